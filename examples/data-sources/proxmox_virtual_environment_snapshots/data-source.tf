@@ -0,0 +1,8 @@
+data "proxmox_virtual_environment_snapshots" "example" {
+  node_name = "pve"
+  vm_id     = 100
+}
+
+output "data_proxmox_virtual_environment_snapshots" {
+  value = data.proxmox_virtual_environment_snapshots.example.snapshots
+}
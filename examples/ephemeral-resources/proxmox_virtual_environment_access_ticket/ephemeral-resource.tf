@@ -0,0 +1,15 @@
+variable "password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+ephemeral "proxmox_virtual_environment_access_ticket" "ticket" {
+  username = "deploy@pve"
+  password = var.password
+}
+
+# the ticket can be used as the `PVEAuthCookie` cookie, e.g. to authenticate to the noVNC console
+locals {
+  auth_cookie = "PVEAuthCookie=${ephemeral.proxmox_virtual_environment_access_ticket.ticket.ticket}"
+}
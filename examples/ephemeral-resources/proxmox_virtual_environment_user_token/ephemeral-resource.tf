@@ -0,0 +1,14 @@
+# a temporary token, which is created for each Terraform operation and revoked at its end
+ephemeral "proxmox_virtual_environment_user_token" "deploy" {
+  comment         = "Temporary token for the deployment"
+  expiration_date = "2033-01-01T22:00:00Z"
+  user_id         = "deploy@pve"
+}
+
+# the token value can be passed to other providers, or to the write-only attributes
+provider "restapi" {
+  uri = "https://pve.example.com:8006/api2/json"
+  headers = {
+    Authorization = "PVEAPIToken=${ephemeral.proxmox_virtual_environment_user_token.deploy.value}"
+  }
+}
@@ -0,0 +1,13 @@
+locals {
+  net = provider::proxmox::parse_net("virtio=BC:24:11:2E:C5:02,bridge=vmbr0,tag=10")
+}
+
+output "net" {
+  # "model=virtio,bridge=vmbr1,macaddr=BC:24:11:2E:C5:02,tag=10"
+  value = provider::proxmox::encode_net(merge(local.net, { bridge = "vmbr1" }))
+}
+
+output "new_net" {
+  # "model=virtio,bridge=vmbr0,firewall=1"
+  value = provider::proxmox::encode_net({ model = "virtio", bridge = "vmbr0", firewall = true })
+}
@@ -0,0 +1,11 @@
+locals {
+  disk = provider::proxmox::parse_disk("local-lvm:vm-100-disk-0,iothread=1,size=32G")
+}
+
+output "disk_datastore_id" {
+  value = local.disk.datastore_id # "local-lvm"
+}
+
+output "disk_size" {
+  value = local.disk.size # 32
+}
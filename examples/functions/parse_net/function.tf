@@ -0,0 +1,11 @@
+locals {
+  net = provider::proxmox::parse_net("virtio=BC:24:11:2E:C5:02,bridge=vmbr0,tag=10")
+}
+
+output "mac_address" {
+  value = local.net.mac_address # "BC:24:11:2E:C5:02"
+}
+
+output "vlan_id" {
+  value = local.net.vlan_id # 10
+}
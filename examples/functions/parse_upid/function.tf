@@ -0,0 +1,11 @@
+locals {
+  task = provider::proxmox::parse_upid("UPID:pve:000C41A5:0135F6C2:66B0B5E4:qmstart:100:root@pam:")
+}
+
+output "task_node_name" {
+  value = local.task.node_name # "pve"
+}
+
+output "task_start_time" {
+  value = local.task.start_time # "2024-08-05T11:22:12Z"
+}
@@ -0,0 +1,18 @@
+resource "proxmox_virtual_environment_download_file" "ubuntu_cloud_image" {
+  content_type = "iso"
+  datastore_id = "local"
+  node_name    = "pve"
+  url          = "https://cloud-images.ubuntu.com/noble/current/noble-server-cloudimg-amd64.img"
+}
+
+locals {
+  image = provider::proxmox::parse_volume_id(proxmox_virtual_environment_download_file.ubuntu_cloud_image.id)
+}
+
+output "image_file_name" {
+  value = local.image.file_name # "noble-server-cloudimg-amd64.img"
+}
+
+output "image_content_type" {
+  value = local.image.content_type # "iso"
+}
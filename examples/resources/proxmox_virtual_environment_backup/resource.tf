@@ -0,0 +1,19 @@
+resource "proxmox_virtual_environment_backup" "before_upgrade" {
+  node_name      = "pve"
+  vm_id          = 100
+  storage        = "local"
+  mode           = "snapshot"
+  compress       = "zstd"
+  notes_template = "{{guestname}} before upgrade"
+}
+
+# restore the backup into a new VM
+resource "proxmox_virtual_environment_vm" "restored" {
+  node_name = "pve"
+  vm_id     = 200
+
+  restore {
+    archive = proxmox_virtual_environment_backup.before_upgrade.volume_id
+    unique  = true
+  }
+}
@@ -0,0 +1,3 @@
+#!/usr/bin/env sh
+#Backup jobs can be imported using their identifier, e.g.
+terraform import proxmox_virtual_environment_backup_job.weekly weekly-backup
@@ -0,0 +1,23 @@
+resource "proxmox_virtual_environment_backup_job" "weekly" {
+  job_id         = "weekly-backup"
+  schedule       = "sun 01:00"
+  storage        = "local"
+  selection_mode = "exclude"
+  vm_ids         = [100, 101]
+  compress       = "zstd"
+  mode           = "snapshot"
+  comment        = "Weekly backup of all guests except the test ones"
+
+  prune_backups = {
+    keep_last    = 3
+    keep_weekly  = 4
+    keep_monthly = 6
+  }
+
+  fleecing = {
+    enabled = true
+    storage = "local-lvm"
+  }
+
+  notification_mode = "notification-system"
+}
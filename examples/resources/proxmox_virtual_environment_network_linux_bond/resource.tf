@@ -0,0 +1,22 @@
+resource "proxmox_virtual_environment_network_linux_bond" "bond0" {
+  node_name = "pve"
+  name      = "bond0"
+
+  slaves      = ["eno1", "eno2"]
+  bond_mode   = "802.3ad"
+  hash_policy = "layer3+4"
+
+  comment = "LACP uplink"
+}
+
+resource "proxmox_virtual_environment_network_linux_bridge" "vmbr10" {
+  node_name = "pve"
+  name      = "vmbr10"
+
+  address = "10.0.10.2/24"
+  gateway = "10.0.10.1"
+
+  ports = [
+    proxmox_virtual_environment_network_linux_bond.bond0.name
+  ]
+}
@@ -0,0 +1,25 @@
+resource "proxmox_virtual_environment_network_ovs_bridge" "vmbr20" {
+  node_name = "pve"
+  name      = "vmbr20"
+
+  comment = "OVS bridge"
+}
+
+resource "proxmox_virtual_environment_network_ovs_bond" "bond1" {
+  node_name = "pve"
+  name      = "bond1"
+  bridge    = proxmox_virtual_environment_network_ovs_bridge.vmbr20.name
+
+  slaves      = ["eno3", "eno4"]
+  bond_mode   = "balance-slb"
+  ovs_options = "other_config:bond-rebalance-interval=0"
+}
+
+resource "proxmox_virtual_environment_network_ovs_int_port" "mgmt" {
+  node_name = "pve"
+  name      = "mgmt"
+  bridge    = proxmox_virtual_environment_network_ovs_bridge.vmbr20.name
+  tag       = 30
+
+  address = "10.0.30.2/24"
+}
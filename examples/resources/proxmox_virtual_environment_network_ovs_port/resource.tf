@@ -0,0 +1,12 @@
+resource "proxmox_virtual_environment_network_ovs_bridge" "vmbr20" {
+  node_name = "pve"
+  name      = "vmbr20"
+}
+
+resource "proxmox_virtual_environment_network_ovs_port" "eno3" {
+  node_name = "pve"
+  name      = "eno3"
+  bridge    = proxmox_virtual_environment_network_ovs_bridge.vmbr20.name
+
+  ovs_options = "vlan_mode=native-untagged"
+}
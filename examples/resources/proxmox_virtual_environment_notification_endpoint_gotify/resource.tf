@@ -0,0 +1,5 @@
+resource "proxmox_virtual_environment_notification_endpoint_gotify" "gotify" {
+  name   = "gotify"
+  server = "https://gotify.example.com"
+  token  = var.gotify_token
+}
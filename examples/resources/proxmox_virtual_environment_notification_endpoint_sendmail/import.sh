@@ -0,0 +1,3 @@
+#!/usr/bin/env sh
+#Sendmail notification endpoints can be imported using their name, e.g.
+terraform import proxmox_virtual_environment_notification_endpoint_sendmail.ops ops-mail
@@ -0,0 +1,8 @@
+resource "proxmox_virtual_environment_notification_endpoint_sendmail" "ops" {
+  name         = "ops-mail"
+  mailto       = ["ops@example.com"]
+  mailto_user  = ["root@pam"]
+  from_address = "pve@example.com"
+  author       = "Proxmox VE"
+  comment      = "Managed by Terraform"
+}
@@ -0,0 +1,3 @@
+#!/usr/bin/env sh
+#SMTP notification endpoints can be imported using their name, e.g.
+terraform import proxmox_virtual_environment_notification_endpoint_smtp.relay smtp-relay
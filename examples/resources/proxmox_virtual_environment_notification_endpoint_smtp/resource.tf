@@ -0,0 +1,10 @@
+resource "proxmox_virtual_environment_notification_endpoint_smtp" "relay" {
+  name         = "smtp-relay"
+  server       = "smtp.example.com"
+  port         = 587
+  mode         = "starttls"
+  username     = "pve@example.com"
+  password     = var.smtp_password
+  from_address = "pve@example.com"
+  mailto       = ["ops@example.com"]
+}
@@ -0,0 +1,3 @@
+#!/usr/bin/env sh
+#Webhook notification endpoints can be imported using their name, e.g.
+terraform import proxmox_virtual_environment_notification_endpoint_webhook.chat chat
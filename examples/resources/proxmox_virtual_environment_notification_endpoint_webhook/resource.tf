@@ -0,0 +1,17 @@
+resource "proxmox_virtual_environment_notification_endpoint_webhook" "chat" {
+  name   = "chat"
+  url    = "https://chat.example.com/hooks/{{ secrets.hook_id }}"
+  method = "post"
+
+  headers = {
+    "Content-Type" = "application/json"
+  }
+
+  body = jsonencode({
+    text = "{{ title }}: {{ message }}"
+  })
+
+  secrets = {
+    hook_id = var.chat_hook_id
+  }
+}
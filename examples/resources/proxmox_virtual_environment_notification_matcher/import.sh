@@ -0,0 +1,3 @@
+#!/usr/bin/env sh
+#Notification matchers can be imported using their name, e.g.
+terraform import proxmox_virtual_environment_notification_matcher.backups backup-failures
@@ -0,0 +1,20 @@
+resource "proxmox_virtual_environment_notification_matcher" "backups" {
+  name           = "backup-failures"
+  comment        = "Failed backups outside of office hours"
+  mode           = "all"
+  targets        = [proxmox_virtual_environment_notification_endpoint_webhook.chat.name]
+  match_severity = ["error"]
+  match_calendar = ["mon..fri 18-8", "sat,sun"]
+
+  match_field = [
+    {
+      field = "type"
+      value = "vzdump"
+    },
+    {
+      type  = "regex"
+      field = "hostname"
+      value = "^pve-prod-.*$"
+    },
+  ]
+}
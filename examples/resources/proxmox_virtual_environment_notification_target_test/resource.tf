@@ -0,0 +1,7 @@
+resource "proxmox_virtual_environment_notification_target_test" "chat" {
+  target = proxmox_virtual_environment_notification_endpoint_webhook.chat.name
+
+  triggers = {
+    url = proxmox_virtual_environment_notification_endpoint_webhook.chat.url
+  }
+}
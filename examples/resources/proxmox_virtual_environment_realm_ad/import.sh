@@ -0,0 +1,3 @@
+#!/usr/bin/env sh
+#Active Directory realms can be imported using their identifier, e.g.
+terraform import proxmox_virtual_environment_realm_ad.example example-ad
@@ -0,0 +1,19 @@
+resource "proxmox_virtual_environment_realm_ad" "example" {
+  id            = "example-ad"
+  domain        = "example.com"
+  server1       = "dc1.example.com"
+  mode          = "ldap+starttls"
+  base_dn       = "dc=example,dc=com"
+  bind_dn       = "cn=pve,cn=Users,dc=example,dc=com"
+  bind_password = var.ad_bind_password
+  default       = true
+
+  tfa = {
+    type = "oath"
+  }
+}
+
+variable "ad_bind_password" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,3 @@
+#!/usr/bin/env sh
+#LDAP realms can be imported using their identifier, e.g.
+terraform import proxmox_virtual_environment_realm_ldap.example example-ldap
@@ -0,0 +1,28 @@
+resource "proxmox_virtual_environment_realm_ldap" "example" {
+  id            = "example-ldap"
+  comment       = "Managed by Terraform"
+  server1       = "ldap1.example.com"
+  server2       = "ldap2.example.com"
+  mode          = "ldaps"
+  verify        = true
+  base_dn       = "ou=people,dc=example,dc=com"
+  user_attr     = "uid"
+  bind_dn       = "cn=pve,ou=services,dc=example,dc=com"
+  bind_password = var.ldap_bind_password
+
+  group_dn        = "ou=groups,dc=example,dc=com"
+  group_filter    = "(cn=pve-*)"
+  group_name_attr = "cn"
+  sync_attributes = "email=mail,firstname=givenName,lastname=sn"
+
+  sync_defaults = {
+    scope           = "both"
+    enable_new      = true
+    remove_vanished = ["acl", "entry", "properties"]
+  }
+}
+
+variable "ldap_bind_password" {
+  type      = string
+  sensitive = true
+}
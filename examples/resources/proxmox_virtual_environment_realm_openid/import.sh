@@ -0,0 +1,3 @@
+#!/usr/bin/env sh
+#OpenID Connect realms can be imported using their identifier, e.g.
+terraform import proxmox_virtual_environment_realm_openid.example example-sso
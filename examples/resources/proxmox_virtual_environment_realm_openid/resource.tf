@@ -0,0 +1,14 @@
+resource "proxmox_virtual_environment_realm_openid" "example" {
+  id             = "example-sso"
+  issuer_url     = "https://sso.example.com/realms/pve"
+  client_id      = "proxmox"
+  client_key     = var.openid_client_key
+  username_claim = "email"
+  autocreate     = true
+  groups_claim   = "groups"
+}
+
+variable "openid_client_key" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,9 @@
+resource "proxmox_virtual_environment_realm_sync" "example" {
+  realm           = proxmox_virtual_environment_realm_ldap.example.id
+  scope           = "both"
+  remove_vanished = ["acl", "entry"]
+
+  triggers = {
+    group_filter = proxmox_virtual_environment_realm_ldap.example.group_filter
+  }
+}
@@ -0,0 +1,3 @@
+#!/usr/bin/env sh
+#Replication jobs can be imported using their identifier in the `<guest ID>-<job number>` format, e.g.
+terraform import proxmox_virtual_environment_replication.database 100-0
@@ -0,0 +1,10 @@
+resource "proxmox_virtual_environment_replication" "database" {
+  guest_id = 100
+  target   = "pve2"
+  schedule = "*/5"
+  rate     = 50
+  comment  = "Managed by Terraform"
+
+  # keep the replicated volumes on pve2 when the job is removed
+  remove_job = "local"
+}
@@ -0,0 +1,7 @@
+resource "proxmox_virtual_environment_sdn_applier" "apply" {
+  triggers = {
+    zone   = jsonencode(proxmox_virtual_environment_sdn_zone.simple)
+    vnet   = jsonencode(proxmox_virtual_environment_sdn_vnet.vnet)
+    subnet = jsonencode(proxmox_virtual_environment_sdn_subnet.subnet)
+  }
+}
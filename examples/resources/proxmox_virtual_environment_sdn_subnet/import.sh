@@ -0,0 +1,3 @@
+#!/usr/bin/env sh
+#SDN subnets can be imported using the VNet identifier and the subnet CIDR, e.g.
+terraform import proxmox_virtual_environment_sdn_subnet.subnet vnet1/10.10.0.0/24
@@ -0,0 +1,24 @@
+resource "proxmox_virtual_environment_sdn_zone" "simple" {
+  name = "simple1"
+  type = "simple"
+  dhcp = "dnsmasq"
+}
+
+resource "proxmox_virtual_environment_sdn_vnet" "vnet" {
+  name = "vnet1"
+  zone = proxmox_virtual_environment_sdn_zone.simple.name
+}
+
+resource "proxmox_virtual_environment_sdn_subnet" "subnet" {
+  vnet    = proxmox_virtual_environment_sdn_vnet.vnet.name
+  cidr    = "10.10.0.0/24"
+  gateway = "10.10.0.1"
+  snat    = true
+
+  dhcp_range = [
+    {
+      start_address = "10.10.0.100"
+      end_address   = "10.10.0.199"
+    },
+  ]
+}
@@ -0,0 +1,3 @@
+#!/usr/bin/env sh
+#SDN VNets can be imported using their identifier, e.g.
+terraform import proxmox_virtual_environment_sdn_vnet.vnet vnet1
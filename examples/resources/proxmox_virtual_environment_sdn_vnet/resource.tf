@@ -0,0 +1,12 @@
+resource "proxmox_virtual_environment_sdn_zone" "vlan" {
+  name   = "vlan1"
+  type   = "vlan"
+  bridge = "vmbr0"
+}
+
+resource "proxmox_virtual_environment_sdn_vnet" "vnet" {
+  name  = "vnet1"
+  zone  = proxmox_virtual_environment_sdn_zone.vlan.name
+  tag   = 100
+  alias = "tenant-a"
+}
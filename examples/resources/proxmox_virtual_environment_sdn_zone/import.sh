@@ -0,0 +1,3 @@
+#!/usr/bin/env sh
+#SDN zones can be imported using their identifier, e.g.
+terraform import proxmox_virtual_environment_sdn_zone.simple simple1
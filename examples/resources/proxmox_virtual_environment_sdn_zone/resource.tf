@@ -0,0 +1,23 @@
+resource "proxmox_virtual_environment_sdn_zone" "simple" {
+  name = "simple1"
+  type = "simple"
+  dhcp = "dnsmasq"
+  ipam = "pve"
+}
+
+resource "proxmox_virtual_environment_sdn_zone" "vlan" {
+  name   = "vlan1"
+  type   = "vlan"
+  bridge = "vmbr0"
+  nodes  = ["pve"]
+}
+
+resource "proxmox_virtual_environment_sdn_zone" "evpn" {
+  name                     = "evpn1"
+  type                     = "evpn"
+  controller               = "evpnctl"
+  vrf_vxlan                = 10000
+  exit_nodes               = ["pve"]
+  advertise_subnets        = true
+  exit_nodes_local_routing = true
+}
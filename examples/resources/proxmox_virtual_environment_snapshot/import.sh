@@ -0,0 +1,3 @@
+#!/usr/bin/env sh
+#Snapshots can be imported using the `node_name:vm|container:id:name` format, e.g.
+terraform import proxmox_virtual_environment_snapshot.before_upgrade pve:vm:100:before_upgrade
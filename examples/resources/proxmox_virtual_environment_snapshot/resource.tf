@@ -0,0 +1,19 @@
+resource "proxmox_virtual_environment_snapshot" "before_upgrade" {
+  node_name   = "pve"
+  vm_id       = 100
+  name        = "before_upgrade"
+  description = "Taken before the OS upgrade"
+  vmstate     = true
+}
+
+# roll the container back to the snapshot whenever `triggers` change
+resource "proxmox_virtual_environment_snapshot" "baseline" {
+  node_name          = "pve"
+  container_id       = 101
+  name               = "baseline"
+  rollback_on_change = true
+
+  triggers = {
+    reset = "1"
+  }
+}
@@ -0,0 +1,3 @@
+#!/usr/bin/env sh
+#CIFS storages can be imported using their identifier, e.g.
+terraform import proxmox_virtual_environment_storage_cifs.archive archive
@@ -0,0 +1,10 @@
+resource "proxmox_virtual_environment_storage_cifs" "archive" {
+  id          = "archive"
+  server      = "fileserver.example.com"
+  share       = "proxmox"
+  username    = "svc-proxmox"
+  password    = var.cifs_password
+  domain      = "EXAMPLE"
+  smb_version = "3"
+  content     = ["backup"]
+}
@@ -0,0 +1,12 @@
+resource "proxmox_virtual_environment_storage_directory" "backups" {
+  id            = "backups"
+  path          = "/mnt/backups"
+  content       = ["backup", "iso", "vztmpl"]
+  nodes         = ["pve1", "pve2"]
+  preallocation = "metadata"
+
+  prune_backups = {
+    keep_last  = 3
+    keep_daily = 7
+  }
+}
@@ -0,0 +1,6 @@
+resource "proxmox_virtual_environment_storage_iscsi" "san" {
+  id      = "san"
+  portal  = "10.0.0.20"
+  target  = "iqn.2024-01.com.example:storage.lun1"
+  content = ["none"]
+}
@@ -0,0 +1,7 @@
+resource "proxmox_virtual_environment_storage_lvm" "vmdata" {
+  id                   = "vmdata"
+  volume_group         = "vmdata"
+  content              = ["images", "rootdir"]
+  shared               = true
+  wipe_removed_volumes = true
+}
@@ -0,0 +1,7 @@
+resource "proxmox_virtual_environment_storage_lvmthin" "thin" {
+  id           = "thin"
+  volume_group = "pve"
+  thin_pool    = "data"
+  content      = ["images", "rootdir"]
+  nodes        = ["pve1"]
+}
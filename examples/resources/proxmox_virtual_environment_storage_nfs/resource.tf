@@ -0,0 +1,7 @@
+resource "proxmox_virtual_environment_storage_nfs" "shared_iso" {
+  id      = "shared-iso"
+  server  = "10.0.0.10"
+  export  = "/export/iso"
+  content = ["iso", "vztmpl"]
+  options = "vers=4.2"
+}
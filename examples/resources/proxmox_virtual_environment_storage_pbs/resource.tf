@@ -0,0 +1,16 @@
+resource "proxmox_virtual_environment_storage_pbs" "pbs" {
+  id                  = "pbs"
+  server              = "pbs.example.com"
+  datastore           = "backups"
+  namespace           = "pve"
+  username            = "backup@pbs!terraform"
+  password            = var.pbs_token_secret
+  password_wo_version = 1
+  fingerprint         = "AA:BB:CC:DD:EE:FF:00:11:22:33:44:55:66:77:88:99:AA:BB:CC:DD:EE:FF:00:11:22:33:44:55:66:77:88:99"
+  encryption_key      = file("pbs-encryption-key.json")
+
+  prune_backups = {
+    keep_daily  = 7
+    keep_weekly = 4
+  }
+}
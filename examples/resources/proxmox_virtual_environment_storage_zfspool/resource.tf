@@ -0,0 +1,7 @@
+resource "proxmox_virtual_environment_storage_zfspool" "tank" {
+  id             = "tank"
+  zfs_pool       = "tank/vms"
+  content        = ["images", "rootdir"]
+  thin_provision = true
+  blocksize      = "16K"
+}
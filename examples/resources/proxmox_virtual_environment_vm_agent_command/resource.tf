@@ -0,0 +1,32 @@
+resource "proxmox_virtual_environment_vm_agent_command" "provision" {
+  node_name = "pve"
+  vm_id     = 100
+
+  user_password = {
+    username            = "admin"
+    password            = var.admin_password
+    password_wo_version = 1
+  }
+
+  file = [
+    {
+      path    = "/etc/motd"
+      content = "Managed by Terraform\n"
+    },
+  ]
+
+  command = ["/bin/sh", "-c", "apt-get update && apt-get -y install nginx"]
+
+  # run everything again whenever `triggers` change
+  triggers = {
+    revision = "1"
+  }
+
+  timeouts = {
+    create = "20m"
+  }
+}
+
+output "provision_stdout" {
+  value = proxmox_virtual_environment_vm_agent_command.provision.stdout
+}
@@ -0,0 +1,144 @@
+//go:build acceptance || all
+
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package access_test
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/test"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/access"
+	"github.com/bpg/terraform-provider-proxmox/utils"
+)
+
+func echoProviders(te *test.Environment) map[string]func() (tfprotov6.ProviderServer, error) {
+	providers := maps.Clone(te.AccProviders)
+	providers["echo"] = echoprovider.NewProviderServer()
+
+	return providers
+}
+
+func TestAccEphemeralUserToken(t *testing.T) {
+	t.Parallel()
+
+	te := test.InitEnvironment(t)
+	userID := fmt.Sprintf("%s@pve", gofakeit.Username())
+
+	te.AddTemplateVars(map[string]any{
+		"UserID": userID,
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: echoProviders(te),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		PreCheck: func() {
+			err := te.AccessClient().CreateUser(context.Background(), &access.UserCreateRequestBody{
+				ID:       userID,
+				Password: gofakeit.Password(true, true, true, true, false, 8),
+			})
+			require.NoError(t, err)
+
+			t.Cleanup(func() {
+				err = te.AccessClient().DeleteUser(context.Background(), userID)
+				require.NoError(t, err)
+			})
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: te.RenderConfig(`
+				ephemeral "proxmox_virtual_environment_user_token" "token" {
+					comment         = "Managed by Terraform"
+					expiration_date = "2033-01-01T01:01:01Z"
+					user_id         = "{{.UserID}}"
+				}
+				provider "echo" {
+					data = ephemeral.proxmox_virtual_environment_user_token.token
+				}
+				resource "echo" "token" {}`),
+				Check: resource.ComposeTestCheckFunc(
+					test.ResourceAttributes("echo.token", map[string]string{
+						"data.comment":               "Managed by Terraform",
+						"data.id":                    fmt.Sprintf("%s!terraform-.*", userID),
+						"data.privileges_separation": "true",
+						"data.user_id":               userID,
+						"data.value":                 fmt.Sprintf("%s!terraform-.*=.*", userID),
+					}),
+					func(*terraform.State) error {
+						// the token is revoked when the ephemeral resource is closed
+						tokens, err := te.AccessClient().ListUserTokens(context.Background(), userID)
+						assert.NoError(t, err)
+						assert.Empty(t, tokens)
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccEphemeralAccessTicket(t *testing.T) {
+	t.Parallel()
+
+	username := utils.GetAnyStringEnv("PROXMOX_VE_USERNAME")
+	password := utils.GetAnyStringEnv("PROXMOX_VE_PASSWORD")
+
+	if username == "" || password == "" {
+		t.Skip("PROXMOX_VE_USERNAME and PROXMOX_VE_PASSWORD must be set")
+	}
+
+	te := test.InitEnvironment(t)
+
+	te.AddTemplateVars(map[string]any{
+		"Username": username,
+		"Password": password,
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: echoProviders(te),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: te.RenderConfig(`
+				ephemeral "proxmox_virtual_environment_access_ticket" "ticket" {
+					username = "{{.Username}}"
+					password = "{{.Password}}"
+				}
+				provider "echo" {
+					data = ephemeral.proxmox_virtual_environment_access_ticket.ticket
+				}
+				resource "echo" "ticket" {}`),
+				Check: resource.ComposeTestCheckFunc(
+					test.ResourceAttributes("echo.ticket", map[string]string{
+						"data.username": username,
+						"data.ticket":   fmt.Sprintf("PVE:%s:.*", username),
+					}),
+					test.ResourceAttributesSet("echo.ticket", []string{
+						"data.csrf_prevention_token",
+					}),
+				),
+			},
+		},
+	})
+}
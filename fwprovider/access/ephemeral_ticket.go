@@ -0,0 +1,144 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package access
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/config"
+	"github.com/bpg/terraform-provider-proxmox/proxmox"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/access"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &ticketEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &ticketEphemeralResource{}
+)
+
+type ticketEphemeralResource struct {
+	client proxmox.Client
+}
+
+type ticketModel struct {
+	ClusterName         types.String `tfsdk:"cluster_name"`
+	CSRFPreventionToken types.String `tfsdk:"csrf_prevention_token"`
+	OTP                 types.String `tfsdk:"otp"`
+	Password            types.String `tfsdk:"password"`
+	Ticket              types.String `tfsdk:"ticket"`
+	Username            types.String `tfsdk:"username"`
+}
+
+// NewTicketEphemeralResource creates a new ticket ephemeral resource.
+func NewTicketEphemeralResource() ephemeral.EphemeralResource {
+	return &ticketEphemeralResource{}
+}
+
+func (r *ticketEphemeralResource) Metadata(
+	_ context.Context,
+	req ephemeral.MetadataRequest,
+	resp *ephemeral.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_access_ticket"
+}
+
+func (r *ticketEphemeralResource) Schema(
+	_ context.Context,
+	_ ephemeral.SchemaRequest,
+	resp *ephemeral.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a short-lived authentication ticket, which is not stored in the state.",
+		MarkdownDescription: "Creates a short-lived authentication ticket using the `/access/ticket` API. " +
+			"The ticket is valid for two hours, and is not stored in the plan or state. " +
+			"Requires Terraform 1.10 or later.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Description: "The name of the cluster.",
+				Computed:    true,
+			},
+			"csrf_prevention_token": schema.StringAttribute{
+				Description: "The CSRF prevention token, required along with the ticket for the write requests.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"otp": schema.StringAttribute{
+				Description: "The one-time password for the two-factor authentication.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"password": schema.StringAttribute{
+				Description: "The user password.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"ticket": schema.StringAttribute{
+				Description: "The authentication ticket, to be used as the `PVEAuthCookie` cookie value.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"username": schema.StringAttribute{
+				Description: "The user name, including the realm, e.g. `terraform@pve`.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (r *ticketEphemeralResource) Configure(
+	_ context.Context,
+	req ephemeral.ConfigureRequest,
+	resp *ephemeral.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(config.EphemeralResource)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected config.EphemeralResource, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = cfg.Client
+}
+
+func (r *ticketEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ticketModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := access.TicketCreateRequestBody{
+		Username: data.Username.ValueString(),
+		Password: data.Password.ValueString(),
+		OTP:      data.OTP.ValueStringPointer(),
+	}
+
+	ticket, err := r.client.Access().CreateTicket(ctx, &body)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating ticket", err.Error())
+		return
+	}
+
+	data.ClusterName = types.StringPointerValue(ticket.ClusterName)
+	data.CSRFPreventionToken = types.StringValue(ticket.CSRFPreventionToken)
+	data.Ticket = types.StringValue(ticket.Ticket)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, data)...)
+}
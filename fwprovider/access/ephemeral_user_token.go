@@ -0,0 +1,229 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package access
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/config"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/validators"
+	"github.com/bpg/terraform-provider-proxmox/proxmox"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/access"
+	proxmoxtypes "github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+// userTokenPrivateKey is the private data key holding the identifier of the token to revoke on close.
+const userTokenPrivateKey = "token"
+
+var (
+	_ ephemeral.EphemeralResource              = &userTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &userTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &userTokenEphemeralResource{}
+)
+
+type userTokenEphemeralResource struct {
+	client proxmox.Client
+}
+
+type userTokenPrivateData struct {
+	UserID    string `json:"user_id"`
+	TokenName string `json:"token_name"`
+}
+
+// NewUserTokenEphemeralResource creates a new user token ephemeral resource.
+func NewUserTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &userTokenEphemeralResource{}
+}
+
+func (r *userTokenEphemeralResource) Metadata(
+	_ context.Context,
+	req ephemeral.MetadataRequest,
+	resp *ephemeral.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_user_token"
+}
+
+func (r *userTokenEphemeralResource) Schema(
+	_ context.Context,
+	_ ephemeral.SchemaRequest,
+	resp *ephemeral.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a temporary user API token, which is revoked when it is no longer needed.",
+		MarkdownDescription: "Creates a temporary user API token, which is not stored in the plan or state. " +
+			"The token is revoked at the end of each Terraform operation. " +
+			"Requires Terraform 1.10 or later.",
+		Attributes: map[string]schema.Attribute{
+			"comment": schema.StringAttribute{
+				Description: "Comment for the token.",
+				Optional:    true,
+			},
+			"expiration_date": schema.StringAttribute{
+				Description: "Expiration date for the token.",
+				MarkdownDescription: "Expiration date for the token. It is recommended to set it, so the token expires " +
+					"even if it could not be revoked.",
+				Optional: true,
+				Validators: []validator.String{
+					validators.NewParseValidator(func(s string) (time.Time, error) {
+						return time.Parse(time.RFC3339, s)
+					}, "must be a valid RFC3339 date"),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "Unique token identifier with format `<user_id>!<token_name>`.",
+				Computed:    true,
+			},
+			"privileges_separation": schema.BoolAttribute{
+				Description: "Restrict API token privileges with separate ACLs (default)",
+				MarkdownDescription: "Restrict API token privileges with separate ACLs (default), " +
+					"or give full privileges of corresponding user.",
+				Optional: true,
+				Computed: true,
+			},
+			"token_name": schema.StringAttribute{
+				Description: "User-specific token identifier.",
+				MarkdownDescription: "User-specific token identifier. A unique name with the `terraform-` prefix " +
+					"is generated if not set.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`[A-Za-z][A-Za-z0-9.\-_]+`), "must be a valid token identifier"),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Description: "User identifier.",
+				Required:    true,
+			},
+			"value": schema.StringAttribute{
+				Description: "API token value used for authentication, with format `<user_id>!<token_name>=<secret>`.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (r *userTokenEphemeralResource) Configure(
+	_ context.Context,
+	req ephemeral.ConfigureRequest,
+	resp *ephemeral.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(config.EphemeralResource)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected config.EphemeralResource, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = cfg.Client
+}
+
+func (r *userTokenEphemeralResource) Open(
+	ctx context.Context,
+	req ephemeral.OpenRequest,
+	resp *ephemeral.OpenResponse,
+) {
+	var data userTokenModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.TokenName.IsNull() || data.TokenName.ValueString() == "" {
+		// the token is created on every Terraform operation, so its name must not clash with a token
+		// that was not revoked yet
+		data.TokenName = types.StringValue("terraform-" + strings.ReplaceAll(uuid.NewString(), "-", "")[:16])
+	}
+
+	if data.PrivSeparation.IsNull() {
+		data.PrivSeparation = types.BoolValue(true)
+	}
+
+	body := access.UserTokenCreateRequestBody{
+		Comment:      data.Comment.ValueStringPointer(),
+		PrivSeparate: proxmoxtypes.CustomBoolPtr(data.PrivSeparation.ValueBoolPointer()),
+	}
+
+	if !data.ExpirationDate.IsNull() && data.ExpirationDate.ValueString() != "" {
+		expirationDate, err := time.Parse(time.RFC3339, data.ExpirationDate.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error parsing expiration date", err.Error())
+			return
+		}
+
+		v := expirationDate.Unix()
+		body.ExpirationDate = &v
+	}
+
+	userID := data.UserID.ValueString()
+	tokenName := data.TokenName.ValueString()
+
+	value, err := r.client.Access().CreateUserToken(ctx, userID, tokenName, &body)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating user token", err.Error())
+		return
+	}
+
+	privateData, err := json.Marshal(userTokenPrivateData{UserID: userID, TokenName: tokenName})
+	if err != nil {
+		resp.Diagnostics.AddError("Error storing user token private data", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, userTokenPrivateKey, privateData)...)
+
+	data.ID = types.StringValue(userID + "!" + tokenName)
+	data.Value = types.StringValue(value)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, data)...)
+}
+
+func (r *userTokenEphemeralResource) Close(
+	ctx context.Context,
+	req ephemeral.CloseRequest,
+	resp *ephemeral.CloseResponse,
+) {
+	privateData, diags := req.Private.GetKey(ctx, userTokenPrivateKey)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() || privateData == nil {
+		return
+	}
+
+	var token userTokenPrivateData
+
+	if err := json.Unmarshal(privateData, &token); err != nil {
+		resp.Diagnostics.AddError("Error reading user token private data", err.Error())
+		return
+	}
+
+	err := r.client.Access().DeleteUserToken(ctx, token.UserID, token.TokenName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error revoking user token", err.Error())
+	}
+}
@@ -0,0 +1,250 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package access
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/types/stringset"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/access"
+	proxmoxtypes "github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+// realmModel is implemented by the models of all realm types.
+type realmModel interface {
+	// base returns the attributes shared by all realm types.
+	base() *realmModelBase
+	// fixedFields returns the type specific fields that can only be set on creation.
+	fixedFields() access.RealmFixedFields
+	// mutableFields returns the type specific fields that can be changed after creation.
+	mutableFields(ctx context.Context, diags *diag.Diagnostics) access.RealmMutableFields
+	// writeOnlyFields sets the write-only fields of the configuration, they are never stored in the state.
+	writeOnlyFields(fields *access.RealmMutableFields)
+	// importFromAPI sets the type specific attributes from the API response.
+	importFromAPI(ctx context.Context, data *access.RealmGetResponseData, diags *diag.Diagnostics)
+}
+
+// realmModelBase contains the attributes shared by all realm types.
+type realmModelBase struct {
+	ID      types.String   `tfsdk:"id"`
+	Comment types.String   `tfsdk:"comment"`
+	Default types.Bool     `tfsdk:"default"`
+	TFA     *realmTFAModel `tfsdk:"tfa"`
+}
+
+func (m *realmModelBase) base() *realmModelBase {
+	return m
+}
+
+func (m *realmModelBase) fixedFields() access.RealmFixedFields {
+	return access.RealmFixedFields{}
+}
+
+func (m *realmModelBase) importBaseFromAPI(data *access.RealmGetResponseData) {
+	m.Comment = stringValue(data.Comment)
+	m.Default = types.BoolValue(data.Default != nil && bool(*data.Default))
+	m.TFA = tfaFromAPI(data.TFA)
+}
+
+type realmTFAModel struct {
+	Type   types.String `tfsdk:"type"`
+	Step   types.Int64  `tfsdk:"step"`
+	Digits types.Int64  `tfsdk:"digits"`
+	ID     types.String `tfsdk:"id"`
+	Key    types.String `tfsdk:"key"`
+	URL    types.String `tfsdk:"url"`
+}
+
+func (m *realmTFAModel) toAPI() *access.RealmTFA {
+	if m == nil {
+		return nil
+	}
+
+	return &access.RealmTFA{
+		Type:   m.Type.ValueString(),
+		Step:   m.Step.ValueInt64Pointer(),
+		Digits: m.Digits.ValueInt64Pointer(),
+		ID:     m.ID.ValueStringPointer(),
+		Key:    m.Key.ValueStringPointer(),
+		URL:    m.URL.ValueStringPointer(),
+	}
+}
+
+func tfaFromAPI(data *access.RealmTFA) *realmTFAModel {
+	if data == nil {
+		return nil
+	}
+
+	return &realmTFAModel{
+		Type:   types.StringValue(data.Type),
+		Step:   types.Int64PointerValue(data.Step),
+		Digits: types.Int64PointerValue(data.Digits),
+		ID:     types.StringPointerValue(data.ID),
+		Key:    types.StringPointerValue(data.Key),
+		URL:    types.StringPointerValue(data.URL),
+	}
+}
+
+// directoryModel contains the attributes shared by the LDAP and Active Directory realms.
+type directoryModel struct {
+	Server1             types.String            `tfsdk:"server1"`
+	Server2             types.String            `tfsdk:"server2"`
+	Port                types.Int64             `tfsdk:"port"`
+	Mode                types.String            `tfsdk:"mode"`
+	Verify              types.Bool              `tfsdk:"verify"`
+	CAPath              types.String            `tfsdk:"capath"`
+	BindDN              types.String            `tfsdk:"bind_dn"`
+	BindPassword        types.String            `tfsdk:"bind_password"`
+	BindPasswordVersion types.Int64             `tfsdk:"bind_password_wo_version"`
+	UserClasses         stringset.Value         `tfsdk:"user_classes"`
+	Filter              types.String            `tfsdk:"filter"`
+	GroupDN             types.String            `tfsdk:"group_dn"`
+	GroupFilter         types.String            `tfsdk:"group_filter"`
+	GroupClasses        stringset.Value         `tfsdk:"group_classes"`
+	GroupNameAttr       types.String            `tfsdk:"group_name_attr"`
+	SyncAttributes      types.String            `tfsdk:"sync_attributes"`
+	SyncDefaults        *realmSyncDefaultsModel `tfsdk:"sync_defaults"`
+	CaseSensitive       types.Bool              `tfsdk:"case_sensitive"`
+}
+
+type realmSyncDefaultsModel struct {
+	EnableNew      types.Bool      `tfsdk:"enable_new"`
+	RemoveVanished stringset.Value `tfsdk:"remove_vanished"`
+	Scope          types.String    `tfsdk:"scope"`
+}
+
+func (m *realmSyncDefaultsModel) toAPI(ctx context.Context, diags *diag.Diagnostics) access.RealmSyncOptions {
+	if m == nil {
+		return access.RealmSyncOptions{}
+	}
+
+	return access.RealmSyncOptions{
+		EnableNew:      proxmoxtypes.CustomBoolPtr(m.EnableNew.ValueBoolPointer()),
+		RemoveVanished: m.RemoveVanished.ValueStringPointer(ctx, diags),
+		Scope:          m.Scope.ValueStringPointer(),
+	}
+}
+
+func (m *directoryModel) directoryFields(ctx context.Context, diags *diag.Diagnostics) access.RealmMutableFields {
+	fields := access.RealmMutableFields{
+		Server1:        m.Server1.ValueStringPointer(),
+		Server2:        m.Server2.ValueStringPointer(),
+		Port:           m.Port.ValueInt64Pointer(),
+		Mode:           m.Mode.ValueStringPointer(),
+		Verify:         proxmoxtypes.CustomBoolPtr(m.Verify.ValueBoolPointer()),
+		CAPath:         m.CAPath.ValueStringPointer(),
+		BindDN:         m.BindDN.ValueStringPointer(),
+		UserClasses:    m.UserClasses.ValueStringPointer(ctx, diags, stringset.WithSeparator(",")),
+		Filter:         m.Filter.ValueStringPointer(),
+		GroupDN:        m.GroupDN.ValueStringPointer(),
+		GroupFilter:    m.GroupFilter.ValueStringPointer(),
+		GroupClasses:   m.GroupClasses.ValueStringPointer(ctx, diags, stringset.WithSeparator(",")),
+		GroupNameAttr:  m.GroupNameAttr.ValueStringPointer(),
+		SyncAttributes: m.SyncAttributes.ValueStringPointer(),
+		CaseSensitive:  proxmoxtypes.CustomBoolPtr(m.CaseSensitive.ValueBoolPointer()),
+	}
+
+	if m.SyncDefaults != nil {
+		options := access.RealmSyncDefaultsOptions(m.SyncDefaults.toAPI(ctx, diags))
+		fields.SyncDefaultsOptions = &options
+	}
+
+	return fields
+}
+
+func (m *directoryModel) writeOnlyFields(fields *access.RealmMutableFields) {
+	fields.Password = m.BindPassword.ValueStringPointer()
+}
+
+func (m *directoryModel) importDirectoryFromAPI(data *access.RealmGetResponseData, diags *diag.Diagnostics) {
+	m.Server1 = types.StringPointerValue(data.Server1)
+	m.Server2 = stringValue(data.Server2)
+	m.Port = types.Int64PointerValue(data.Port)
+	m.Mode = stringValue(data.Mode)
+	m.Verify = types.BoolPointerValue(data.Verify.PointerBool())
+	m.CAPath = stringValue(data.CAPath)
+	m.BindDN = stringValue(data.BindDN)
+	m.UserClasses = stringset.NewValueString(data.UserClasses, diags, stringset.WithSeparator(","))
+	m.Filter = stringValue(data.Filter)
+	m.GroupDN = stringValue(data.GroupDN)
+	m.GroupFilter = stringValue(data.GroupFilter)
+	m.GroupClasses = stringset.NewValueString(data.GroupClasses, diags, stringset.WithSeparator(","))
+	m.GroupNameAttr = stringValue(data.GroupNameAttr)
+	m.SyncAttributes = stringValue(data.SyncAttributes)
+	m.CaseSensitive = types.BoolPointerValue(data.CaseSensitive.PointerBool())
+	m.SyncDefaults = nil
+
+	if data.SyncDefaultsOptions != nil {
+		removeVanished := data.SyncDefaultsOptions.RemoveVanished
+		if removeVanished != nil && *removeVanished == "none" {
+			removeVanished = nil
+		}
+
+		m.SyncDefaults = &realmSyncDefaultsModel{
+			EnableNew:      types.BoolPointerValue(data.SyncDefaultsOptions.EnableNew.PointerBool()),
+			RemoveVanished: stringset.NewValueString(removeVanished, diags),
+			Scope:          types.StringPointerValue(data.SyncDefaultsOptions.Scope),
+		}
+	}
+}
+
+// stringValue returns a null value for empty API strings.
+func stringValue(s *string) types.String {
+	if s == nil || *s == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(*s)
+}
+
+// deletedRealmFields returns the API fields that are set in the current state but not in the plan.
+func deletedRealmFields(plan, state *access.RealmMutableFields) []string {
+	var toDelete []string
+
+	for _, f := range []struct {
+		name      string
+		inPlan    bool
+		inCurrent bool
+	}{
+		{"comment", plan.Comment != nil, state.Comment != nil},
+		{"tfa", plan.TFA != nil, state.TFA != nil},
+		{"case-sensitive", plan.CaseSensitive != nil, state.CaseSensitive != nil},
+		{"server2", plan.Server2 != nil, state.Server2 != nil},
+		{"port", plan.Port != nil, state.Port != nil},
+		{"mode", plan.Mode != nil, state.Mode != nil},
+		{"verify", plan.Verify != nil, state.Verify != nil},
+		{"capath", plan.CAPath != nil, state.CAPath != nil},
+		{"base_dn", plan.BaseDN != nil, state.BaseDN != nil},
+		{"bind_dn", plan.BindDN != nil, state.BindDN != nil},
+		{"user_attr", plan.UserAttr != nil, state.UserAttr != nil},
+		{"user_classes", plan.UserClasses != nil, state.UserClasses != nil},
+		{"filter", plan.Filter != nil, state.Filter != nil},
+		{"group_dn", plan.GroupDN != nil, state.GroupDN != nil},
+		{"group_filter", plan.GroupFilter != nil, state.GroupFilter != nil},
+		{"group_classes", plan.GroupClasses != nil, state.GroupClasses != nil},
+		{"group_name_attr", plan.GroupNameAttr != nil, state.GroupNameAttr != nil},
+		{"sync_attributes", plan.SyncAttributes != nil, state.SyncAttributes != nil},
+		{"sync-defaults-options", plan.SyncDefaultsOptions != nil, state.SyncDefaultsOptions != nil},
+		{"autocreate", plan.Autocreate != nil, state.Autocreate != nil},
+		{"scopes", plan.Scopes != nil, state.Scopes != nil},
+		{"prompt", plan.Prompt != nil, state.Prompt != nil},
+		{"acr-values", plan.ACRValues != nil, state.ACRValues != nil},
+		{"groups-claim", plan.GroupsClaim != nil, state.GroupsClaim != nil},
+		{"groups-autocreate", plan.GroupsAutocreate != nil, state.GroupsAutocreate != nil},
+		{"groups-overwrite", plan.GroupsOverwrite != nil, state.GroupsOverwrite != nil},
+		{"query-userinfo", plan.QueryUserinfo != nil, state.QueryUserinfo != nil},
+	} {
+		if !f.inPlan && f.inCurrent {
+			toDelete = append(toDelete, f.name)
+		}
+	}
+
+	return toDelete
+}
@@ -0,0 +1,466 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package access
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/attribute"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/config"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/types/stringset"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/access"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+	proxmoxtypes "github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+var realmIDRegex = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9\.\-_]+$`)
+
+var (
+	_ resource.Resource                = &realmResource{}
+	_ resource.ResourceWithConfigure   = &realmResource{}
+	_ resource.ResourceWithImportState = &realmResource{}
+)
+
+// realmResource implements the resources of all realm types, the type specific
+// behaviour is provided by the realmModel implementations.
+type realmResource struct {
+	client *access.Client
+
+	// realmType is the realm type used by the PVE API, e.g. `ldap` or `openid`.
+	realmType string
+	// typeNameSuffix is appended to the provider type name to form the resource type name.
+	typeNameSuffix string
+	// description is the Markdown description of the resource.
+	description string
+	// attributes are the type specific schema attributes.
+	attributes map[string]schema.Attribute
+	// newModel returns an empty model of the realm type.
+	newModel func() realmModel
+}
+
+func (r *realmResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + r.typeNameSuffix
+}
+
+// Schema defines the schema for the resource.
+func (r *realmResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The identifier of the realm, used as the suffix of the user names (e.g. `user@realm`).",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(realmIDRegex, "must start with a letter and contain only letters, "+
+					"digits, '.', '-' and '_'"),
+				stringvalidator.LengthAtMost(32),
+			},
+		},
+		"comment": schema.StringAttribute{
+			Description: "The description of the realm.",
+			Optional:    true,
+			Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"default": schema.BoolAttribute{
+			Description: "Whether the realm is the default realm of the login screen.",
+			MarkdownDescription: "Whether the realm is the default realm of the login screen (defaults to `false`). " +
+				"Setting it on one realm unsets it on all others.",
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
+		},
+		"tfa": schema.SingleNestedAttribute{
+			Description: "The two-factor authentication enforced for all users of the realm.",
+			Optional:    true,
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Description: "The second factor type. Choice is between `oath` | `yubico`.",
+					Required:    true,
+					Validators:  []validator.String{stringvalidator.OneOf("oath", "yubico")},
+				},
+				"step": schema.Int64Attribute{
+					Description: "The time step of the TOTP codes, in seconds. If not set, PVE default is `30`. " +
+						"Only used by `oath`.",
+					Optional:   true,
+					Validators: []validator.Int64{int64validator.AtLeast(10)},
+				},
+				"digits": schema.Int64Attribute{
+					Description: "The number of digits of the TOTP codes. If not set, PVE default is `6`. " +
+						"Only used by `oath`.",
+					Optional:   true,
+					Validators: []validator.Int64{int64validator.Between(6, 8)},
+				},
+				"id": schema.StringAttribute{
+					Description: "The Yubico API client ID. Only used by `yubico`.",
+					Optional:    true,
+					Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+				},
+				"key": schema.StringAttribute{
+					Description: "The Yubico API secret key. Only used by `yubico`.",
+					Optional:    true,
+					Sensitive:   true,
+					Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+				},
+				"url": schema.StringAttribute{
+					Description: "The Yubico API server URL. Only used by `yubico`.",
+					Optional:    true,
+					Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+				},
+			},
+		},
+	}
+
+	maps.Copy(attributes, r.attributes)
+
+	resp.Schema = schema.Schema{
+		Description:         r.description,
+		MarkdownDescription: r.description,
+		Attributes:          attributes,
+	}
+}
+
+func (r *realmResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(config.Resource)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected config.Resource, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = cfg.Client.Access()
+}
+
+// mutableFields returns all fields of the model that can be changed after creation.
+func (r *realmResource) mutableFields(
+	ctx context.Context,
+	model realmModel,
+	diags *diag.Diagnostics,
+) access.RealmMutableFields {
+	fields := model.mutableFields(ctx, diags)
+	fields.Comment = model.base().Comment.ValueStringPointer()
+	fields.Default = proxmoxtypes.CustomBool(model.base().Default.ValueBool()).Pointer()
+	fields.TFA = model.base().TFA.toAPI()
+
+	return fields
+}
+
+// read refreshes the model from the API. Returns false if the realm does not exist.
+func (r *realmResource) read(ctx context.Context, model realmModel, diags *diag.Diagnostics) bool {
+	id := model.base().ID.ValueString()
+
+	data, err := r.client.GetRealm(ctx, id)
+	if err != nil {
+		if errors.Is(err, api.ErrResourceDoesNotExist) {
+			return false
+		}
+
+		diags.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return false
+	}
+
+	if data.Type != r.realmType {
+		diags.AddError(
+			"Unexpected Realm Type",
+			fmt.Sprintf("Realm %q is not of type %q.", id, r.realmType),
+		)
+
+		return false
+	}
+
+	model.base().importBaseFromAPI(data)
+	model.importFromAPI(ctx, data, diags)
+
+	return true
+}
+
+func (r *realmResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := r.newModel()
+	cfg := r.newModel()
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, cfg)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := &access.RealmCreateRequestBody{
+		ID:                 plan.base().ID.ValueString(),
+		Type:               r.realmType,
+		RealmFixedFields:   plan.fixedFields(),
+		RealmMutableFields: r.mutableFields(ctx, plan, &resp.Diagnostics),
+	}
+
+	cfg.writeOnlyFields(&body.RealmMutableFields)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CreateRealm(ctx, body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while creating the realm.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if !r.read(ctx, plan, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError(
+				"Unable to Create Resource",
+				fmt.Sprintf("Realm %q was not found after creation.", body.ID),
+			)
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *realmResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := r.newModel()
+
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.read(ctx, state, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.State.RemoveResource(ctx)
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *realmResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := r.newModel()
+	state := r.newModel()
+	cfg := r.newModel()
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, cfg)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planFields := r.mutableFields(ctx, plan, &resp.Diagnostics)
+	stateFields := r.mutableFields(ctx, state, &resp.Diagnostics)
+
+	body := &access.RealmUpdateRequestBody{
+		Delete:             deletedRealmFields(&planFields, &stateFields),
+		RealmMutableFields: planFields,
+	}
+
+	cfg.writeOnlyFields(&body.RealmMutableFields)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := plan.base().ID.ValueString()
+
+	err := r.client.UpdateRealm(ctx, id, body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while updating the realm.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if !r.read(ctx, plan, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError(
+				"Unable to Update Resource",
+				fmt.Sprintf("Realm %q no longer exists.", id),
+			)
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *realmResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := r.newModel()
+
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteRealm(ctx, state.base().ID.ValueString())
+	if err != nil {
+		if errors.Is(err, api.ErrResourceDoesNotExist) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while deleting the realm.\n\n"+
+				"Error: "+err.Error(),
+		)
+	}
+}
+
+func (r *realmResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// directoryAttributes returns the attributes shared by the LDAP and Active Directory realms.
+func directoryAttributes() map[string]schema.Attribute {
+	optionalString := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Description: description,
+			Optional:    true,
+			Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+		}
+	}
+
+	return map[string]schema.Attribute{
+		"server1": schema.StringAttribute{
+			Description: "The address of the primary directory server.",
+			Required:    true,
+			Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"server2": optionalString("The address of the fallback directory server."),
+		"port": schema.Int64Attribute{
+			Description: "The port of the directory servers. If not set, PVE uses the default port of the `mode`.",
+			Optional:    true,
+			Validators:  []validator.Int64{int64validator.Between(1, 65535)},
+		},
+		"mode": schema.StringAttribute{
+			Description: "The connection security. Choice is between `ldap` | `ldaps` | `ldap+starttls`. " +
+				"If not set, PVE default is `ldap`.",
+			Optional:   true,
+			Validators: []validator.String{stringvalidator.OneOf("ldap", "ldaps", "ldap+starttls")},
+		},
+		"verify": schema.BoolAttribute{
+			Description: "Verify the certificate of the directory servers. If not set, PVE default is `false`.",
+			Optional:    true,
+		},
+		"capath": optionalString("The path to the CA certificate store used to verify the server certificates."),
+		"bind_dn": optionalString("The distinguished name of the user used to bind to the directory. " +
+			"If not set, an anonymous bind is used."),
+		"bind_password":            attribute.WriteOnlyString("bind_password", "The password of the bind user.", false),
+		"bind_password_wo_version": attribute.WriteOnlyVersion("bind_password"),
+		"user_classes": stringset.ResourceAttribute(
+			"The object classes of the users. If not set, PVE default is "+
+				"`inetorgperson`, `posixaccount`, `person` and `user`.",
+			"",
+		),
+		"filter": optionalString("The LDAP filter applied to the users, " +
+			"e.g. `(memberOf=cn=pve,ou=groups,dc=example,dc=com)`."),
+		"group_dn": optionalString("The base distinguished name of the groups to synchronize."),
+		"group_filter": optionalString("The LDAP filter applied to the groups to synchronize, " +
+			"e.g. `(cn=pve-*)`."),
+		"group_classes": stringset.ResourceAttribute(
+			"The object classes of the groups. If not set, PVE default is "+
+				"`groupOfNames`, `group`, `univentionGroup` and `ipausergroup`.",
+			"",
+		),
+		"group_name_attr": optionalString("The LDAP attribute used as the group name, e.g. `cn`."),
+		"sync_attributes": optionalString("Comma separated list of `pve_attribute=ldap_attribute` pairs " +
+			"synchronized to the users, e.g. `email=mail,firstname=givenName`."),
+		"sync_defaults": schema.SingleNestedAttribute{
+			Description: "The default options used when the realm is synchronized.",
+			Optional:    true,
+			Attributes:  syncOptionsAttributes(),
+		},
+		"case_sensitive": schema.BoolAttribute{
+			Description: "Whether the user names are case sensitive. If not set, PVE default is `true`.",
+			Optional:    true,
+		},
+	}
+}
+
+// syncOptionsAttributes returns the attributes of the options of a realm synchronization.
+func syncOptionsAttributes() map[string]schema.Attribute {
+	removeVanished := stringset.ResourceAttribute(
+		"What to remove for users and groups that vanished from the directory. "+
+			"Choice is between `acl` | `entry` | `properties`.",
+		"",
+	)
+	removeVanished.Validators = append(removeVanished.Validators,
+		setvalidator.ValueStringsAre(stringvalidator.OneOf("acl", "entry", "properties")),
+	)
+
+	return map[string]schema.Attribute{
+		"enable_new": schema.BoolAttribute{
+			Description: "Enable the newly synchronized users immediately. If not set, PVE default is `true`.",
+			Optional:    true,
+		},
+		"remove_vanished": removeVanished,
+		"scope": schema.StringAttribute{
+			Description: "What to synchronize. Choice is between `users` | `groups` | `both`.",
+			Optional:    true,
+			Validators:  []validator.String{stringvalidator.OneOf("users", "groups", "both")},
+		},
+	}
+}
@@ -0,0 +1,69 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package access
+
+import (
+	"context"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/access"
+)
+
+type adRealmModel struct {
+	realmModelBase
+	directoryModel
+
+	Domain types.String `tfsdk:"domain"`
+	BaseDN types.String `tfsdk:"base_dn"`
+}
+
+func (m *adRealmModel) mutableFields(ctx context.Context, diags *diag.Diagnostics) access.RealmMutableFields {
+	fields := m.directoryFields(ctx, diags)
+	fields.Domain = m.Domain.ValueStringPointer()
+	fields.BaseDN = m.BaseDN.ValueStringPointer()
+
+	return fields
+}
+
+func (m *adRealmModel) importFromAPI(_ context.Context, data *access.RealmGetResponseData, diags *diag.Diagnostics) {
+	m.importDirectoryFromAPI(data, diags)
+	m.Domain = types.StringPointerValue(data.Domain)
+	m.BaseDN = stringValue(data.BaseDN)
+}
+
+// NewADRealmResource creates a new resource for managing Active Directory realms.
+func NewADRealmResource() resource.Resource {
+	attributes := directoryAttributes()
+	maps.Copy(attributes, map[string]schema.Attribute{
+		"domain": schema.StringAttribute{
+			Description: "The Active Directory domain, e.g. `example.com`.",
+			Required:    true,
+			Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"base_dn": schema.StringAttribute{
+			Description: "The base distinguished name of the users, required to synchronize the realm, " +
+				"e.g. `dc=example,dc=com`.",
+			Optional:   true,
+			Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+	})
+
+	return &realmResource{
+		realmType:      "ad",
+		typeNameSuffix: "_realm_ad",
+		description:    "Manages an Active Directory authentication realm of a Proxmox VE cluster.",
+		attributes:     attributes,
+		newModel:       func() realmModel { return &adRealmModel{} },
+	}
+}
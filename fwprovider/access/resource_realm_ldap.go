@@ -0,0 +1,68 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package access
+
+import (
+	"context"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/access"
+)
+
+type ldapRealmModel struct {
+	realmModelBase
+	directoryModel
+
+	BaseDN   types.String `tfsdk:"base_dn"`
+	UserAttr types.String `tfsdk:"user_attr"`
+}
+
+func (m *ldapRealmModel) mutableFields(ctx context.Context, diags *diag.Diagnostics) access.RealmMutableFields {
+	fields := m.directoryFields(ctx, diags)
+	fields.BaseDN = m.BaseDN.ValueStringPointer()
+	fields.UserAttr = m.UserAttr.ValueStringPointer()
+
+	return fields
+}
+
+func (m *ldapRealmModel) importFromAPI(_ context.Context, data *access.RealmGetResponseData, diags *diag.Diagnostics) {
+	m.importDirectoryFromAPI(data, diags)
+	m.BaseDN = types.StringPointerValue(data.BaseDN)
+	m.UserAttr = types.StringPointerValue(data.UserAttr)
+}
+
+// NewLDAPRealmResource creates a new resource for managing LDAP realms.
+func NewLDAPRealmResource() resource.Resource {
+	attributes := directoryAttributes()
+	maps.Copy(attributes, map[string]schema.Attribute{
+		"base_dn": schema.StringAttribute{
+			Description: "The base distinguished name of the users, e.g. `ou=people,dc=example,dc=com`.",
+			Required:    true,
+			Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"user_attr": schema.StringAttribute{
+			Description: "The LDAP attribute used as the user name, e.g. `uid`.",
+			Required:    true,
+			Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+	})
+
+	return &realmResource{
+		realmType:      "ldap",
+		typeNameSuffix: "_realm_ldap",
+		description:    "Manages an LDAP authentication realm of a Proxmox VE cluster.",
+		attributes:     attributes,
+		newModel:       func() realmModel { return &ldapRealmModel{} },
+	}
+}
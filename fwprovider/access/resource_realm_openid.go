@@ -0,0 +1,147 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package access
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/attribute"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/access"
+	proxmoxtypes "github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+type openIDRealmModel struct {
+	realmModelBase
+
+	IssuerURL        types.String `tfsdk:"issuer_url"`
+	ClientID         types.String `tfsdk:"client_id"`
+	ClientKey        types.String `tfsdk:"client_key"`
+	ClientKeyVersion types.Int64  `tfsdk:"client_key_wo_version"`
+	UsernameClaim    types.String `tfsdk:"username_claim"`
+	Autocreate       types.Bool   `tfsdk:"autocreate"`
+	Scopes           types.String `tfsdk:"scopes"`
+	Prompt           types.String `tfsdk:"prompt"`
+	ACRValues        types.String `tfsdk:"acr_values"`
+	GroupsClaim      types.String `tfsdk:"groups_claim"`
+	GroupsAutocreate types.Bool   `tfsdk:"groups_autocreate"`
+	GroupsOverwrite  types.Bool   `tfsdk:"groups_overwrite"`
+	QueryUserinfo    types.Bool   `tfsdk:"query_userinfo"`
+}
+
+func (m *openIDRealmModel) fixedFields() access.RealmFixedFields {
+	return access.RealmFixedFields{
+		UsernameClaim: m.UsernameClaim.ValueStringPointer(),
+	}
+}
+
+func (m *openIDRealmModel) mutableFields(_ context.Context, _ *diag.Diagnostics) access.RealmMutableFields {
+	return access.RealmMutableFields{
+		IssuerURL:        m.IssuerURL.ValueStringPointer(),
+		ClientID:         m.ClientID.ValueStringPointer(),
+		Autocreate:       proxmoxtypes.CustomBoolPtr(m.Autocreate.ValueBoolPointer()),
+		Scopes:           m.Scopes.ValueStringPointer(),
+		Prompt:           m.Prompt.ValueStringPointer(),
+		ACRValues:        m.ACRValues.ValueStringPointer(),
+		GroupsClaim:      m.GroupsClaim.ValueStringPointer(),
+		GroupsAutocreate: proxmoxtypes.CustomBoolPtr(m.GroupsAutocreate.ValueBoolPointer()),
+		GroupsOverwrite:  proxmoxtypes.CustomBoolPtr(m.GroupsOverwrite.ValueBoolPointer()),
+		QueryUserinfo:    proxmoxtypes.CustomBoolPtr(m.QueryUserinfo.ValueBoolPointer()),
+	}
+}
+
+func (m *openIDRealmModel) writeOnlyFields(fields *access.RealmMutableFields) {
+	fields.ClientKey = m.ClientKey.ValueStringPointer()
+}
+
+func (m *openIDRealmModel) importFromAPI(_ context.Context, data *access.RealmGetResponseData, _ *diag.Diagnostics) {
+	m.IssuerURL = types.StringPointerValue(data.IssuerURL)
+	m.ClientID = types.StringPointerValue(data.ClientID)
+	m.UsernameClaim = stringValue(data.UsernameClaim)
+	m.Autocreate = types.BoolPointerValue(data.Autocreate.PointerBool())
+	m.Scopes = stringValue(data.Scopes)
+	m.Prompt = stringValue(data.Prompt)
+	m.ACRValues = stringValue(data.ACRValues)
+	m.GroupsClaim = stringValue(data.GroupsClaim)
+	m.GroupsAutocreate = types.BoolPointerValue(data.GroupsAutocreate.PointerBool())
+	m.GroupsOverwrite = types.BoolPointerValue(data.GroupsOverwrite.PointerBool())
+	m.QueryUserinfo = types.BoolPointerValue(data.QueryUserinfo.PointerBool())
+}
+
+// NewOpenIDRealmResource creates a new resource for managing OpenID Connect realms.
+func NewOpenIDRealmResource() resource.Resource {
+	optionalString := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Description: description,
+			Optional:    true,
+			Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+		}
+	}
+
+	return &realmResource{
+		realmType:      "openid",
+		typeNameSuffix: "_realm_openid",
+		description:    "Manages an OpenID Connect authentication realm of a Proxmox VE cluster.",
+		attributes: map[string]schema.Attribute{
+			"issuer_url": schema.StringAttribute{
+				Description: "The URL of the OpenID Connect provider, e.g. `https://sso.example.com/realms/pve`.",
+				Required:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"client_id": schema.StringAttribute{
+				Description: "The client ID registered at the OpenID Connect provider.",
+				Required:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"client_key": attribute.WriteOnlyString("client_key",
+				"The client secret registered at the OpenID Connect provider.", false),
+			"client_key_wo_version": attribute.WriteOnlyVersion("client_key"),
+			"username_claim": schema.StringAttribute{
+				Description: "The claim used to build the user names. If not set, PVE default is `sub`. " +
+					"Can only be set when the realm is created.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"autocreate": schema.BoolAttribute{
+				Description: "Automatically create the users on their first login. If not set, PVE default is `false`.",
+				Optional:    true,
+			},
+			"scopes": optionalString("Space separated list of the scopes requested from the provider. " +
+				"If not set, PVE default is `email profile`."),
+			"prompt": optionalString("The `prompt` parameter sent to the provider, e.g. `login` or `consent`."),
+			"acr_values": optionalString("Space separated list of the authentication context class references " +
+				"requested from the provider."),
+			"groups_claim": optionalString("The claim containing the groups of the user."),
+			"groups_autocreate": schema.BoolAttribute{
+				Description: "Automatically create the groups of the `groups_claim`. If not set, PVE default is `false`.",
+				Optional:    true,
+			},
+			"groups_overwrite": schema.BoolAttribute{
+				Description: "Replace the groups of the user with the groups of the `groups_claim` on every login. " +
+					"If not set, PVE default is `false`.",
+				Optional: true,
+			},
+			"query_userinfo": schema.BoolAttribute{
+				Description: "Query the userinfo endpoint of the provider for the claims. " +
+					"If not set, PVE default is `true`.",
+				Optional: true,
+			},
+		},
+		newModel: func() realmModel { return &openIDRealmModel{} },
+	}
+}
@@ -0,0 +1,241 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package access
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/attribute"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/config"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/access"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+	proxmoxtypes "github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+var (
+	_ resource.Resource              = &realmSyncResource{}
+	_ resource.ResourceWithConfigure = &realmSyncResource{}
+)
+
+type realmSyncModel struct {
+	ID             types.String `tfsdk:"id"`
+	Realm          types.String `tfsdk:"realm"`
+	Scope          types.String `tfsdk:"scope"`
+	RemoveVanished types.Set    `tfsdk:"remove_vanished"`
+	EnableNew      types.Bool   `tfsdk:"enable_new"`
+	DryRun         types.Bool   `tfsdk:"dry_run"`
+	Triggers       types.Map    `tfsdk:"triggers"`
+}
+
+type realmSyncResource struct {
+	client *access.Client
+}
+
+// NewRealmSyncResource creates a new resource for synchronizing the users and groups of a realm.
+func NewRealmSyncResource() resource.Resource {
+	return &realmSyncResource{}
+}
+
+func (r *realmSyncResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_realm_sync"
+}
+
+func (r *realmSyncResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(config.Resource)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected config.Resource, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = cfg.Client.Access()
+}
+
+func (r *realmSyncResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Synchronizes the users and groups of an LDAP or Active Directory realm. " +
+			"The synchronization runs when the resource is created and whenever any of its attributes change. " +
+			"Destroying the resource does not change the synchronized users and groups.",
+		Attributes: map[string]schema.Attribute{
+			"id": attribute.ResourceID(),
+			"realm": schema.StringAttribute{
+				Description: "The identifier of the realm to synchronize.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"scope": schema.StringAttribute{
+				Description: "What to synchronize. Choice is between `users` | `groups` | `both`. " +
+					"If not set, the `sync_defaults` of the realm are used.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{stringvalidator.OneOf("users", "groups", "both")},
+			},
+			"remove_vanished": schema.SetAttribute{
+				Description: "What to remove for users and groups that vanished from the directory. " +
+					"Choice is between `acl` | `entry` | `properties`. " +
+					"If not set, the `sync_defaults` of the realm are used.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf("acl", "entry", "properties")),
+				},
+			},
+			"enable_new": schema.BoolAttribute{
+				Description: "Enable the newly synchronized users immediately. " +
+					"If not set, the `sync_defaults` of the realm are used.",
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"dry_run": schema.BoolAttribute{
+				Description: "Only report what would be synchronized, without changing anything.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, run the synchronization again.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *realmSyncResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan realmSyncModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := &access.RealmSyncRequestBody{
+		DryRun: proxmoxtypes.CustomBoolPtr(plan.DryRun.ValueBoolPointer()),
+		RealmSyncOptions: access.RealmSyncOptions{
+			EnableNew: proxmoxtypes.CustomBoolPtr(plan.EnableNew.ValueBoolPointer()),
+			Scope:     plan.Scope.ValueStringPointer(),
+		},
+	}
+
+	if !plan.RemoveVanished.IsNull() && !plan.RemoveVanished.IsUnknown() {
+		var removeVanished []string
+
+		resp.Diagnostics.Append(plan.RemoveVanished.ElementsAs(ctx, &removeVanished, false)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		s := "none"
+		if len(removeVanished) > 0 {
+			s = strings.Join(removeVanished, ";")
+		}
+
+		body.RemoveVanished = &s
+	}
+
+	err := r.client.SyncRealm(ctx, plan.Realm.ValueString(), body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while synchronizing the realm.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	plan.ID = plan.Realm
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *realmSyncResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state realmSyncModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.GetRealm(ctx, state.Realm.ValueString())
+	if err != nil {
+		if errors.Is(err, api.ErrResourceDoesNotExist) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *realmSyncResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+	// all attributes force a replacement, so there is nothing to update
+}
+
+func (r *realmSyncResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// nothing to delete, the synchronized users and groups are left as is
+}
@@ -0,0 +1,146 @@
+//go:build acceptance || all
+
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package access_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/test"
+)
+
+func TestAccResourceRealm(t *testing.T) {
+	te := test.InitEnvironment(t)
+
+	tests := []struct {
+		name  string
+		steps []resource.TestStep
+	}{
+		{"create, update and import LDAP realm", []resource.TestStep{
+			{
+				Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_realm_ldap" "acc_realm" {
+					id            = "acc-ldap"
+					comment       = "acceptance"
+					server1       = "ldap.example.com"
+					base_dn       = "ou=people,dc=example,dc=com"
+					user_attr     = "uid"
+					bind_dn       = "cn=pve,dc=example,dc=com"
+					bind_password = "secret"
+
+					sync_defaults = {
+						scope           = "both"
+						remove_vanished = ["acl", "entry"]
+					}
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					test.ResourceAttributes("proxmox_virtual_environment_realm_ldap.acc_realm", map[string]string{
+						"id":                              "acc-ldap",
+						"comment":                         "acceptance",
+						"default":                         "false",
+						"sync_defaults.scope":             "both",
+						"sync_defaults.remove_vanished.#": "2",
+					}),
+					test.NoResourceAttributesSet("proxmox_virtual_environment_realm_ldap.acc_realm", []string{
+						"bind_password",
+						"server2",
+						"tfa",
+					}),
+				),
+			},
+			{
+				Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_realm_ldap" "acc_realm" {
+					id                       = "acc-ldap"
+					comment                  = "acceptance"
+					server1                  = "ldap.example.com"
+					base_dn                  = "ou=people,dc=example,dc=com"
+					user_attr                = "uid"
+					bind_dn                  = "cn=pve,dc=example,dc=com"
+					bind_password            = "new-secret"
+					bind_password_wo_version = 1
+
+					sync_defaults = {
+						scope           = "both"
+						remove_vanished = ["acl", "entry"]
+					}
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					test.ResourceAttributes("proxmox_virtual_environment_realm_ldap.acc_realm", map[string]string{
+						"bind_password_wo_version": "1",
+					}),
+					test.NoResourceAttributesSet("proxmox_virtual_environment_realm_ldap.acc_realm", []string{
+						"bind_password",
+					}),
+				),
+			},
+			{
+				Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_realm_ldap" "acc_realm" {
+					id        = "acc-ldap"
+					server1   = "ldap.example.com"
+					server2   = "ldap2.example.com"
+					mode      = "ldaps"
+					base_dn   = "ou=people,dc=example,dc=com"
+					user_attr = "uid"
+
+					tfa = {
+						type   = "oath"
+						digits = 8
+					}
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					test.ResourceAttributes("proxmox_virtual_environment_realm_ldap.acc_realm", map[string]string{
+						"server2":    "ldap2.example.com",
+						"mode":       "ldaps",
+						"tfa.type":   "oath",
+						"tfa.digits": "8",
+					}),
+					test.NoResourceAttributesSet("proxmox_virtual_environment_realm_ldap.acc_realm", []string{
+						"bind_dn",
+						"comment",
+						"sync_defaults",
+					}),
+				),
+			},
+			{
+				ResourceName:      "proxmox_virtual_environment_realm_ldap.acc_realm",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		}},
+		{"create OpenID realm", []resource.TestStep{{
+			Config: te.RenderConfig(`
+			resource "proxmox_virtual_environment_realm_openid" "acc_realm" {
+				id             = "acc-openid"
+				issuer_url     = "https://sso.example.com/realms/pve"
+				client_id      = "proxmox"
+				client_key     = "secret"
+				username_claim = "email"
+				autocreate     = true
+			}`),
+			Check: test.ResourceAttributes("proxmox_virtual_environment_realm_openid.acc_realm", map[string]string{
+				"issuer_url":     "https://sso.example.com/realms/pve",
+				"client_id":      "proxmox",
+				"username_claim": "email",
+				"autocreate":     "true",
+			}),
+		}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: te.AccProviders,
+				Steps:                    tt.steps,
+			})
+		})
+	}
+}
@@ -7,10 +7,16 @@
 package attribute
 
 import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
 // ResourceID generates an attribute definition suitable for the always-present resource `id` attribute.
@@ -39,3 +45,34 @@ func ShouldBeRemoved(plan attr.Value, state attr.Value, isClone bool) bool {
 func IsDefined(v attr.Value) bool {
 	return !v.IsNull() && !v.IsUnknown()
 }
+
+// WriteOnlyString generates a sensitive string attribute that is sent to PVE but never stored in the state.
+// The resource must also have the attribute generated by WriteOnlyVersion for the same name.
+func WriteOnlyString(name string, description string, required bool) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description:         description,
+		MarkdownDescription: WriteOnlyDescription(name, description),
+		Required:            required,
+		Optional:            !required,
+		Sensitive:           true,
+		WriteOnly:           true,
+		Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+	}
+}
+
+// WriteOnlyDescription appends to the description of the write-only attribute with the given name how to update it.
+func WriteOnlyDescription(name string, description string) string {
+	return fmt.Sprintf("%s Not stored in the state, change `%s_wo_version` to update it.", description, name)
+}
+
+// WriteOnlyVersion generates the `<name>_wo_version` attribute of the write-only attribute with the given name.
+// Unlike the write-only value, the version is stored in the state, so changing it triggers an update.
+func WriteOnlyVersion(name string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Description: fmt.Sprintf("The version of the write-only `%s`, change it to update `%s`.", name, name),
+		Optional:    true,
+		Validators: []validator.Int64{
+			int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName(name)),
+		},
+	}
+}
@@ -0,0 +1,290 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/cluster/backup"
+	proxmoxtypes "github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+const (
+	selectionModeAll     = "all"
+	selectionModeInclude = "include"
+	selectionModeExclude = "exclude"
+	selectionModePool    = "pool"
+)
+
+type backupJobModel struct {
+	ID               types.String       `tfsdk:"id"`
+	JobID            types.String       `tfsdk:"job_id"`
+	Schedule         types.String       `tfsdk:"schedule"`
+	Enabled          types.Bool         `tfsdk:"enabled"`
+	Comment          types.String       `tfsdk:"comment"`
+	Storage          types.String       `tfsdk:"storage"`
+	Node             types.String       `tfsdk:"node"`
+	SelectionMode    types.String       `tfsdk:"selection_mode"`
+	VMIDs            types.Set          `tfsdk:"vm_ids"`
+	Pool             types.String       `tfsdk:"pool"`
+	Compress         types.String       `tfsdk:"compress"`
+	Mode             types.String       `tfsdk:"mode"`
+	PruneBackups     *pruneBackupsModel `tfsdk:"prune_backups"`
+	Fleecing         *fleecingModel     `tfsdk:"fleecing"`
+	MailTo           types.Set          `tfsdk:"mail_to"`
+	MailNotification types.String       `tfsdk:"mail_notification"`
+	NotificationMode types.String       `tfsdk:"notification_mode"`
+	RepeatMissed     types.Bool         `tfsdk:"repeat_missed"`
+	NextRun          types.String       `tfsdk:"next_run"`
+}
+
+type pruneBackupsModel struct {
+	KeepAll     types.Bool  `tfsdk:"keep_all"`
+	KeepLast    types.Int64 `tfsdk:"keep_last"`
+	KeepHourly  types.Int64 `tfsdk:"keep_hourly"`
+	KeepDaily   types.Int64 `tfsdk:"keep_daily"`
+	KeepWeekly  types.Int64 `tfsdk:"keep_weekly"`
+	KeepMonthly types.Int64 `tfsdk:"keep_monthly"`
+	KeepYearly  types.Int64 `tfsdk:"keep_yearly"`
+}
+
+type fleecingModel struct {
+	Enabled types.Bool   `tfsdk:"enabled"`
+	Storage types.String `tfsdk:"storage"`
+}
+
+// toAPI converts the model to the fields sent to the backup job API.
+func (m *backupJobModel) toAPI(ctx context.Context) (*backup.JobDataBase, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data := &backup.JobDataBase{
+		Schedule:         m.Schedule.ValueString(),
+		Enabled:          proxmoxtypes.CustomBoolPtr(m.Enabled.ValueBoolPointer()),
+		Comment:          m.Comment.ValueStringPointer(),
+		Storage:          m.Storage.ValueStringPointer(),
+		Node:             m.Node.ValueStringPointer(),
+		Compress:         m.Compress.ValueStringPointer(),
+		Mode:             m.Mode.ValueStringPointer(),
+		MailNotification: m.MailNotification.ValueStringPointer(),
+		NotificationMode: m.NotificationMode.ValueStringPointer(),
+		RepeatMissed:     proxmoxtypes.CustomBoolPtr(m.RepeatMissed.ValueBoolPointer()),
+	}
+
+	var vmIDs []int64
+
+	if !m.VMIDs.IsNull() && !m.VMIDs.IsUnknown() {
+		diags.Append(m.VMIDs.ElementsAs(ctx, &vmIDs, false)...)
+	}
+
+	vmIDList := make([]string, len(vmIDs))
+	for i, id := range vmIDs {
+		vmIDList[i] = strconv.FormatInt(id, 10)
+	}
+
+	switch m.SelectionMode.ValueString() {
+	case selectionModeAll:
+		data.All = proxmoxtypes.CustomBool(true).Pointer()
+	case selectionModeExclude:
+		data.All = proxmoxtypes.CustomBool(true).Pointer()
+		data.Exclude = joinNonEmpty(vmIDList)
+	case selectionModeInclude:
+		data.VMID = joinNonEmpty(vmIDList)
+	case selectionModePool:
+		data.Pool = m.Pool.ValueStringPointer()
+	}
+
+	if m.PruneBackups != nil {
+		data.PruneBackups = &proxmoxtypes.CustomPruneBackups{
+			KeepAll:     proxmoxtypes.CustomBoolPtr(m.PruneBackups.KeepAll.ValueBoolPointer()),
+			KeepLast:    m.PruneBackups.KeepLast.ValueInt64Pointer(),
+			KeepHourly:  m.PruneBackups.KeepHourly.ValueInt64Pointer(),
+			KeepDaily:   m.PruneBackups.KeepDaily.ValueInt64Pointer(),
+			KeepWeekly:  m.PruneBackups.KeepWeekly.ValueInt64Pointer(),
+			KeepMonthly: m.PruneBackups.KeepMonthly.ValueInt64Pointer(),
+			KeepYearly:  m.PruneBackups.KeepYearly.ValueInt64Pointer(),
+		}
+	}
+
+	if m.Fleecing != nil {
+		data.Fleecing = &backup.CustomFleecing{
+			Enabled: proxmoxtypes.CustomBoolPtr(m.Fleecing.Enabled.ValueBoolPointer()),
+			Storage: m.Fleecing.Storage.ValueStringPointer(),
+		}
+	}
+
+	if !m.MailTo.IsNull() && !m.MailTo.IsUnknown() {
+		var mailTo []string
+
+		diags.Append(m.MailTo.ElementsAs(ctx, &mailTo, false)...)
+		data.MailTo = joinNonEmpty(mailTo)
+	}
+
+	return data, diags
+}
+
+// importFromAPI sets the model fields from the backup job API response.
+func (m *backupJobModel) importFromAPI(data *backup.JobGetResponseData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(data.ID)
+	m.JobID = types.StringValue(data.ID)
+	m.Schedule = types.StringValue(data.Schedule)
+	m.Enabled = types.BoolValue(data.Enabled == nil || bool(*data.Enabled))
+	m.Comment = types.StringPointerValue(data.Comment)
+	m.Storage = types.StringPointerValue(data.Storage)
+	m.Node = types.StringPointerValue(data.Node)
+	m.Compress = types.StringPointerValue(data.Compress)
+	m.Mode = types.StringPointerValue(data.Mode)
+	m.MailNotification = types.StringPointerValue(data.MailNotification)
+	m.NotificationMode = types.StringPointerValue(data.NotificationMode)
+	m.RepeatMissed = types.BoolPointerValue(data.RepeatMissed.PointerBool())
+	m.Pool = types.StringNull()
+	m.VMIDs = types.SetNull(types.Int64Type)
+
+	var d diag.Diagnostics
+
+	switch {
+	case data.Pool != nil:
+		m.SelectionMode = types.StringValue(selectionModePool)
+		m.Pool = types.StringPointerValue(data.Pool)
+	case data.All != nil && bool(*data.All) && data.Exclude != nil && *data.Exclude != "":
+		m.SelectionMode = types.StringValue(selectionModeExclude)
+		m.VMIDs, d = parseVMIDs(*data.Exclude)
+	case data.All != nil && bool(*data.All):
+		m.SelectionMode = types.StringValue(selectionModeAll)
+	default:
+		m.SelectionMode = types.StringValue(selectionModeInclude)
+
+		if data.VMID != nil {
+			m.VMIDs, d = parseVMIDs(*data.VMID)
+		}
+	}
+
+	diags.Append(d...)
+
+	m.PruneBackups = nil
+
+	if data.PruneBackups != nil {
+		m.PruneBackups = &pruneBackupsModel{
+			KeepAll:     types.BoolPointerValue(data.PruneBackups.KeepAll.PointerBool()),
+			KeepLast:    types.Int64PointerValue(data.PruneBackups.KeepLast),
+			KeepHourly:  types.Int64PointerValue(data.PruneBackups.KeepHourly),
+			KeepDaily:   types.Int64PointerValue(data.PruneBackups.KeepDaily),
+			KeepWeekly:  types.Int64PointerValue(data.PruneBackups.KeepWeekly),
+			KeepMonthly: types.Int64PointerValue(data.PruneBackups.KeepMonthly),
+			KeepYearly:  types.Int64PointerValue(data.PruneBackups.KeepYearly),
+		}
+	}
+
+	m.Fleecing = nil
+
+	if data.Fleecing != nil {
+		m.Fleecing = &fleecingModel{
+			Enabled: types.BoolPointerValue(data.Fleecing.Enabled.PointerBool()),
+			Storage: types.StringPointerValue(data.Fleecing.Storage),
+		}
+	}
+
+	m.MailTo = types.SetNull(types.StringType)
+
+	if data.MailTo != nil && *data.MailTo != "" {
+		values := []attr.Value{}
+
+		for _, s := range strings.Split(*data.MailTo, ",") {
+			values = append(values, types.StringValue(strings.TrimSpace(s)))
+		}
+
+		m.MailTo, d = types.SetValue(types.StringType, values)
+		diags.Append(d...)
+	}
+
+	m.NextRun = types.StringNull()
+
+	if data.NextRun != nil {
+		m.NextRun = types.StringValue(time.Time(*data.NextRun).UTC().Format(time.RFC3339))
+	}
+
+	return diags
+}
+
+func parseVMIDs(s string) (types.Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	values := []attr.Value{}
+
+	for _, v := range strings.Split(s, ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			diags.AddError("Could not parse guest ID", fmt.Sprintf("Invalid guest ID %q: %s", v, err))
+
+			continue
+		}
+
+		values = append(values, types.Int64Value(id))
+	}
+
+	set, d := types.SetValue(types.Int64Type, values)
+	diags.Append(d...)
+
+	return set, diags
+}
+
+func joinNonEmpty(values []string) *string {
+	if len(values) == 0 {
+		return nil
+	}
+
+	s := strings.Join(values, ",")
+
+	return &s
+}
+
+// deletedFields returns the API fields that are set in the current state but not in the plan.
+func deletedFields(plan, state *backup.JobDataBase) []string {
+	var toDelete []string
+
+	for _, f := range []struct {
+		name      string
+		inPlan    bool
+		inCurrent bool
+	}{
+		{"comment", plan.Comment != nil, state.Comment != nil},
+		{"storage", plan.Storage != nil, state.Storage != nil},
+		{"node", plan.Node != nil, state.Node != nil},
+		{"all", plan.All != nil, state.All != nil},
+		{"vmid", plan.VMID != nil, state.VMID != nil},
+		{"exclude", plan.Exclude != nil, state.Exclude != nil},
+		{"pool", plan.Pool != nil, state.Pool != nil},
+		{"compress", plan.Compress != nil, state.Compress != nil},
+		{"mode", plan.Mode != nil, state.Mode != nil},
+		{"prune-backups", plan.PruneBackups != nil, state.PruneBackups != nil},
+		{"fleecing", plan.Fleecing != nil, state.Fleecing != nil},
+		{"mailto", plan.MailTo != nil, state.MailTo != nil},
+		{"mailnotification", plan.MailNotification != nil, state.MailNotification != nil},
+		{"notification-mode", plan.NotificationMode != nil, state.NotificationMode != nil},
+		{"repeat-missed", plan.RepeatMissed != nil, state.RepeatMissed != nil},
+	} {
+		if !f.inPlan && f.inCurrent {
+			toDelete = append(toDelete, f.name)
+		}
+	}
+
+	return toDelete
+}
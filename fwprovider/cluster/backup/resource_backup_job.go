@@ -0,0 +1,495 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/attribute"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/config"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/cluster/backup"
+)
+
+var (
+	_ resource.Resource                     = &backupJobResource{}
+	_ resource.ResourceWithConfigure        = &backupJobResource{}
+	_ resource.ResourceWithImportState      = &backupJobResource{}
+	_ resource.ResourceWithConfigValidators = &backupJobResource{}
+	_ resource.ResourceWithValidateConfig   = &backupJobResource{}
+)
+
+type backupJobResource struct {
+	client *backup.Client
+}
+
+// NewBackupJobResource creates a new resource for managing cluster backup jobs.
+func NewBackupJobResource() resource.Resource {
+	return &backupJobResource{}
+}
+
+func (r *backupJobResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_backup_job"
+}
+
+func (r *backupJobResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(config.Resource)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected config.Resource, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = cfg.Client.Cluster().Backup()
+}
+
+func (r *backupJobResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	keepAttribute := func(period string) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			Description: fmt.Sprintf("Keep backups for the last N %s.", period),
+			Optional:    true,
+			Validators:  []validator.Int64{int64validator.AtLeast(1)},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a scheduled vzdump backup job of a Proxmox VE cluster.",
+		Attributes: map[string]schema.Attribute{
+			"id": attribute.ResourceID(),
+			"job_id": schema.StringAttribute{
+				Description: "The identifier of the backup job.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9\-_]{2,49}$`),
+						"must start with a letter, be composed of letters, numbers, '-' and '_', "+
+							"and must be between 3 and 50 characters long",
+					),
+				},
+			},
+			"schedule": schema.StringAttribute{
+				Description: "The backup schedule in systemd calendar event format (e.g. `sun 01:00`).",
+				Required:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the backup job is enabled (defaults to `true`).",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"comment": schema.StringAttribute{
+				Description: "The description of the backup job.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"storage": schema.StringAttribute{
+				Description: "The identifier of the storage the backups are written to. " +
+					"If not set, PVE default is `local`.",
+				Optional:   true,
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"node": schema.StringAttribute{
+				Description: "Only run the backup job on this node. If not set, the job runs on all nodes.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"selection_mode": schema.StringAttribute{
+				Description: "How the guests to back up are selected. Choice is between `all` | `include` | " +
+					"`exclude` | `pool`. With `include`, only the guests listed in `vm_ids` are backed up. " +
+					"With `exclude`, all guests except those listed in `vm_ids` are backed up. " +
+					"With `pool`, all guests in `pool` are backed up.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(selectionModeAll, selectionModeInclude, selectionModeExclude, selectionModePool),
+				},
+			},
+			"vm_ids": schema.SetAttribute{
+				Description: "The IDs of the guests to include or exclude, depending on `selection_mode`.",
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueInt64sAre(int64validator.AtLeast(100)),
+				},
+			},
+			"pool": schema.StringAttribute{
+				Description: "The pool whose guests are backed up, when `selection_mode` is `pool`.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"compress": schema.StringAttribute{
+				Description: "The compression algorithm. Choice is between `0` | `1` | `gzip` | `lz4` | `zstd`. " +
+					"If not set, PVE default is `0` (no compression).",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("0", "1", "gzip", "lz4", "zstd"),
+				},
+			},
+			"mode": schema.StringAttribute{
+				Description: "The backup mode. Choice is between `snapshot` | `suspend` | `stop`. " +
+					"If not set, PVE default is `snapshot`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("snapshot", "suspend", "stop"),
+				},
+			},
+			"prune_backups": schema.SingleNestedAttribute{
+				Description: "The retention options of the backups created by this job. " +
+					"If not set, the retention options of the storage are used.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"keep_all": schema.BoolAttribute{
+						Description: "Keep all backups. Conflicts with the other options when `true`.",
+						Optional:    true,
+					},
+					"keep_last":    keepAttribute("backups"),
+					"keep_hourly":  keepAttribute("hours"),
+					"keep_daily":   keepAttribute("days"),
+					"keep_weekly":  keepAttribute("weeks"),
+					"keep_monthly": keepAttribute("months"),
+					"keep_yearly":  keepAttribute("years"),
+				},
+			},
+			"fleecing": schema.SingleNestedAttribute{
+				Description: "The backup fleecing options. With fleecing, guest writes to blocks that have " +
+					"not been backed up yet are first copied to a fleecing image on the given storage.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Description: "Whether backup fleecing is enabled.",
+						Optional:    true,
+					},
+					"storage": schema.StringAttribute{
+						Description: "The storage to use for the fleecing images.",
+						Optional:    true,
+						Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+					},
+				},
+			},
+			"mail_to": schema.SetAttribute{
+				Description: "The email addresses that receive the notifications.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators:  []validator.Set{setvalidator.SizeAtLeast(1)},
+			},
+			"mail_notification": schema.StringAttribute{
+				Description: "When to send legacy email notifications. Choice is between `always` | `failure`. " +
+					"If not set, PVE default is `always`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("always", "failure"),
+				},
+			},
+			"notification_mode": schema.StringAttribute{
+				Description: "The notification mode. Choice is between `auto` | `legacy-sendmail` | " +
+					"`notification-system`. If not set, PVE default is `auto`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("auto", "legacy-sendmail", "notification-system"),
+				},
+			},
+			"repeat_missed": schema.BoolAttribute{
+				Description: "Whether to run the job as soon as possible if it was missed while the " +
+					"scheduler was not running. If not set, PVE default is `false`.",
+				Optional: true,
+			},
+			"next_run": schema.StringAttribute{
+				Description: "The time of the next scheduled run, in RFC 3339 format.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *backupJobResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("vm_ids"),
+			path.MatchRoot("pool"),
+		),
+	}
+}
+
+func (r *backupJobResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var data backupJobModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.SelectionMode.IsUnknown() {
+		return
+	}
+
+	mode := data.SelectionMode.ValueString()
+
+	switch {
+	case (mode == selectionModeInclude || mode == selectionModeExclude) && data.VMIDs.IsNull():
+		resp.Diagnostics.AddAttributeError(
+			path.Root("vm_ids"),
+			"Missing Attribute Configuration",
+			fmt.Sprintf("`vm_ids` must be set when `selection_mode` is %q.", mode),
+		)
+	case mode != selectionModeInclude && mode != selectionModeExclude && !data.VMIDs.IsNull():
+		resp.Diagnostics.AddAttributeError(
+			path.Root("vm_ids"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("`vm_ids` can not be set when `selection_mode` is %q.", mode),
+		)
+	case mode == selectionModePool && data.Pool.IsNull():
+		resp.Diagnostics.AddAttributeError(
+			path.Root("pool"),
+			"Missing Attribute Configuration",
+			"`pool` must be set when `selection_mode` is \"pool\".",
+		)
+	case mode != selectionModePool && !data.Pool.IsNull():
+		resp.Diagnostics.AddAttributeError(
+			path.Root("pool"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("`pool` can not be set when `selection_mode` is %q.", mode),
+		)
+	}
+}
+
+func (r *backupJobResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan backupJobModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, diags := plan.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Create(ctx, &backup.JobCreateRequestBody{
+		JobDataBase: *data,
+		ID:          plan.JobID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while creating the resource create request.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	r.readBack(ctx, plan.JobID.ValueString(), &plan, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *backupJobResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state backupJobModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := r.client.Get(ctx, state.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, api.ErrResourceDoesNotExist) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(state.importFromAPI(data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *backupJobResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state backupJobModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planData, diags := plan.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	stateData, diags := state.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Update(ctx, state.ID.ValueString(), &backup.JobUpdateRequestBody{
+		JobDataBase: *planData,
+		Delete:      deletedFields(planData, stateData),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while creating the resource update request.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	r.readBack(ctx, state.ID.ValueString(), &plan, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *backupJobResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state backupJobModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Delete(ctx, state.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, api.ErrResourceDoesNotExist) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while creating the resource delete request.\n\n"+
+				"Error: "+err.Error(),
+		)
+	}
+}
+
+func (r *backupJobResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	data, err := r.client.Get(ctx, req.ID)
+	if err != nil {
+		if errors.Is(err, api.ErrResourceDoesNotExist) {
+			resp.Diagnostics.AddError(
+				"Resource does not exist",
+				"Resource you try to import does not exist.\n\n"+
+					"Error: "+err.Error(),
+			)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Import Resource",
+			"An unexpected error occurred while attempting to import resource state.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	state := &backupJobModel{}
+	resp.Diagnostics.Append(state.importFromAPI(data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// readBack reads the backup job from the API and updates the model with the computed values.
+func (r *backupJobResource) readBack(
+	ctx context.Context,
+	id string,
+	model *backupJobModel,
+	diags *diag.Diagnostics,
+) {
+	data, err := r.client.Get(ctx, id)
+	if err != nil {
+		diags.AddError(
+			"Unable to Read Resource",
+			"An unexpected error occurred while reading back the backup job.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	diags.Append(model.importFromAPI(data)...)
+}
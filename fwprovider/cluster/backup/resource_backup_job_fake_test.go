@@ -0,0 +1,137 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package backup_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/cluster/backup"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/config"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/test"
+	"github.com/bpg/terraform-provider-proxmox/proxmox"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+)
+
+// TestBackupJobLifecycle runs the create, read, update and delete operations of the backup job resource
+// against the fake API, so unlike the acceptance tests it runs without a Proxmox VE cluster.
+func TestBackupJobLifecycle(t *testing.T) {
+	t.Parallel()
+
+	te := test.InitFakeEnvironment(t)
+	ctx := t.Context()
+
+	r := backup.NewBackupJobResource()
+
+	configureResp := &resource.ConfigureResponse{}
+	r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{
+		ProviderData: config.Resource{Client: proxmox.NewClient(te.Client(), nil, "")},
+	}, configureResp)
+	require.False(t, configureResp.Diagnostics.HasError(), configureResp.Diagnostics)
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError(), schemaResp.Diagnostics)
+
+	// plan returns the plan of the given attributes, the other ones are null
+	plan := func(attrs map[string]any) tfsdk.Plan {
+		p := tfsdk.Plan{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		}
+
+		for k, v := range attrs {
+			require.False(t, p.SetAttribute(ctx, path.Root(k), v).HasError())
+		}
+
+		return p
+	}
+
+	emptyState := func() tfsdk.State {
+		return tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		}
+	}
+
+	jobs := te.ClusterClient().Backup()
+
+	createResp := &resource.CreateResponse{State: emptyState()}
+	r.Create(ctx, resource.CreateRequest{Plan: plan(map[string]any{
+		"job_id":         "daily",
+		"schedule":       "*-*-* 02:00",
+		"enabled":        true,
+		"comment":        "Daily backup",
+		"storage":        "local",
+		"selection_mode": "include",
+		"vm_ids":         []int64{100, 101},
+	})}, createResp)
+	require.False(t, createResp.Diagnostics.HasError(), createResp.Diagnostics)
+
+	var id, nextRun types.String
+
+	require.False(t, createResp.State.GetAttribute(ctx, path.Root("id"), &id).HasError())
+	require.False(t, createResp.State.GetAttribute(ctx, path.Root("next_run"), &nextRun).HasError())
+	assert.Equal(t, "daily", id.ValueString())
+	assert.False(t, nextRun.IsNull())
+
+	job, err := jobs.Get(ctx, "daily")
+	require.NoError(t, err)
+	assert.Equal(t, "*-*-* 02:00", job.Schedule)
+	assert.Equal(t, "Daily backup", *job.Comment)
+	assert.Equal(t, "100,101", *job.VMID)
+
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	require.False(t, readResp.Diagnostics.HasError(), readResp.Diagnostics)
+	assert.True(t, readResp.State.Raw.Equal(createResp.State.Raw))
+
+	updateResp := &resource.UpdateResponse{State: readResp.State}
+	r.Update(ctx, resource.UpdateRequest{
+		State: readResp.State,
+		Plan: plan(map[string]any{
+			"id":             "daily",
+			"job_id":         "daily",
+			"schedule":       "sat 03:00",
+			"enabled":        false,
+			"storage":        "local",
+			"selection_mode": "all",
+		}),
+	}, updateResp)
+	require.False(t, updateResp.Diagnostics.HasError(), updateResp.Diagnostics)
+
+	require.False(t, updateResp.State.GetAttribute(ctx, path.Root("next_run"), &nextRun).HasError())
+	assert.True(t, nextRun.IsNull())
+
+	job, err = jobs.Get(ctx, "daily")
+	require.NoError(t, err)
+	assert.Equal(t, "sat 03:00", job.Schedule)
+	assert.False(t, bool(*job.Enabled))
+	assert.True(t, bool(*job.All))
+	assert.Nil(t, job.Comment)
+	assert.Nil(t, job.VMID)
+
+	deleteResp := &resource.DeleteResponse{State: updateResp.State}
+	r.Delete(ctx, resource.DeleteRequest{State: updateResp.State}, deleteResp)
+	require.False(t, deleteResp.Diagnostics.HasError(), deleteResp.Diagnostics)
+
+	_, err = jobs.Get(ctx, "daily")
+	require.ErrorIs(t, err, api.ErrResourceDoesNotExist)
+
+	// the job deleted outside of Terraform is removed from the state
+	readResp = &resource.ReadResponse{State: updateResp.State}
+	r.Read(ctx, resource.ReadRequest{State: updateResp.State}, readResp)
+	require.False(t, readResp.Diagnostics.HasError(), readResp.Diagnostics)
+	assert.True(t, readResp.State.Raw.IsNull())
+}
@@ -0,0 +1,123 @@
+//go:build acceptance || all
+
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package backup_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/test"
+)
+
+func TestAccResourceBackupJob(t *testing.T) {
+	te := test.InitEnvironment(t)
+
+	tests := []struct {
+		name  string
+		steps []resource.TestStep
+	}{
+		{"invalid selection", []resource.TestStep{{
+			Config: te.RenderConfig(`
+			resource "proxmox_virtual_environment_backup_job" "acc_backup_job" {
+				job_id         = "acc-invalid-job"
+				schedule       = "sun 01:00"
+				selection_mode = "include"
+			}`),
+			ExpectError: regexp.MustCompile("`vm_ids` must be set"),
+		}}},
+		{"create, update and import backup job", []resource.TestStep{
+			{
+				Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_backup_job" "acc_backup_job" {
+					job_id         = "acc-backup-job"
+					schedule       = "sun 01:00"
+					storage        = "local"
+					node           = "{{.NodeName}}"
+					selection_mode = "all"
+					compress       = "zstd"
+					mode           = "snapshot"
+					enabled        = false
+
+					prune_backups = {
+						keep_last  = 3
+						keep_daily = 7
+					}
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					test.ResourceAttributes("proxmox_virtual_environment_backup_job.acc_backup_job", map[string]string{
+						"id":                       "acc-backup-job",
+						"schedule":                 "sun 01:00",
+						"storage":                  "local",
+						"selection_mode":           "all",
+						"compress":                 "zstd",
+						"mode":                     "snapshot",
+						"enabled":                  "false",
+						"prune_backups.keep_last":  "3",
+						"prune_backups.keep_daily": "7",
+					}),
+					test.NoResourceAttributesSet("proxmox_virtual_environment_backup_job.acc_backup_job", []string{
+						"comment",
+						"fleecing",
+						"pool",
+						"vm_ids",
+					}),
+				),
+			},
+			{
+				Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_backup_job" "acc_backup_job" {
+					job_id         = "acc-backup-job"
+					schedule       = "sat 02:30"
+					storage        = "local"
+					selection_mode = "exclude"
+					vm_ids         = [100, 101]
+					enabled        = false
+					comment        = "managed by terraform"
+
+					fleecing = {
+						enabled = true
+						storage = "local"
+					}
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					test.ResourceAttributes("proxmox_virtual_environment_backup_job.acc_backup_job", map[string]string{
+						"schedule":         "sat 02:30",
+						"selection_mode":   "exclude",
+						"vm_ids.#":         "2",
+						"comment":          "managed by terraform",
+						"fleecing.enabled": "true",
+						"fleecing.storage": "local",
+					}),
+					test.NoResourceAttributesSet("proxmox_virtual_environment_backup_job.acc_backup_job", []string{
+						"compress",
+						"mode",
+						"node",
+						"prune_backups",
+					}),
+				),
+			},
+			{
+				ResourceName:      "proxmox_virtual_environment_backup_job.acc_backup_job",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: te.AccProviders,
+				Steps:                    tt.steps,
+			})
+		})
+	}
+}
@@ -0,0 +1,146 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package notification
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/cluster/notifications"
+	proxmoxtypes "github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+// endpointModel is implemented by the models of all notification endpoint types.
+type endpointModel interface {
+	// base returns the attributes shared by all endpoint types.
+	base() *endpointModelBase
+	// fields returns the type specific fields of the endpoint.
+	fields(ctx context.Context, diags *diag.Diagnostics) notifications.EndpointFields
+	// writeOnlyFields sets the write-only fields of the configuration, they are never stored in the state.
+	writeOnlyFields(ctx context.Context, fields *notifications.EndpointFields, diags *diag.Diagnostics)
+	// importFromAPI sets the type specific attributes from the API response.
+	importFromAPI(ctx context.Context, data *notifications.EndpointGetResponseData, diags *diag.Diagnostics)
+}
+
+// endpointModelBase contains the attributes shared by all notification endpoint types.
+type endpointModelBase struct {
+	ID      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Comment types.String `tfsdk:"comment"`
+	Disable types.Bool   `tfsdk:"disable"`
+}
+
+func (m *endpointModelBase) base() *endpointModelBase {
+	return m
+}
+
+func (m *endpointModelBase) writeOnlyFields(_ context.Context, _ *notifications.EndpointFields, _ *diag.Diagnostics) {
+}
+
+func (m *endpointModelBase) importBaseFromAPI(data *notifications.EndpointGetResponseData) {
+	m.ID = types.StringValue(data.Name)
+	m.Name = types.StringValue(data.Name)
+	m.Comment = stringValue(data.Comment)
+	m.Disable = types.BoolValue(data.Disable != nil && bool(*data.Disable))
+}
+
+// mailModel contains the attributes shared by the endpoint types sending emails.
+type mailModel struct {
+	MailTo      types.Set    `tfsdk:"mailto"`
+	MailToUser  types.Set    `tfsdk:"mailto_user"`
+	FromAddress types.String `tfsdk:"from_address"`
+	Author      types.String `tfsdk:"author"`
+}
+
+func (m *mailModel) mailFields(ctx context.Context, diags *diag.Diagnostics) notifications.EndpointFields {
+	return notifications.EndpointFields{
+		MailTo:      stringList(ctx, m.MailTo, diags),
+		MailToUser:  stringList(ctx, m.MailToUser, diags),
+		FromAddress: m.FromAddress.ValueStringPointer(),
+		Author:      m.Author.ValueStringPointer(),
+	}
+}
+
+func (m *mailModel) importMailFromAPI(
+	ctx context.Context,
+	data *notifications.EndpointGetResponseData,
+	diags *diag.Diagnostics,
+) {
+	m.MailTo = stringSet(ctx, data.MailTo, diags)
+	m.MailToUser = stringSet(ctx, data.MailToUser, diags)
+	m.FromAddress = stringValue(data.FromAddress)
+	m.Author = stringValue(data.Author)
+}
+
+// stringValue returns a null value for empty API strings.
+func stringValue(s *string) types.String {
+	if s == nil || *s == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(*s)
+}
+
+// stringList returns the elements of a set attribute, or nil if the set is null or unknown.
+func stringList(ctx context.Context, set types.Set, diags *diag.Diagnostics) []string {
+	if set.IsNull() || set.IsUnknown() {
+		return nil
+	}
+
+	var list []string
+
+	diags.Append(set.ElementsAs(ctx, &list, false)...)
+
+	return list
+}
+
+// stringSet returns a set attribute of the API list, or a null set if the list is empty.
+func stringSet(ctx context.Context, list []string, diags *diag.Diagnostics) types.Set {
+	if len(list) == 0 {
+		return types.SetNull(types.StringType)
+	}
+
+	set, d := types.SetValueFrom(ctx, types.StringType, list)
+	diags.Append(d...)
+
+	return set
+}
+
+// disableField returns the `disable` field sent to the API.
+func disableField(disable types.Bool) *proxmoxtypes.CustomBool {
+	return proxmoxtypes.CustomBool(disable.ValueBool()).Pointer()
+}
+
+// deletedEndpointFields returns the API fields that are set in the current state but not in the plan.
+func deletedEndpointFields(plan, state *notifications.EndpointFields) []string {
+	var toDelete []string
+
+	for _, f := range []struct {
+		name      string
+		inPlan    bool
+		inCurrent bool
+	}{
+		{"author", plan.Author != nil, state.Author != nil},
+		{"body", plan.Body != nil, state.Body != nil},
+		{"comment", plan.Comment != nil, state.Comment != nil},
+		{"from-address", plan.FromAddress != nil, state.FromAddress != nil},
+		{"header", plan.Header != nil, state.Header != nil},
+		{"mailto", plan.MailTo != nil, state.MailTo != nil},
+		{"mailto-user", plan.MailToUser != nil, state.MailToUser != nil},
+		{"mode", plan.Mode != nil, state.Mode != nil},
+		{"port", plan.Port != nil, state.Port != nil},
+		{"username", plan.Username != nil, state.Username != nil},
+	} {
+		if !f.inPlan && f.inCurrent {
+			toDelete = append(toDelete, f.name)
+		}
+	}
+
+	return toDelete
+}
@@ -0,0 +1,135 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package notification
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/cluster/notifications"
+)
+
+type matcherModel struct {
+	ID            types.String      `tfsdk:"id"`
+	Name          types.String      `tfsdk:"name"`
+	Comment       types.String      `tfsdk:"comment"`
+	Disable       types.Bool        `tfsdk:"disable"`
+	Mode          types.String      `tfsdk:"mode"`
+	InvertMatch   types.Bool        `tfsdk:"invert_match"`
+	Targets       types.Set         `tfsdk:"targets"`
+	MatchSeverity types.Set         `tfsdk:"match_severity"`
+	MatchCalendar types.Set         `tfsdk:"match_calendar"`
+	MatchField    []matchFieldModel `tfsdk:"match_field"`
+}
+
+type matchFieldModel struct {
+	Type  types.String `tfsdk:"type"`
+	Field types.String `tfsdk:"field"`
+	Value types.String `tfsdk:"value"`
+}
+
+func (m *matcherModel) toAPI(ctx context.Context, diags *diag.Diagnostics) notifications.MatcherFields {
+	fields := notifications.MatcherFields{
+		Comment:       m.Comment.ValueStringPointer(),
+		Disable:       disableField(m.Disable),
+		InvertMatch:   disableField(m.InvertMatch),
+		MatchCalendar: stringList(ctx, m.MatchCalendar, diags),
+		MatchSeverity: stringList(ctx, m.MatchSeverity, diags),
+		Mode:          m.Mode.ValueStringPointer(),
+		Target:        stringList(ctx, m.Targets, diags),
+	}
+
+	for _, f := range m.MatchField {
+		fields.MatchField = append(fields.MatchField, fmt.Sprintf(
+			"%s:%s=%s", f.Type.ValueString(), f.Field.ValueString(), f.Value.ValueString(),
+		))
+	}
+
+	return fields
+}
+
+func (m *matcherModel) importFromAPI(
+	ctx context.Context,
+	data *notifications.MatcherGetResponseData,
+	diags *diag.Diagnostics,
+) {
+	m.ID = types.StringValue(data.Name)
+	m.Name = types.StringValue(data.Name)
+	m.Comment = stringValue(data.Comment)
+	m.Disable = types.BoolValue(data.Disable != nil && bool(*data.Disable))
+	m.InvertMatch = types.BoolValue(data.InvertMatch != nil && bool(*data.InvertMatch))
+	m.Mode = types.StringValue("all")
+
+	if data.Mode != nil && *data.Mode != "" {
+		m.Mode = types.StringValue(*data.Mode)
+	}
+
+	m.Targets = stringSet(ctx, data.Target, diags)
+	m.MatchSeverity = stringSet(ctx, data.MatchSeverity, diags)
+	m.MatchCalendar = stringSet(ctx, data.MatchCalendar, diags)
+	m.MatchField = nil
+
+	for _, s := range data.MatchField {
+		f, err := parseMatchField(s)
+		if err != nil {
+			diags.AddError("Unable to parse matcher field rule", err.Error())
+
+			continue
+		}
+
+		m.MatchField = append(m.MatchField, f)
+	}
+}
+
+// parseMatchField parses a field matching rule in the `[exact|regex:]<field>=<value>` format.
+func parseMatchField(s string) (matchFieldModel, error) {
+	matchType := "exact"
+	rule := s
+
+	if t, r, ok := strings.Cut(s, ":"); ok && (t == "exact" || t == "regex") {
+		matchType = t
+		rule = r
+	}
+
+	field, value, ok := strings.Cut(rule, "=")
+	if !ok || field == "" {
+		return matchFieldModel{}, fmt.Errorf("invalid field matching rule %q", s)
+	}
+
+	return matchFieldModel{
+		Type:  types.StringValue(matchType),
+		Field: types.StringValue(field),
+		Value: types.StringValue(value),
+	}, nil
+}
+
+// deletedMatcherFields returns the API fields that are set in the current state but not in the plan.
+func deletedMatcherFields(plan, state *notifications.MatcherFields) []string {
+	var toDelete []string
+
+	for _, f := range []struct {
+		name      string
+		inPlan    bool
+		inCurrent bool
+	}{
+		{"comment", plan.Comment != nil, state.Comment != nil},
+		{"match-calendar", plan.MatchCalendar != nil, state.MatchCalendar != nil},
+		{"match-field", plan.MatchField != nil, state.MatchField != nil},
+		{"match-severity", plan.MatchSeverity != nil, state.MatchSeverity != nil},
+		{"target", plan.Target != nil, state.Target != nil},
+	} {
+		if !f.inPlan && f.inCurrent {
+			toDelete = append(toDelete, f.name)
+		}
+	}
+
+	return toDelete
+}
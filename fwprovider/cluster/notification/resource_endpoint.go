@@ -0,0 +1,366 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/attribute"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/config"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/cluster/notifications"
+)
+
+var nameRegex = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9\-_]*$`)
+
+var (
+	_ resource.Resource                = &endpointResource{}
+	_ resource.ResourceWithConfigure   = &endpointResource{}
+	_ resource.ResourceWithImportState = &endpointResource{}
+)
+
+// endpointResource implements the resources of all notification endpoint types, the type specific
+// behaviour is provided by the endpointModel implementations.
+type endpointResource struct {
+	client *notifications.Client
+
+	// endpointType is the endpoint type used by the PVE API, e.g. `smtp` or `webhook`.
+	endpointType string
+	// typeNameSuffix is appended to the provider type name to form the resource type name.
+	typeNameSuffix string
+	// description is the Markdown description of the resource.
+	description string
+	// attributes are the type specific schema attributes.
+	attributes map[string]schema.Attribute
+	// newModel returns an empty model of the endpoint type.
+	newModel func() endpointModel
+}
+
+func (r *endpointResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + r.typeNameSuffix
+}
+
+// Schema defines the schema for the resource.
+func (r *endpointResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	attributes := map[string]schema.Attribute{
+		"id":      attribute.ResourceID("The identifier of the endpoint, same as `name`."),
+		"name":    nameAttribute("The name of the endpoint, used as notification target by the matchers."),
+		"comment": commentAttribute("The description of the endpoint."),
+		"disable": disableAttribute("Whether the endpoint is disabled"),
+	}
+
+	maps.Copy(attributes, r.attributes)
+
+	resp.Schema = schema.Schema{
+		Description:         r.description,
+		MarkdownDescription: r.description,
+		Attributes:          attributes,
+	}
+}
+
+func (r *endpointResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(config.Resource)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected config.Resource, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = cfg.Client.Cluster().Notifications()
+}
+
+// fields returns all fields of the model sent to the API.
+func (r *endpointResource) fields(
+	ctx context.Context,
+	model endpointModel,
+	diags *diag.Diagnostics,
+) notifications.EndpointFields {
+	fields := model.fields(ctx, diags)
+	fields.Comment = model.base().Comment.ValueStringPointer()
+	fields.Disable = disableField(model.base().Disable)
+
+	return fields
+}
+
+// read refreshes the model from the API. Returns false if the endpoint does not exist.
+func (r *endpointResource) read(ctx context.Context, model endpointModel, diags *diag.Diagnostics) bool {
+	name := model.base().Name.ValueString()
+
+	data, err := r.client.GetEndpoint(ctx, r.endpointType, name)
+	if err != nil {
+		if errors.Is(err, api.ErrResourceDoesNotExist) {
+			return false
+		}
+
+		diags.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return false
+	}
+
+	model.base().importBaseFromAPI(data)
+	model.importFromAPI(ctx, data, diags)
+
+	return true
+}
+
+func (r *endpointResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := r.newModel()
+	cfg := r.newModel()
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, cfg)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := &notifications.EndpointCreateRequestBody{
+		Name:           plan.base().Name.ValueString(),
+		EndpointFields: r.fields(ctx, plan, &resp.Diagnostics),
+	}
+
+	cfg.writeOnlyFields(ctx, &body.EndpointFields, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CreateEndpoint(ctx, r.endpointType, body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while creating the notification endpoint.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if !r.read(ctx, plan, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError(
+				"Unable to Create Resource",
+				fmt.Sprintf("Notification endpoint %q was not found after creation.", body.Name),
+			)
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *endpointResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := r.newModel()
+
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.read(ctx, state, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.State.RemoveResource(ctx)
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *endpointResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := r.newModel()
+	state := r.newModel()
+	cfg := r.newModel()
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, cfg)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planFields := r.fields(ctx, plan, &resp.Diagnostics)
+	stateFields := r.fields(ctx, state, &resp.Diagnostics)
+
+	body := &notifications.EndpointUpdateRequestBody{
+		Delete:         deletedEndpointFields(&planFields, &stateFields),
+		EndpointFields: planFields,
+	}
+
+	cfg.writeOnlyFields(ctx, &body.EndpointFields, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.base().Name.ValueString()
+
+	err := r.client.UpdateEndpoint(ctx, r.endpointType, name, body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while updating the notification endpoint.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if !r.read(ctx, plan, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError(
+				"Unable to Update Resource",
+				fmt.Sprintf("Notification endpoint %q no longer exists.", name),
+			)
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *endpointResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := r.newModel()
+
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteEndpoint(ctx, r.endpointType, state.base().Name.ValueString())
+	if err != nil {
+		if errors.Is(err, api.ErrResourceDoesNotExist) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while deleting the notification endpoint.\n\n"+
+				"Error: "+err.Error(),
+		)
+	}
+}
+
+func (r *endpointResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+// nameAttribute returns the `name` attribute of endpoints and matchers.
+func nameAttribute(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: description,
+		Required:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(nameRegex, "must start with a letter, and contain only letters, "+
+				"digits, '-' and '_'"),
+		},
+	}
+}
+
+// commentAttribute returns the `comment` attribute of endpoints and matchers.
+func commentAttribute(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: description,
+		Optional:    true,
+		Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+	}
+}
+
+// disableAttribute returns the `disable` attribute of endpoints and matchers.
+func disableAttribute(description string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: description + " (defaults to `false`).",
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(false),
+	}
+}
+
+// mailAttributes returns the attributes shared by the endpoint types sending emails.
+func mailAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"mailto": schema.SetAttribute{
+			Description: "The email addresses to send the notifications to.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+				setvalidator.AtLeastOneOf(path.MatchRoot("mailto_user")),
+			},
+		},
+		"mailto_user": schema.SetAttribute{
+			Description: "The users to send the notifications to, e.g. `root@pam`. The email address " +
+				"configured for the user is used.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Validators:  []validator.Set{setvalidator.SizeAtLeast(1)},
+		},
+		"from_address": schema.StringAttribute{
+			Description: "The sender address of the emails. If not set, the `email_from` cluster option is used.",
+			Optional:    true,
+			Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"author": schema.StringAttribute{
+			Description: "The author of the emails. If not set, PVE default is `Proxmox VE`.",
+			Optional:    true,
+			Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+	}
+}
@@ -0,0 +1,67 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package notification
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/attribute"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/cluster/notifications"
+)
+
+type gotifyModel struct {
+	endpointModelBase
+
+	Server       types.String `tfsdk:"server"`
+	Token        types.String `tfsdk:"token"`
+	TokenVersion types.Int64  `tfsdk:"token_wo_version"`
+}
+
+func (m *gotifyModel) fields(_ context.Context, _ *diag.Diagnostics) notifications.EndpointFields {
+	return notifications.EndpointFields{
+		Server: m.Server.ValueStringPointer(),
+	}
+}
+
+func (m *gotifyModel) writeOnlyFields(_ context.Context, fields *notifications.EndpointFields, _ *diag.Diagnostics) {
+	fields.Token = m.Token.ValueStringPointer()
+}
+
+func (m *gotifyModel) importFromAPI(
+	_ context.Context,
+	data *notifications.EndpointGetResponseData,
+	_ *diag.Diagnostics,
+) {
+	m.Server = stringValue(data.Server)
+}
+
+// NewGotifyEndpointResource creates a new resource for managing Gotify notification endpoints.
+func NewGotifyEndpointResource() resource.Resource {
+	return &endpointResource{
+		endpointType:   "gotify",
+		typeNameSuffix: "_notification_endpoint_gotify",
+		description:    "Manages a Gotify notification endpoint.",
+		attributes: map[string]schema.Attribute{
+			"server": schema.StringAttribute{
+				Description: "The URL of the Gotify server, e.g. `https://gotify.example.com`.",
+				Required:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"token": attribute.WriteOnlyString("token",
+				"The application token used to send messages to the Gotify server.", true),
+			"token_wo_version": attribute.WriteOnlyVersion("token"),
+		},
+		newModel: func() endpointModel { return &gotifyModel{} },
+	}
+}
@@ -0,0 +1,45 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package notification
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/cluster/notifications"
+)
+
+type sendmailModel struct {
+	endpointModelBase
+	mailModel
+}
+
+func (m *sendmailModel) fields(ctx context.Context, diags *diag.Diagnostics) notifications.EndpointFields {
+	return m.mailFields(ctx, diags)
+}
+
+func (m *sendmailModel) importFromAPI(
+	ctx context.Context,
+	data *notifications.EndpointGetResponseData,
+	diags *diag.Diagnostics,
+) {
+	m.importMailFromAPI(ctx, data, diags)
+}
+
+// NewSendmailEndpointResource creates a new resource for managing sendmail notification endpoints.
+func NewSendmailEndpointResource() resource.Resource {
+	return &endpointResource{
+		endpointType:   "sendmail",
+		typeNameSuffix: "_notification_endpoint_sendmail",
+		description: "Manages a sendmail notification endpoint, sending emails with the `sendmail` binary " +
+			"of the nodes.",
+		attributes: mailAttributes(),
+		newModel:   func() endpointModel { return &sendmailModel{} },
+	}
+}
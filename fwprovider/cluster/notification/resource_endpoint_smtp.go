@@ -0,0 +1,112 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package notification
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/attribute"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/cluster/notifications"
+	proxmoxtypes "github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+type smtpModel struct {
+	endpointModelBase
+	mailModel
+
+	Server          types.String `tfsdk:"server"`
+	Port            types.Int64  `tfsdk:"port"`
+	Mode            types.String `tfsdk:"mode"`
+	Username        types.String `tfsdk:"username"`
+	Password        types.String `tfsdk:"password"`
+	PasswordVersion types.Int64  `tfsdk:"password_wo_version"`
+}
+
+func (m *smtpModel) fields(ctx context.Context, diags *diag.Diagnostics) notifications.EndpointFields {
+	fields := m.mailFields(ctx, diags)
+	fields.Server = m.Server.ValueStringPointer()
+	fields.Mode = m.Mode.ValueStringPointer()
+	fields.Username = m.Username.ValueStringPointer()
+
+	if !m.Port.IsNull() && !m.Port.IsUnknown() {
+		port := proxmoxtypes.CustomInt64(m.Port.ValueInt64())
+		fields.Port = &port
+	}
+
+	return fields
+}
+
+func (m *smtpModel) writeOnlyFields(_ context.Context, fields *notifications.EndpointFields, _ *diag.Diagnostics) {
+	fields.Password = m.Password.ValueStringPointer()
+}
+
+func (m *smtpModel) importFromAPI(
+	ctx context.Context,
+	data *notifications.EndpointGetResponseData,
+	diags *diag.Diagnostics,
+) {
+	m.importMailFromAPI(ctx, data, diags)
+	m.Server = stringValue(data.Server)
+	m.Mode = stringValue(data.Mode)
+	m.Username = stringValue(data.Username)
+	m.Port = types.Int64Null()
+
+	if data.Port != nil {
+		m.Port = types.Int64Value(int64(*data.Port))
+	}
+}
+
+// NewSMTPEndpointResource creates a new resource for managing SMTP notification endpoints.
+func NewSMTPEndpointResource() resource.Resource {
+	attributes := mailAttributes()
+	attributes["from_address"] = schema.StringAttribute{
+		Description: "The sender address of the emails.",
+		Required:    true,
+		Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+	}
+	attributes["server"] = schema.StringAttribute{
+		Description: "The address of the SMTP relay.",
+		Required:    true,
+		Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+	}
+	attributes["port"] = schema.Int64Attribute{
+		Description: "The port of the SMTP relay. If not set, PVE default depends on `mode`: " +
+			"`25` for `insecure`, `587` for `starttls` and `465` for `tls`.",
+		Optional:   true,
+		Validators: []validator.Int64{int64validator.Between(1, 65535)},
+	}
+	attributes["mode"] = schema.StringAttribute{
+		Description: "The encryption of the connection to the SMTP relay. " +
+			"Choice is between `insecure` | `starttls` | `tls`. If not set, PVE default is `tls`.",
+		Optional:   true,
+		Validators: []validator.String{stringvalidator.OneOf("insecure", "starttls", "tls")},
+	}
+	attributes["username"] = schema.StringAttribute{
+		Description: "The user name used to authenticate to the SMTP relay.",
+		Optional:    true,
+		Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+	}
+	attributes["password"] = attribute.WriteOnlyString("password",
+		"The password used to authenticate to the SMTP relay.", false)
+	attributes["password_wo_version"] = attribute.WriteOnlyVersion("password")
+
+	return &endpointResource{
+		endpointType:   "smtp",
+		typeNameSuffix: "_notification_endpoint_smtp",
+		description:    "Manages an SMTP notification endpoint, sending emails through an SMTP relay.",
+		attributes:     attributes,
+		newModel:       func() endpointModel { return &smtpModel{} },
+	}
+}
@@ -0,0 +1,170 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package notification
+
+import (
+	"context"
+	"encoding/base64"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/attribute"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/cluster/notifications"
+)
+
+type webhookModel struct {
+	endpointModelBase
+
+	URL            types.String `tfsdk:"url"`
+	Method         types.String `tfsdk:"method"`
+	Headers        types.Map    `tfsdk:"headers"`
+	Body           types.String `tfsdk:"body"`
+	Secrets        types.Map    `tfsdk:"secrets"`
+	SecretsVersion types.Int64  `tfsdk:"secrets_wo_version"`
+}
+
+func (m *webhookModel) fields(ctx context.Context, diags *diag.Diagnostics) notifications.EndpointFields {
+	fields := notifications.EndpointFields{
+		URL:    m.URL.ValueStringPointer(),
+		Method: m.Method.ValueStringPointer(),
+		Header: keyValues(ctx, m.Headers, diags),
+	}
+
+	if !m.Body.IsNull() && !m.Body.IsUnknown() {
+		body := base64.StdEncoding.EncodeToString([]byte(m.Body.ValueString()))
+		fields.Body = &body
+	}
+
+	return fields
+}
+
+func (m *webhookModel) writeOnlyFields(
+	ctx context.Context,
+	fields *notifications.EndpointFields,
+	diags *diag.Diagnostics,
+) {
+	fields.Secret = keyValues(ctx, m.Secrets, diags)
+}
+
+func (m *webhookModel) importFromAPI(
+	ctx context.Context,
+	data *notifications.EndpointGetResponseData,
+	diags *diag.Diagnostics,
+) {
+	m.URL = stringValue(data.URL)
+	m.Method = stringValue(data.Method)
+	m.Body = types.StringNull()
+
+	if data.Body != nil && *data.Body != "" {
+		body, err := base64.StdEncoding.DecodeString(*data.Body)
+		if err != nil {
+			diags.AddError("Unable to decode webhook body", err.Error())
+		} else {
+			m.Body = types.StringValue(string(body))
+		}
+	}
+
+	m.Headers = types.MapNull(types.StringType)
+
+	if len(data.Header) > 0 {
+		headers := make(map[string]string, len(data.Header))
+
+		for _, h := range data.Header {
+			headers[h.Name] = ""
+
+			if h.Value != nil {
+				headers[h.Name] = *h.Value
+			}
+		}
+
+		var d diag.Diagnostics
+
+		m.Headers, d = types.MapValueFrom(ctx, types.StringType, headers)
+		diags.Append(d...)
+	}
+}
+
+// keyValues converts a map attribute to webhook key-values sorted by name, or nil if the map is null or unknown.
+func keyValues(ctx context.Context, m types.Map, diags *diag.Diagnostics) notifications.WebhookKeyValues {
+	if m.IsNull() || m.IsUnknown() {
+		return nil
+	}
+
+	var values map[string]string
+
+	diags.Append(m.ElementsAs(ctx, &values, false)...)
+
+	kvs := make(notifications.WebhookKeyValues, 0, len(values))
+
+	for name, value := range values {
+		kvs = append(kvs, notifications.WebhookKeyValue{Name: name, Value: &value})
+	}
+
+	slices.SortFunc(kvs, func(a, b notifications.WebhookKeyValue) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return kvs
+}
+
+// NewWebhookEndpointResource creates a new resource for managing webhook notification endpoints.
+func NewWebhookEndpointResource() resource.Resource {
+	return &endpointResource{
+		endpointType:   "webhook",
+		typeNameSuffix: "_notification_endpoint_webhook",
+		description: "Manages a webhook notification endpoint, sending notifications as HTTP requests. " +
+			"The `url`, `headers` and `body` attributes may use templates, e.g. `{{ title }}`, " +
+			"`{{ message }}`, `{{ severity }}` or `{{ secrets.<name> }}`.",
+		attributes: map[string]schema.Attribute{
+			"url": schema.StringAttribute{
+				Description: "The URL the requests are sent to.",
+				Required:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"method": schema.StringAttribute{
+				Description: "The HTTP method of the requests. Choice is between `post` | `put` | `get` " +
+					"(defaults to `post`).",
+				Optional:   true,
+				Computed:   true,
+				Default:    stringdefault.StaticString("post"),
+				Validators: []validator.String{stringvalidator.OneOf("post", "put", "get")},
+			},
+			"headers": schema.MapAttribute{
+				Description: "The HTTP headers of the requests.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators:  []validator.Map{mapvalidator.SizeAtLeast(1)},
+			},
+			"body": schema.StringAttribute{
+				Description: "The body of the requests.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"secrets": schema.MapAttribute{
+				Description: "The secrets available in the templates as `{{ secrets.<name> }}`.",
+				MarkdownDescription: attribute.WriteOnlyDescription("secrets",
+					"The secrets available in the templates as `{{ secrets.<name> }}`."),
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Validators:  []validator.Map{mapvalidator.SizeAtLeast(1)},
+			},
+			"secrets_wo_version": attribute.WriteOnlyVersion("secrets"),
+		},
+		newModel: func() endpointModel { return &webhookModel{} },
+	}
+}
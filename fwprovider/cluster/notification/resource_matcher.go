@@ -0,0 +1,317 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/attribute"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/config"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/cluster/notifications"
+)
+
+var (
+	_ resource.Resource                = &matcherResource{}
+	_ resource.ResourceWithConfigure   = &matcherResource{}
+	_ resource.ResourceWithImportState = &matcherResource{}
+)
+
+type matcherResource struct {
+	client *notifications.Client
+}
+
+// NewMatcherResource creates a new resource for managing notification matchers.
+func NewMatcherResource() resource.Resource {
+	return &matcherResource{}
+}
+
+func (r *matcherResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_notification_matcher"
+}
+
+// Schema defines the schema for the resource.
+func (r *matcherResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a notification matcher, routing the notifications matching its rules " +
+			"to notification targets.",
+		Attributes: map[string]schema.Attribute{
+			"id":      attribute.ResourceID("The identifier of the matcher, same as `name`."),
+			"name":    nameAttribute("The name of the matcher."),
+			"comment": commentAttribute("The description of the matcher."),
+			"disable": disableAttribute("Whether the matcher is disabled"),
+			"mode": schema.StringAttribute{
+				Description: "Whether all or any of the matching rules must match. " +
+					"Choice is between `all` | `any` (defaults to `all`).",
+				Optional:   true,
+				Computed:   true,
+				Default:    stringdefault.StaticString("all"),
+				Validators: []validator.String{stringvalidator.OneOf("all", "any")},
+			},
+			"invert_match": schema.BoolAttribute{
+				Description: "Whether to invert the result of the matching rules (defaults to `false`).",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"targets": schema.SetAttribute{
+				Description: "The names of the notification targets the matching notifications are sent to.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators:  []validator.Set{setvalidator.SizeAtLeast(1)},
+			},
+			"match_severity": schema.SetAttribute{
+				Description: "The severities the notifications must have. " +
+					"Choice is between `info` | `notice` | `warning` | `error` | `unknown`.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.OneOf("info", "notice", "warning", "error", "unknown"),
+					),
+				},
+			},
+			"match_calendar": schema.SetAttribute{
+				Description: "The calendar events the notification timestamps must match, e.g. `mon..fri 8-17`.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators:  []validator.Set{setvalidator.SizeAtLeast(1)},
+			},
+			"match_field": schema.ListNestedAttribute{
+				Description: "The rules matching the metadata fields of the notifications, e.g. `type` or `hostname`.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "The type of the rule. Choice is between `exact` | `regex` " +
+								"(defaults to `exact`).",
+							Optional:   true,
+							Computed:   true,
+							Default:    stringdefault.StaticString("exact"),
+							Validators: []validator.String{stringvalidator.OneOf("exact", "regex")},
+						},
+						"field": schema.StringAttribute{
+							Description: "The name of the metadata field.",
+							Required:    true,
+							Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+						},
+						"value": schema.StringAttribute{
+							Description: "The value or the regular expression the field must match.",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *matcherResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(config.Resource)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected config.Resource, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = cfg.Client.Cluster().Notifications()
+}
+
+// read refreshes the model from the API. Returns false if the matcher does not exist.
+func (r *matcherResource) read(ctx context.Context, model *matcherModel, diags *diag.Diagnostics) bool {
+	data, err := r.client.GetMatcher(ctx, model.Name.ValueString())
+	if err != nil {
+		if errors.Is(err, api.ErrResourceDoesNotExist) {
+			return false
+		}
+
+		diags.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return false
+	}
+
+	model.importFromAPI(ctx, data, diags)
+
+	return true
+}
+
+func (r *matcherResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan matcherModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := &notifications.MatcherCreateRequestBody{
+		Name:          plan.Name.ValueString(),
+		MatcherFields: plan.toAPI(ctx, &resp.Diagnostics),
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CreateMatcher(ctx, body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while creating the notification matcher.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if !r.read(ctx, &plan, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError(
+				"Unable to Create Resource",
+				fmt.Sprintf("Notification matcher %q was not found after creation.", body.Name),
+			)
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *matcherResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state matcherModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.read(ctx, &state, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.State.RemoveResource(ctx)
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *matcherResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state matcherModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planFields := plan.toAPI(ctx, &resp.Diagnostics)
+	stateFields := state.toAPI(ctx, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateMatcher(ctx, plan.Name.ValueString(), &notifications.MatcherUpdateRequestBody{
+		Delete:        deletedMatcherFields(&planFields, &stateFields),
+		MatcherFields: planFields,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while updating the notification matcher.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if !r.read(ctx, &plan, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError(
+				"Unable to Update Resource",
+				fmt.Sprintf("Notification matcher %q no longer exists.", plan.Name.ValueString()),
+			)
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *matcherResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state matcherModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteMatcher(ctx, state.Name.ValueString())
+	if err != nil {
+		if errors.Is(err, api.ErrResourceDoesNotExist) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while deleting the notification matcher.\n\n"+
+				"Error: "+err.Error(),
+		)
+	}
+}
+
+func (r *matcherResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
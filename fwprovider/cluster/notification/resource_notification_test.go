@@ -0,0 +1,187 @@
+//go:build acceptance || all
+
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package notification_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/test"
+)
+
+func TestAccResourceNotification(t *testing.T) {
+	te := test.InitEnvironment(t)
+
+	tests := []struct {
+		name  string
+		steps []resource.TestStep
+	}{
+		{"sendmail endpoint and matcher", []resource.TestStep{
+			{
+				Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_notification_endpoint_sendmail" "acc_sendmail" {
+					name        = "acc-sendmail"
+					mailto      = ["acc@example.com"]
+					mailto_user = ["root@pam"]
+					comment     = "acceptance test"
+				}
+
+				resource "proxmox_virtual_environment_notification_matcher" "acc_matcher" {
+					name           = "acc-matcher"
+					targets        = [proxmox_virtual_environment_notification_endpoint_sendmail.acc_sendmail.name]
+					match_severity = ["error", "warning"]
+
+					match_field = [
+						{
+							field = "type"
+							value = "vzdump"
+						},
+						{
+							type  = "regex"
+							field = "hostname"
+							value = "^acc-.*$"
+						},
+					]
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					test.ResourceAttributes("proxmox_virtual_environment_notification_endpoint_sendmail.acc_sendmail", map[string]string{
+						"id":            "acc-sendmail",
+						"mailto.#":      "1",
+						"mailto_user.#": "1",
+						"comment":       "acceptance test",
+						"disable":       "false",
+					}),
+					test.ResourceAttributes("proxmox_virtual_environment_notification_matcher.acc_matcher", map[string]string{
+						"id":                 "acc-matcher",
+						"mode":               "all",
+						"invert_match":       "false",
+						"targets.#":          "1",
+						"match_severity.#":   "2",
+						"match_field.#":      "2",
+						"match_field.0.type": "exact",
+						"match_field.1.type": "regex",
+					}),
+				),
+			},
+			{
+				Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_notification_endpoint_sendmail" "acc_sendmail" {
+					name    = "acc-sendmail"
+					mailto  = ["acc@example.com", "acc2@example.com"]
+					author  = "Acceptance"
+					disable = true
+				}
+
+				resource "proxmox_virtual_environment_notification_matcher" "acc_matcher" {
+					name         = "acc-matcher"
+					mode         = "any"
+					invert_match = true
+					targets      = [proxmox_virtual_environment_notification_endpoint_sendmail.acc_sendmail.name]
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					test.ResourceAttributes("proxmox_virtual_environment_notification_endpoint_sendmail.acc_sendmail", map[string]string{
+						"mailto.#": "2",
+						"author":   "Acceptance",
+						"disable":  "true",
+					}),
+					test.NoResourceAttributesSet("proxmox_virtual_environment_notification_endpoint_sendmail.acc_sendmail", []string{
+						"comment",
+						"mailto_user.#",
+					}),
+					test.ResourceAttributes("proxmox_virtual_environment_notification_matcher.acc_matcher", map[string]string{
+						"mode":         "any",
+						"invert_match": "true",
+					}),
+					test.NoResourceAttributesSet("proxmox_virtual_environment_notification_matcher.acc_matcher", []string{
+						"match_severity.#",
+						"match_field.#",
+					}),
+				),
+			},
+			{
+				ResourceName:      "proxmox_virtual_environment_notification_matcher.acc_matcher",
+				ImportState:       true,
+				ImportStateId:     "acc-matcher",
+				ImportStateVerify: true,
+			},
+		}},
+		{"webhook endpoint", []resource.TestStep{
+			{
+				Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_notification_endpoint_webhook" "acc_webhook" {
+					name = "acc-webhook"
+					url  = "https://example.com/hooks/{{"{{"}} secrets.id {{"}}"}}"
+
+					headers = {
+						"Content-Type" = "application/json"
+					}
+
+					body = jsonencode({ text = "test" })
+
+					secrets = {
+						id = "acc-secret"
+					}
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					test.ResourceAttributes("proxmox_virtual_environment_notification_endpoint_webhook.acc_webhook", map[string]string{
+						"method":               "post",
+						"headers.Content-Type": "application/json",
+						"body":                 `\{"text":"test"\}`,
+					}),
+					test.NoResourceAttributesSet("proxmox_virtual_environment_notification_endpoint_webhook.acc_webhook", []string{
+						"secrets",
+					}),
+				),
+			},
+			{
+				Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_notification_endpoint_webhook" "acc_webhook" {
+					name = "acc-webhook"
+					url  = "https://example.com/hooks/{{"{{"}} secrets.id {{"}}"}}"
+
+					headers = {
+						"Content-Type" = "application/json"
+					}
+
+					body = jsonencode({ text = "test" })
+
+					secrets = {
+						id = "acc-new-secret"
+					}
+					secrets_wo_version = 1
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					test.ResourceAttributes("proxmox_virtual_environment_notification_endpoint_webhook.acc_webhook", map[string]string{
+						"secrets_wo_version": "1",
+					}),
+					test.NoResourceAttributesSet("proxmox_virtual_environment_notification_endpoint_webhook.acc_webhook", []string{
+						"secrets",
+					}),
+				),
+			},
+			{
+				ResourceName:            "proxmox_virtual_environment_notification_endpoint_webhook.acc_webhook",
+				ImportState:             true,
+				ImportStateId:           "acc-webhook",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secrets_wo_version"},
+			},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: te.AccProviders,
+				Steps:                    tt.steps,
+			})
+		})
+	}
+}
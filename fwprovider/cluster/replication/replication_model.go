@@ -0,0 +1,111 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package replication
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/cluster/replication"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes"
+	proxmoxtypes "github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+const (
+	removeJobLocal = "local"
+	removeJobFull  = "full"
+)
+
+type replicationModel struct {
+	ID        types.String  `tfsdk:"id"`
+	GuestID   types.Int64   `tfsdk:"guest_id"`
+	JobNumber types.Int64   `tfsdk:"job_number"`
+	Target    types.String  `tfsdk:"target"`
+	Schedule  types.String  `tfsdk:"schedule"`
+	Rate      types.Float64 `tfsdk:"rate"`
+	Comment   types.String  `tfsdk:"comment"`
+	Enabled   types.Bool    `tfsdk:"enabled"`
+	RemoveJob types.String  `tfsdk:"remove_job"`
+	Source    types.String  `tfsdk:"source"`
+	LastSync  types.String  `tfsdk:"last_sync"`
+	FailCount types.Int64   `tfsdk:"fail_count"`
+}
+
+// jobID returns the replication job identifier in the `<guest>-<job number>` format.
+func (m *replicationModel) jobID() string {
+	return fmt.Sprintf("%d-%d", m.GuestID.ValueInt64(), m.JobNumber.ValueInt64())
+}
+
+// toAPI converts the model to the fields sent to the replication job API.
+func (m *replicationModel) toAPI() *replication.JobDataBase {
+	data := &replication.JobDataBase{
+		Schedule: m.Schedule.ValueStringPointer(),
+		Comment:  m.Comment.ValueStringPointer(),
+		Disable:  proxmoxtypes.CustomBool(!m.Enabled.ValueBool()).Pointer(),
+	}
+
+	if !m.Rate.IsNull() && !m.Rate.IsUnknown() {
+		rate := proxmoxtypes.CustomFloat64(m.Rate.ValueFloat64())
+		data.Rate = &rate
+	}
+
+	return data
+}
+
+// importFromAPI sets the model fields from the replication job API response.
+func (m *replicationModel) importFromAPI(data *replication.JobGetResponseData) {
+	m.ID = types.StringValue(data.ID)
+	m.GuestID = types.Int64Value(int64(data.Guest))
+	m.JobNumber = types.Int64Value(int64(data.JobNum))
+	m.Target = types.StringValue(data.Target)
+	m.Schedule = types.StringPointerValue(data.Schedule)
+	m.Rate = types.Float64PointerValue(data.Rate.PointerFloat64())
+	m.Comment = types.StringPointerValue(data.Comment)
+	m.Enabled = types.BoolValue(data.Disable == nil || !bool(*data.Disable))
+
+	if m.RemoveJob.IsNull() || m.RemoveJob.IsUnknown() {
+		m.RemoveJob = types.StringValue(removeJobFull)
+	}
+}
+
+// importStatusFromAPI sets the status fields of the model from the replication job status API response.
+func (m *replicationModel) importStatusFromAPI(source string, status *nodes.ReplicationJobStatusResponseData) {
+	m.Source = types.StringValue(source)
+	m.LastSync = types.StringNull()
+	m.FailCount = types.Int64Value(0)
+
+	if status.LastSync != nil && time.Time(*status.LastSync).Unix() > 0 {
+		m.LastSync = types.StringValue(time.Time(*status.LastSync).UTC().Format(time.RFC3339))
+	}
+
+	if status.FailCount != nil {
+		m.FailCount = types.Int64Value(int64(*status.FailCount))
+	}
+}
+
+// deletedFields returns the API fields that are set in the current state but not in the plan.
+func deletedFields(plan, state *replication.JobDataBase) []string {
+	var toDelete []string
+
+	for _, f := range []struct {
+		name      string
+		inPlan    bool
+		inCurrent bool
+	}{
+		{"comment", plan.Comment != nil, state.Comment != nil},
+		{"rate", plan.Rate != nil, state.Rate != nil},
+		{"schedule", plan.Schedule != nil, state.Schedule != nil},
+	} {
+		if !f.inPlan && f.inCurrent {
+			toDelete = append(toDelete, f.name)
+		}
+	}
+
+	return toDelete
+}
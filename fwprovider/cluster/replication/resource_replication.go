@@ -0,0 +1,380 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package replication
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/attribute"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/config"
+	"github.com/bpg/terraform-provider-proxmox/proxmox"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/cluster/replication"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes"
+	proxmoxtypes "github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+var (
+	_ resource.Resource                = &replicationResource{}
+	_ resource.ResourceWithConfigure   = &replicationResource{}
+	_ resource.ResourceWithImportState = &replicationResource{}
+)
+
+type replicationResource struct {
+	client proxmox.Client
+}
+
+// NewReplicationResource creates a new resource for managing storage replication jobs.
+func NewReplicationResource() resource.Resource {
+	return &replicationResource{}
+}
+
+func (r *replicationResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_replication"
+}
+
+func (r *replicationResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(config.Resource)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected config.Resource, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = cfg.Client
+}
+
+func (r *replicationResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a storage replication job of a Proxmox VE cluster.",
+		MarkdownDescription: "Manages a storage replication job of a Proxmox VE cluster. Replication copies " +
+			"the local ZFS volumes of a guest to another node on a schedule.",
+		Attributes: map[string]schema.Attribute{
+			"id": attribute.ResourceID("The identifier of the replication job, in the `<guest ID>-<job number>` format."),
+			"guest_id": schema.Int64Attribute{
+				Description: "The ID of the replicated VM or container.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{int64validator.AtLeast(100)},
+			},
+			"job_number": schema.Int64Attribute{
+				Description: "The number of the job, unique per guest (defaults to `0`).",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"target": schema.StringAttribute{
+				Description: "The node the guest volumes are replicated to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"schedule": schema.StringAttribute{
+				Description: "The replication schedule in systemd calendar event format (defaults to `*/15`).",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("*/15"),
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"rate": schema.Float64Attribute{
+				Description: "The rate limit of the replication in MB/s. If not set, the rate is not limited.",
+				Optional:    true,
+				Validators:  []validator.Float64{float64validator.AtLeast(1)},
+			},
+			"comment": schema.StringAttribute{
+				Description: "The description of the replication job.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the replication job is enabled (defaults to `true`).",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"remove_job": schema.StringAttribute{
+				Description: "How the job is removed when the resource is destroyed. Choice is between `local` | " +
+					"`full` (defaults to `full`). With `local`, only the local replication snapshots are removed " +
+					"and the replicated volumes are kept on the target node. With `full`, the replicated volumes " +
+					"are removed from the target node as well. The removal is done by the replication runner " +
+					"of the source node, so the job can remain visible in PVE for a short time.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(removeJobFull),
+				Validators: []validator.String{
+					stringvalidator.OneOf(removeJobLocal, removeJobFull),
+				},
+			},
+			"source": schema.StringAttribute{
+				Description: "The node the guest currently runs on.",
+				Computed:    true,
+			},
+			"last_sync": schema.StringAttribute{
+				Description: "The time of the last successful replication, in RFC 3339 format.",
+				Computed:    true,
+			},
+			"fail_count": schema.Int64Attribute{
+				Description: "The number of consecutive failed replication attempts.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *replicationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan replicationModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := plan.jobID()
+
+	err := r.client.Cluster().Replication().Create(ctx, &replication.JobCreateRequestBody{
+		JobDataBase: *plan.toAPI(),
+		ID:          id,
+		Type:        "local",
+		Target:      plan.Target.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while creating the resource create request.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if !r.read(ctx, id, &plan, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError(
+				"Unable to Create Resource",
+				fmt.Sprintf("Replication job %q was not found after creation.", id),
+			)
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *replicationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state replicationModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.read(ctx, state.ID.ValueString(), &state, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.State.RemoveResource(ctx)
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *replicationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state replicationModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planData := plan.toAPI()
+
+	err := r.client.Cluster().Replication().Update(ctx, state.ID.ValueString(), &replication.JobUpdateRequestBody{
+		JobDataBase: *planData,
+		Delete:      deletedFields(planData, state.toAPI()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while creating the resource update request.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if !r.read(ctx, state.ID.ValueString(), &plan, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError(
+				"Unable to Update Resource",
+				fmt.Sprintf("Replication job %q no longer exists.", state.ID.ValueString()),
+			)
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *replicationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state replicationModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := &replication.JobDeleteRequestBody{}
+	if state.RemoveJob.ValueString() == removeJobLocal {
+		body.Keep = proxmoxtypes.CustomBool(true).Pointer()
+	}
+
+	err := r.client.Cluster().Replication().Delete(ctx, state.ID.ValueString(), body)
+	if err != nil {
+		if errors.Is(err, api.ErrResourceDoesNotExist) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while creating the resource delete request.\n\n"+
+				"Error: "+err.Error(),
+		)
+	}
+}
+
+func (r *replicationResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	state := &replicationModel{}
+
+	if !r.read(ctx, req.ID, state, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError(
+				"Resource does not exist",
+				fmt.Sprintf("Replication job %q you try to import does not exist.", req.ID),
+			)
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// read refreshes the model from the API. Returns false if the replication job does not exist,
+// or is marked for removal.
+func (r *replicationResource) read(
+	ctx context.Context,
+	id string,
+	model *replicationModel,
+	diags *diag.Diagnostics,
+) bool {
+	data, err := r.client.Cluster().Replication().Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, api.ErrResourceDoesNotExist) {
+			return false
+		}
+
+		diags.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return false
+	}
+
+	if data.RemoveJob != nil {
+		return false
+	}
+
+	model.importFromAPI(data)
+	r.readStatus(ctx, model, diags)
+
+	return true
+}
+
+// readStatus refreshes the replication status of the model from the node the guest runs on. The job itself
+// is still managed when the status is not available, e.g. when the guest was removed outside of Terraform,
+// so failures are reported as warnings.
+func (r *replicationResource) readStatus(ctx context.Context, model *replicationModel, diags *diag.Diagnostics) {
+	nodeName, err := r.client.Cluster().GetVMNodeName(ctx, int(model.GuestID.ValueInt64()))
+	if err == nil {
+		var status *nodes.ReplicationJobStatusResponseData
+
+		status, err = r.client.Node(*nodeName).GetReplicationJobStatus(ctx, model.ID.ValueString())
+		if err == nil {
+			model.importStatusFromAPI(*nodeName, status)
+
+			return
+		}
+	}
+
+	diags.AddWarning(
+		"Unable to Read Replication Status",
+		fmt.Sprintf("The status of replication job %q could not be read.\n\nError: %s", model.ID.ValueString(), err),
+	)
+
+	model.Source = types.StringNull()
+	model.LastSync = types.StringNull()
+	model.FailCount = types.Int64Null()
+}
@@ -0,0 +1,114 @@
+//go:build acceptance || all
+
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package replication_test
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/test"
+)
+
+func TestAccResourceReplication(t *testing.T) {
+	te := test.InitEnvironment(t)
+
+	// replication needs a second node, and a guest with all its volumes on a ZFS storage
+	target := os.Getenv("PROXMOX_VE_ACC_REPLICATION_TARGET")
+	guestID := os.Getenv("PROXMOX_VE_ACC_REPLICATION_GUEST_ID")
+
+	te.AddTemplateVars(map[string]any{
+		"Target":  target,
+		"GuestID": guestID,
+	})
+
+	tests := []struct {
+		name  string
+		skip  bool
+		steps []resource.TestStep
+	}{
+		{"invalid remove_job", false, []resource.TestStep{{
+			Config: te.RenderConfig(`
+			resource "proxmox_virtual_environment_replication" "acc_replication" {
+				guest_id   = 100
+				target     = "{{.NodeName}}"
+				remove_job = "none"
+			}`),
+			ExpectError: regexp.MustCompile(`value must be one of`),
+		}}},
+		{"create, update and import replication job", target == "" || guestID == "", []resource.TestStep{
+			{
+				Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_replication" "acc_replication" {
+					guest_id   = {{.GuestID}}
+					job_number = 9
+					target     = "{{.Target}}"
+					rate       = 10
+					comment    = "created by terraform"
+					enabled    = false
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					test.ResourceAttributes("proxmox_virtual_environment_replication.acc_replication", map[string]string{
+						"id":         guestID + "-9",
+						"target":     target,
+						"schedule":   `\*/15`,
+						"rate":       "10",
+						"comment":    "created by terraform",
+						"enabled":    "false",
+						"remove_job": "full",
+						"source":     ".+",
+						"fail_count": "0",
+					}),
+				),
+			},
+			{
+				Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_replication" "acc_replication" {
+					guest_id   = {{.GuestID}}
+					job_number = 9
+					target     = "{{.Target}}"
+					schedule   = "*/30"
+					enabled    = false
+					remove_job = "local"
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					test.ResourceAttributes("proxmox_virtual_environment_replication.acc_replication", map[string]string{
+						"schedule":   `\*/30`,
+						"remove_job": "local",
+					}),
+					test.NoResourceAttributesSet("proxmox_virtual_environment_replication.acc_replication", []string{
+						"comment",
+						"rate",
+					}),
+				),
+			},
+			{
+				ResourceName:            "proxmox_virtual_environment_replication.acc_replication",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"remove_job"},
+			},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.skip {
+				t.Skip("PROXMOX_VE_ACC_REPLICATION_TARGET and PROXMOX_VE_ACC_REPLICATION_GUEST_ID must be set")
+			}
+
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: te.AccProviders,
+				Steps:                    tt.steps,
+			})
+		})
+	}
+}
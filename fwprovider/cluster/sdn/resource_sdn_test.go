@@ -0,0 +1,162 @@
+//go:build acceptance || all
+
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package sdn_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/test"
+)
+
+func TestAccResourceSDN(t *testing.T) {
+	te := test.InitEnvironment(t)
+
+	tests := []struct {
+		name  string
+		steps []resource.TestStep
+	}{
+		{"missing type specific attribute", []resource.TestStep{{
+			Config: te.RenderConfig(`
+			resource "proxmox_virtual_environment_sdn_zone" "acc_zone" {
+				name = "accvlan"
+				type = "vlan"
+			}`),
+			ExpectError: regexp.MustCompile("`bridge` must be set"),
+		}}},
+		{"invalid type specific attribute", []resource.TestStep{{
+			Config: te.RenderConfig(`
+			resource "proxmox_virtual_environment_sdn_zone" "acc_zone" {
+				name       = "accsimpl"
+				type       = "simple"
+				controller = "evpnctl"
+			}`),
+			ExpectError: regexp.MustCompile("`controller` can not be set"),
+		}}},
+		{"create, update and import zone, vnet and subnet", []resource.TestStep{
+			{
+				Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_sdn_zone" "acc_zone" {
+					name = "accsimpl"
+					type = "simple"
+					mtu  = 1450
+				}
+
+				resource "proxmox_virtual_environment_sdn_vnet" "acc_vnet" {
+					name  = "accvnet"
+					zone  = proxmox_virtual_environment_sdn_zone.acc_zone.name
+					alias = "acceptance"
+				}
+
+				resource "proxmox_virtual_environment_sdn_subnet" "acc_subnet" {
+					vnet    = proxmox_virtual_environment_sdn_vnet.acc_vnet.name
+					cidr    = "10.250.0.0/24"
+					gateway = "10.250.0.1"
+					snat    = true
+				}
+
+				resource "proxmox_virtual_environment_sdn_applier" "acc_applier" {
+					triggers = {
+						subnet = proxmox_virtual_environment_sdn_subnet.acc_subnet.id
+					}
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					test.ResourceAttributes("proxmox_virtual_environment_sdn_zone.acc_zone", map[string]string{
+						"id":   "accsimpl",
+						"type": "simple",
+						"mtu":  "1450",
+					}),
+					test.ResourceAttributes("proxmox_virtual_environment_sdn_vnet.acc_vnet", map[string]string{
+						"id":    "accvnet",
+						"zone":  "accsimpl",
+						"alias": "acceptance",
+					}),
+					test.ResourceAttributes("proxmox_virtual_environment_sdn_subnet.acc_subnet", map[string]string{
+						"id":      "accsimpl-10.250.0.0-24",
+						"gateway": "10.250.0.1",
+						"snat":    "true",
+					}),
+					test.NoResourceAttributesSet("proxmox_virtual_environment_sdn_subnet.acc_subnet", []string{
+						"dhcp_range",
+					}),
+				),
+			},
+			{
+				Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_sdn_zone" "acc_zone" {
+					name = "accsimpl"
+					type = "simple"
+					dhcp = "dnsmasq"
+				}
+
+				resource "proxmox_virtual_environment_sdn_vnet" "acc_vnet" {
+					name = "accvnet"
+					zone = proxmox_virtual_environment_sdn_zone.acc_zone.name
+				}
+
+				resource "proxmox_virtual_environment_sdn_subnet" "acc_subnet" {
+					vnet    = proxmox_virtual_environment_sdn_vnet.acc_vnet.name
+					cidr    = "10.250.0.0/24"
+					gateway = "10.250.0.1"
+
+					dhcp_range = [{
+						start_address = "10.250.0.100"
+						end_address   = "10.250.0.199"
+					}]
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					test.ResourceAttributes("proxmox_virtual_environment_sdn_zone.acc_zone", map[string]string{
+						"dhcp": "dnsmasq",
+					}),
+					test.NoResourceAttributesSet("proxmox_virtual_environment_sdn_zone.acc_zone", []string{
+						"mtu",
+					}),
+					test.NoResourceAttributesSet("proxmox_virtual_environment_sdn_vnet.acc_vnet", []string{
+						"alias",
+					}),
+					test.ResourceAttributes("proxmox_virtual_environment_sdn_subnet.acc_subnet", map[string]string{
+						"dhcp_range.#":               "1",
+						"dhcp_range.0.start_address": "10.250.0.100",
+						"dhcp_range.0.end_address":   "10.250.0.199",
+					}),
+					test.NoResourceAttributesSet("proxmox_virtual_environment_sdn_subnet.acc_subnet", []string{
+						"snat",
+					}),
+				),
+			},
+			{
+				ResourceName:      "proxmox_virtual_environment_sdn_zone.acc_zone",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "proxmox_virtual_environment_sdn_vnet.acc_vnet",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "proxmox_virtual_environment_sdn_subnet.acc_subnet",
+				ImportState:       true,
+				ImportStateId:     "accvnet/10.250.0.0/24",
+				ImportStateVerify: true,
+			},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: te.AccProviders,
+				Steps:                    tt.steps,
+			})
+		})
+	}
+}
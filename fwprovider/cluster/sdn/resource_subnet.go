@@ -0,0 +1,326 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package sdn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/attribute"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/config"
+	customtypes "github.com/bpg/terraform-provider-proxmox/fwprovider/types"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/cluster/sdn"
+)
+
+var (
+	_ resource.Resource                = &subnetResource{}
+	_ resource.ResourceWithConfigure   = &subnetResource{}
+	_ resource.ResourceWithImportState = &subnetResource{}
+)
+
+type subnetResource struct {
+	client *sdn.Client
+}
+
+// NewSubnetResource creates a new resource for managing SDN subnets.
+func NewSubnetResource() resource.Resource {
+	return &subnetResource{}
+}
+
+func (r *subnetResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_sdn_subnet"
+}
+
+func (r *subnetResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(config.Resource)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected config.Resource, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = cfg.Client.Cluster().SDN()
+}
+
+func (r *subnetResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a subnet of an SDN VNet. Changes are staged until they are applied " +
+			"with the `proxmox_virtual_environment_sdn_applier` resource.",
+		Attributes: map[string]schema.Attribute{
+			"id": attribute.ResourceID("The identifier of the subnet in PVE, composed of its zone and CIDR."),
+			"vnet": schema.StringAttribute{
+				Description: "The identifier of the VNet the subnet belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cidr": schema.StringAttribute{
+				Description: "The IP network of the subnet in CIDR notation (e.g. `10.0.0.0/24`).",
+				CustomType:  customtypes.IPCIDRType{},
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"gateway": schema.StringAttribute{
+				Description: "The IP address of the subnet gateway.",
+				CustomType:  customtypes.IPAddrType{},
+				Optional:    true,
+			},
+			"snat": schema.BoolAttribute{
+				Description: "Enable source NAT for the traffic leaving the subnet through the node. " +
+					"If not set, PVE default is `false`.",
+				Optional: true,
+			},
+			"dns_zone_prefix": schema.StringAttribute{
+				Description: "The prefix added to the DNS zone of the hostnames registered in the subnet " +
+					"(e.g. `subnet1` for `hostname.subnet1.example.com`).",
+				Optional:   true,
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"dhcp_dns_server": schema.StringAttribute{
+				Description: "The IP address of the DNS server announced by the DHCP server of the subnet.",
+				CustomType:  customtypes.IPAddrType{},
+				Optional:    true,
+			},
+			"dhcp_range": schema.ListNestedAttribute{
+				Description: "The ranges of addresses handed out by the DHCP server of the zone. " +
+					"Only used when the zone has DHCP enabled.",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"start_address": schema.StringAttribute{
+							Description: "The first address of the range.",
+							CustomType:  customtypes.IPAddrType{},
+							Required:    true,
+						},
+						"end_address": schema.StringAttribute{
+							Description: "The last address of the range.",
+							CustomType:  customtypes.IPAddrType{},
+							Required:    true,
+						},
+					},
+				},
+				Validators: []validator.List{listvalidator.SizeAtLeast(1)},
+			},
+		},
+	}
+}
+
+func (r *subnetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan subnetModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CreateSubnet(ctx, plan.VNet.ValueString(), &sdn.SubnetCreateRequestBody{
+		CIDR:           plan.CIDR.ValueString(),
+		Type:           "subnet",
+		SubnetDataBase: plan.toAPI(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while creating the resource create request.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	r.readBack(ctx, &plan, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *subnetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state subnetModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := r.client.GetSubnetByCIDR(ctx, state.VNet.ValueString(), state.CIDR.ValueString())
+	if err != nil {
+		if errors.Is(err, api.ErrResourceDoesNotExist) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	state.importFromAPI(state.VNet.ValueString(), data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *subnetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state subnetModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planData := plan.toAPI()
+	stateData := state.toAPI()
+
+	err := r.client.UpdateSubnet(ctx, state.VNet.ValueString(), state.ID.ValueString(), &sdn.SubnetUpdateRequestBody{
+		SubnetDataBase: planData,
+		Delete:         deletedSubnetFields(&planData, &stateData),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while creating the resource update request.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	r.readBack(ctx, &plan, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *subnetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state subnetModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteSubnet(ctx, state.VNet.ValueString(), state.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, api.ErrResourceDoesNotExist) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while creating the resource delete request.\n\n"+
+				"Error: "+err.Error(),
+		)
+	}
+}
+
+func (r *subnetResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	vnet, cidr, found := strings.Cut(req.ID, "/")
+	if !found || vnet == "" || cidr == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: `vnet/cidr`. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	data, err := r.client.GetSubnetByCIDR(ctx, vnet, cidr)
+	if err != nil {
+		if errors.Is(err, api.ErrResourceDoesNotExist) {
+			resp.Diagnostics.AddError(
+				"Resource does not exist",
+				"Resource you try to import does not exist.\n\n"+
+					"Error: "+err.Error(),
+			)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Import Resource",
+			"An unexpected error occurred while attempting to import resource state.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	state := &subnetModel{}
+	state.importFromAPI(vnet, data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// readBack reads the subnet from the API and updates the model with the computed values.
+func (r *subnetResource) readBack(ctx context.Context, model *subnetModel, diags *diag.Diagnostics) {
+	data, err := r.client.GetSubnetByCIDR(ctx, model.VNet.ValueString(), model.CIDR.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Unable to Read Resource",
+			"An unexpected error occurred while reading back the SDN subnet.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	model.importFromAPI(model.VNet.ValueString(), data)
+}
@@ -0,0 +1,298 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package sdn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/attribute"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/config"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/cluster/sdn"
+)
+
+var (
+	_ resource.Resource                = &vnetResource{}
+	_ resource.ResourceWithConfigure   = &vnetResource{}
+	_ resource.ResourceWithImportState = &vnetResource{}
+)
+
+type vnetResource struct {
+	client *sdn.Client
+}
+
+// NewVNetResource creates a new resource for managing SDN VNets.
+func NewVNetResource() resource.Resource {
+	return &vnetResource{}
+}
+
+func (r *vnetResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_sdn_vnet"
+}
+
+func (r *vnetResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(config.Resource)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected config.Resource, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = cfg.Client.Cluster().SDN()
+}
+
+func (r *vnetResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an SDN VNet of a Proxmox VE cluster. Changes are staged until they are applied " +
+			"with the `proxmox_virtual_environment_sdn_applier` resource.",
+		Attributes: map[string]schema.Attribute{
+			"id": attribute.ResourceID(),
+			"name": schema.StringAttribute{
+				Description: "The identifier of the VNet, also used as the name of its bridge on the nodes.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9]{1,7}$`),
+						"must start with a letter, be composed of letters and numbers, "+
+							"and must be between 2 and 8 characters long",
+					),
+				},
+			},
+			"zone": schema.StringAttribute{
+				Description: "The identifier of the zone the VNet belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tag": schema.Int64Attribute{
+				Description: "The VLAN or VXLAN ID of the VNet. Required for `vlan`, `qinq`, `vxlan` and `evpn` zones.",
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.Between(1, 16777215)},
+			},
+			"alias": schema.StringAttribute{
+				Description: "The alias of the VNet.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.LengthBetween(1, 256)},
+			},
+			"vlan_aware": schema.BoolAttribute{
+				Description: "Allow guests to use VLAN tags inside the VNet. If not set, PVE default is `false`.",
+				Optional:    true,
+			},
+			"isolate_ports": schema.BoolAttribute{
+				Description: "Prevent guests on the VNet from communicating with each other, " +
+					"only with the gateway. If not set, PVE default is `false`.",
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (r *vnetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vnetModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CreateVNet(ctx, &sdn.VNetCreateRequestBody{
+		ID:           plan.Name.ValueString(),
+		VNetDataBase: plan.toAPI(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while creating the resource create request.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	r.readBack(ctx, plan.Name.ValueString(), &plan, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *vnetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vnetModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := r.client.GetVNet(ctx, state.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, api.ErrResourceDoesNotExist) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	state.importFromAPI(data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *vnetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state vnetModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planData := plan.toAPI()
+	stateData := state.toAPI()
+
+	err := r.client.UpdateVNet(ctx, state.ID.ValueString(), &sdn.VNetUpdateRequestBody{
+		VNetDataBase: planData,
+		Delete:       deletedVNetFields(&planData, &stateData),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while creating the resource update request.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	r.readBack(ctx, state.ID.ValueString(), &plan, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *vnetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state vnetModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteVNet(ctx, state.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, api.ErrResourceDoesNotExist) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while creating the resource delete request.\n\n"+
+				"Error: "+err.Error(),
+		)
+	}
+}
+
+func (r *vnetResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	data, err := r.client.GetVNet(ctx, req.ID)
+	if err != nil {
+		if errors.Is(err, api.ErrResourceDoesNotExist) {
+			resp.Diagnostics.AddError(
+				"Resource does not exist",
+				"Resource you try to import does not exist.\n\n"+
+					"Error: "+err.Error(),
+			)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Import Resource",
+			"An unexpected error occurred while attempting to import resource state.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	state := &vnetModel{}
+	state.importFromAPI(data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// readBack reads the VNet from the API and updates the model with the computed values.
+func (r *vnetResource) readBack(ctx context.Context, id string, model *vnetModel, diags *diag.Diagnostics) {
+	data, err := r.client.GetVNet(ctx, id)
+	if err != nil {
+		diags.AddError(
+			"Unable to Read Resource",
+			"An unexpected error occurred while reading back the SDN VNet.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	model.importFromAPI(data)
+}
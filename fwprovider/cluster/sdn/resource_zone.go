@@ -0,0 +1,452 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package sdn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/attribute"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/config"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/types/stringset"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/cluster/sdn"
+)
+
+var (
+	_ resource.Resource                   = &zoneResource{}
+	_ resource.ResourceWithConfigure      = &zoneResource{}
+	_ resource.ResourceWithImportState    = &zoneResource{}
+	_ resource.ResourceWithValidateConfig = &zoneResource{}
+)
+
+type zoneResource struct {
+	client *sdn.Client
+}
+
+// NewZoneResource creates a new resource for managing SDN zones.
+func NewZoneResource() resource.Resource {
+	return &zoneResource{}
+}
+
+func (r *zoneResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_sdn_zone"
+}
+
+func (r *zoneResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(config.Resource)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected config.Resource, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = cfg.Client.Cluster().SDN()
+}
+
+func (r *zoneResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an SDN zone of a Proxmox VE cluster. Changes are staged until they are applied " +
+			"with the `proxmox_virtual_environment_sdn_applier` resource.",
+		Attributes: map[string]schema.Attribute{
+			"id": attribute.ResourceID(),
+			"name": schema.StringAttribute{
+				Description: "The identifier of the zone.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9]{1,7}$`),
+						"must start with a letter, be composed of letters and numbers, "+
+							"and must be between 2 and 8 characters long",
+					),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "The zone type. Choice is between `simple` | `vlan` | `qinq` | `vxlan` | `evpn`.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(zoneTypeSimple, zoneTypeVLAN, zoneTypeQinQ, zoneTypeVXLAN, zoneTypeEVPN),
+				},
+			},
+			"nodes": stringset.ResourceAttribute(
+				"The nodes the zone is deployed on. If not set, the zone is deployed on all nodes.",
+				"",
+			),
+			"mtu": schema.Int64Attribute{
+				Description: "The MTU of the zone's VNets. If not set, PVE derives it from the underlying interfaces.",
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.Between(576, 65520)},
+			},
+			"dns": schema.StringAttribute{
+				Description: "The identifier of the DNS plugin.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"dns_zone": schema.StringAttribute{
+				Description: "The DNS domain name, used to register the hostnames of guests (e.g. `example.com`).",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"reverse_dns": schema.StringAttribute{
+				Description: "The identifier of the reverse DNS plugin.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"ipam": schema.StringAttribute{
+				Description: "The identifier of the IPAM plugin. If not set, PVE default is `pve`.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"dhcp": schema.StringAttribute{
+				Description: "The DHCP server used for the subnets of the zone. Only `dnsmasq` is supported. " +
+					"Only valid for `simple` zones.",
+				Optional:   true,
+				Validators: []validator.String{stringvalidator.OneOf("dnsmasq")},
+			},
+			"bridge": schema.StringAttribute{
+				Description: "The local bridge or OVS switch the zone is attached to. " +
+					"Required for `vlan` and `qinq` zones.",
+				Optional:   true,
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"service_vlan": schema.Int64Attribute{
+				Description: "The service VLAN tag (outer VLAN). Required for `qinq` zones.",
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.Between(1, 4094)},
+			},
+			"service_vlan_protocol": schema.StringAttribute{
+				Description: "The service VLAN protocol. Choice is between `802.1q` | `802.1ad`. " +
+					"If not set, PVE default is `802.1q`. Only valid for `qinq` zones.",
+				Optional:   true,
+				Validators: []validator.String{stringvalidator.OneOf("802.1q", "802.1ad")},
+			},
+			"peers": stringset.ResourceAttribute(
+				"The IP addresses of the VXLAN tunnel peers, usually one per node. Required for `vxlan` zones.",
+				"",
+			),
+			"controller": schema.StringAttribute{
+				Description: "The identifier of the EVPN controller. Required for `evpn` zones.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"vrf_vxlan": schema.Int64Attribute{
+				Description: "The VXLAN ID of the zone's VRF, used for routing between VNets. Required for `evpn` zones.",
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.Between(1, 16777215)},
+			},
+			"mac": schema.StringAttribute{
+				Description: "The anycast MAC address of the VNet gateways. If not set, PVE generates one. " +
+					"Only valid for `evpn` zones.",
+				Optional:   true,
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"exit_nodes": stringset.ResourceAttribute(
+				"The nodes that route traffic from the EVPN network to the outside. Only valid for `evpn` zones.",
+				"",
+			),
+			"primary_exit_node": schema.StringAttribute{
+				Description: "The exit node preferred for outgoing traffic, instead of load-balancing " +
+					"between all exit nodes. Only valid for `evpn` zones.",
+				Optional:   true,
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"exit_nodes_local_routing": schema.BoolAttribute{
+				Description: "Allow exit nodes to reach the EVPN guests from the node itself. " +
+					"Only valid for `evpn` zones.",
+				Optional: true,
+			},
+			"advertise_subnets": schema.BoolAttribute{
+				Description: "Announce the full subnets in the EVPN network, for silent guests. " +
+					"Only valid for `evpn` zones.",
+				Optional: true,
+			},
+			"disable_arp_nd_suppression": schema.BoolAttribute{
+				Description: "Disable the ARP and ND suppression of the EVPN network. Only valid for `evpn` zones.",
+				Optional:    true,
+			},
+			"rt_import": schema.StringAttribute{
+				Description: "Comma separated list of route targets imported from other EVPN networks. " +
+					"Only valid for `evpn` zones.",
+				Optional:   true,
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+		},
+	}
+}
+
+func (r *zoneResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var data zoneModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Type.IsUnknown() || data.Type.IsNull() {
+		return
+	}
+
+	zoneType := data.Type.ValueString()
+	allowed := zoneTypeAttributes[zoneType]
+	values := data.typeSpecificAttributes()
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		value := values[name]
+		required, ok := allowed[name]
+
+		switch {
+		case required && value.IsNull():
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Missing Attribute Configuration",
+				fmt.Sprintf("`%s` must be set for %q zones.", name, zoneType),
+			)
+		case !ok && !value.IsNull():
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("`%s` can not be set for %q zones.", name, zoneType),
+			)
+		}
+	}
+}
+
+func (r *zoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan zoneModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := plan.toAPI(ctx, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CreateZone(ctx, &sdn.ZoneCreateRequestBody{
+		ID:           plan.Name.ValueString(),
+		Type:         plan.Type.ValueString(),
+		ZoneDataBase: data,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while creating the resource create request.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	r.readBack(ctx, plan.Name.ValueString(), &plan, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *zoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state zoneModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := r.client.GetZone(ctx, state.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, api.ErrResourceDoesNotExist) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	state.importFromAPI(data, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *zoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state zoneModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planData := plan.toAPI(ctx, &resp.Diagnostics)
+	stateData := state.toAPI(ctx, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateZone(ctx, state.ID.ValueString(), &sdn.ZoneUpdateRequestBody{
+		ZoneDataBase: planData,
+		Delete:       deletedZoneFields(&planData, &stateData),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while creating the resource update request.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	r.readBack(ctx, state.ID.ValueString(), &plan, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *zoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state zoneModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteZone(ctx, state.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, api.ErrResourceDoesNotExist) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while creating the resource delete request.\n\n"+
+				"Error: "+err.Error(),
+		)
+	}
+}
+
+func (r *zoneResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	data, err := r.client.GetZone(ctx, req.ID)
+	if err != nil {
+		if errors.Is(err, api.ErrResourceDoesNotExist) {
+			resp.Diagnostics.AddError(
+				"Resource does not exist",
+				"Resource you try to import does not exist.\n\n"+
+					"Error: "+err.Error(),
+			)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Import Resource",
+			"An unexpected error occurred while attempting to import resource state.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	state := &zoneModel{}
+	state.importFromAPI(data, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// readBack reads the zone from the API and updates the model with the computed values.
+func (r *zoneResource) readBack(ctx context.Context, id string, model *zoneModel, diags *diag.Diagnostics) {
+	data, err := r.client.GetZone(ctx, id)
+	if err != nil {
+		diags.AddError(
+			"Unable to Read Resource",
+			"An unexpected error occurred while reading back the SDN zone.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	model.importFromAPI(data, diags)
+}
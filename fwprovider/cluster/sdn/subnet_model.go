@@ -0,0 +1,92 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package sdn
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	customtypes "github.com/bpg/terraform-provider-proxmox/fwprovider/types"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/cluster/sdn"
+	proxmoxtypes "github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+type subnetModel struct {
+	ID            types.String            `tfsdk:"id"`
+	VNet          types.String            `tfsdk:"vnet"`
+	CIDR          customtypes.IPCIDRValue `tfsdk:"cidr"`
+	Gateway       customtypes.IPAddrValue `tfsdk:"gateway"`
+	SNAT          types.Bool              `tfsdk:"snat"`
+	DNSZonePrefix types.String            `tfsdk:"dns_zone_prefix"`
+	DHCPDNSServer customtypes.IPAddrValue `tfsdk:"dhcp_dns_server"`
+	DHCPRange     []subnetDHCPRangeModel  `tfsdk:"dhcp_range"`
+}
+
+type subnetDHCPRangeModel struct {
+	StartAddress customtypes.IPAddrValue `tfsdk:"start_address"`
+	EndAddress   customtypes.IPAddrValue `tfsdk:"end_address"`
+}
+
+// toAPI converts the model to the fields sent to the SDN subnet API.
+func (m *subnetModel) toAPI() sdn.SubnetDataBase {
+	data := sdn.SubnetDataBase{
+		Gateway:       m.Gateway.ValueStringPointer(),
+		SNAT:          proxmoxtypes.CustomBoolPtr(m.SNAT.ValueBoolPointer()),
+		DNSZonePrefix: m.DNSZonePrefix.ValueStringPointer(),
+		DHCPDNSServer: m.DHCPDNSServer.ValueStringPointer(),
+	}
+
+	for _, r := range m.DHCPRange {
+		data.DHCPRange = append(data.DHCPRange, sdn.DHCPRange{
+			StartAddress: r.StartAddress.ValueString(),
+			EndAddress:   r.EndAddress.ValueString(),
+		})
+	}
+
+	return data
+}
+
+// importFromAPI sets the model fields from the SDN subnet API response.
+func (m *subnetModel) importFromAPI(vnet string, data *sdn.SubnetGetResponseData) {
+	m.ID = types.StringValue(data.ID)
+	m.VNet = types.StringValue(vnet)
+	m.CIDR = customtypes.NewIPCIDRPointerValue(data.CIDR)
+	m.Gateway = customtypes.NewIPAddrPointerValue(data.Gateway)
+	m.SNAT = types.BoolPointerValue(data.SNAT.PointerBool())
+	m.DNSZonePrefix = types.StringPointerValue(data.DNSZonePrefix)
+	m.DHCPDNSServer = customtypes.NewIPAddrPointerValue(data.DHCPDNSServer)
+	m.DHCPRange = nil
+
+	for _, r := range data.DHCPRange {
+		m.DHCPRange = append(m.DHCPRange, subnetDHCPRangeModel{
+			StartAddress: customtypes.NewIPAddrPointerValue(&r.StartAddress),
+			EndAddress:   customtypes.NewIPAddrPointerValue(&r.EndAddress),
+		})
+	}
+}
+
+// deletedSubnetFields returns the API fields that are set in the current state but not in the plan.
+func deletedSubnetFields(plan, state *sdn.SubnetDataBase) []string {
+	var toDelete []string
+
+	for _, f := range []struct {
+		name      string
+		inPlan    bool
+		inCurrent bool
+	}{
+		{"gateway", plan.Gateway != nil, state.Gateway != nil},
+		{"snat", plan.SNAT != nil, state.SNAT != nil},
+		{"dnszoneprefix", plan.DNSZonePrefix != nil, state.DNSZonePrefix != nil},
+		{"dhcp-dns-server", plan.DHCPDNSServer != nil, state.DHCPDNSServer != nil},
+		{"dhcp-range", len(plan.DHCPRange) > 0, len(state.DHCPRange) > 0},
+	} {
+		if !f.inPlan && f.inCurrent {
+			toDelete = append(toDelete, f.name)
+		}
+	}
+
+	return toDelete
+}
@@ -0,0 +1,68 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package sdn
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/cluster/sdn"
+	proxmoxtypes "github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+type vnetModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Zone         types.String `tfsdk:"zone"`
+	Tag          types.Int64  `tfsdk:"tag"`
+	Alias        types.String `tfsdk:"alias"`
+	VLANAware    types.Bool   `tfsdk:"vlan_aware"`
+	IsolatePorts types.Bool   `tfsdk:"isolate_ports"`
+}
+
+// toAPI converts the model to the fields sent to the SDN VNet API.
+func (m *vnetModel) toAPI() sdn.VNetDataBase {
+	return sdn.VNetDataBase{
+		Zone:         m.Zone.ValueStringPointer(),
+		Tag:          m.Tag.ValueInt64Pointer(),
+		Alias:        m.Alias.ValueStringPointer(),
+		VLANAware:    proxmoxtypes.CustomBoolPtr(m.VLANAware.ValueBoolPointer()),
+		IsolatePorts: proxmoxtypes.CustomBoolPtr(m.IsolatePorts.ValueBoolPointer()),
+	}
+}
+
+// importFromAPI sets the model fields from the SDN VNet API response.
+func (m *vnetModel) importFromAPI(data *sdn.VNetGetResponseData) {
+	m.ID = types.StringValue(data.ID)
+	m.Name = types.StringValue(data.ID)
+	m.Zone = types.StringPointerValue(data.Zone)
+	m.Tag = types.Int64PointerValue(data.Tag)
+	m.Alias = types.StringPointerValue(data.Alias)
+	m.VLANAware = types.BoolPointerValue(data.VLANAware.PointerBool())
+	m.IsolatePorts = types.BoolPointerValue(data.IsolatePorts.PointerBool())
+}
+
+// deletedVNetFields returns the API fields that are set in the current state but not in the plan.
+func deletedVNetFields(plan, state *sdn.VNetDataBase) []string {
+	var toDelete []string
+
+	for _, f := range []struct {
+		name      string
+		inPlan    bool
+		inCurrent bool
+	}{
+		{"tag", plan.Tag != nil, state.Tag != nil},
+		{"alias", plan.Alias != nil, state.Alias != nil},
+		{"vlanaware", plan.VLANAware != nil, state.VLANAware != nil},
+		{"isolate-ports", plan.IsolatePorts != nil, state.IsolatePorts != nil},
+	} {
+		if !f.inPlan && f.inCurrent {
+			toDelete = append(toDelete, f.name)
+		}
+	}
+
+	return toDelete
+}
@@ -0,0 +1,188 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package sdn
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/types/stringset"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/cluster/sdn"
+	proxmoxtypes "github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+const (
+	zoneTypeSimple = "simple"
+	zoneTypeVLAN   = "vlan"
+	zoneTypeQinQ   = "qinq"
+	zoneTypeVXLAN  = "vxlan"
+	zoneTypeEVPN   = "evpn"
+)
+
+// zoneTypeAttributes lists the type specific attributes of each zone type, mapped to whether they are required.
+var zoneTypeAttributes = map[string]map[string]bool{
+	zoneTypeSimple: {
+		"dhcp": false,
+	},
+	zoneTypeVLAN: {
+		"bridge": true,
+	},
+	zoneTypeQinQ: {
+		"bridge":                true,
+		"service_vlan":          true,
+		"service_vlan_protocol": false,
+	},
+	zoneTypeVXLAN: {
+		"peers": true,
+	},
+	zoneTypeEVPN: {
+		"controller":                 true,
+		"vrf_vxlan":                  true,
+		"mac":                        false,
+		"exit_nodes":                 false,
+		"primary_exit_node":          false,
+		"exit_nodes_local_routing":   false,
+		"advertise_subnets":          false,
+		"disable_arp_nd_suppression": false,
+		"rt_import":                  false,
+	},
+}
+
+type zoneModel struct {
+	ID                      types.String    `tfsdk:"id"`
+	Name                    types.String    `tfsdk:"name"`
+	Type                    types.String    `tfsdk:"type"`
+	Nodes                   stringset.Value `tfsdk:"nodes"`
+	MTU                     types.Int64     `tfsdk:"mtu"`
+	DNS                     types.String    `tfsdk:"dns"`
+	DNSZone                 types.String    `tfsdk:"dns_zone"`
+	ReverseDNS              types.String    `tfsdk:"reverse_dns"`
+	IPAM                    types.String    `tfsdk:"ipam"`
+	DHCP                    types.String    `tfsdk:"dhcp"`
+	Bridge                  types.String    `tfsdk:"bridge"`
+	ServiceVLAN             types.Int64     `tfsdk:"service_vlan"`
+	ServiceVLANProtocol     types.String    `tfsdk:"service_vlan_protocol"`
+	Peers                   stringset.Value `tfsdk:"peers"`
+	Controller              types.String    `tfsdk:"controller"`
+	VRFVXLANID              types.Int64     `tfsdk:"vrf_vxlan"`
+	MAC                     types.String    `tfsdk:"mac"`
+	ExitNodes               stringset.Value `tfsdk:"exit_nodes"`
+	PrimaryExitNode         types.String    `tfsdk:"primary_exit_node"`
+	ExitNodesLocalRouting   types.Bool      `tfsdk:"exit_nodes_local_routing"`
+	AdvertiseSubnets        types.Bool      `tfsdk:"advertise_subnets"`
+	DisableARPNDSuppression types.Bool      `tfsdk:"disable_arp_nd_suppression"`
+	RouteTargetImport       types.String    `tfsdk:"rt_import"`
+}
+
+// typeSpecificAttributes returns the values of the attributes that are only valid for some zone types.
+func (m *zoneModel) typeSpecificAttributes() map[string]attr.Value {
+	return map[string]attr.Value{
+		"dhcp":                       m.DHCP,
+		"bridge":                     m.Bridge,
+		"service_vlan":               m.ServiceVLAN,
+		"service_vlan_protocol":      m.ServiceVLANProtocol,
+		"peers":                      m.Peers,
+		"controller":                 m.Controller,
+		"vrf_vxlan":                  m.VRFVXLANID,
+		"mac":                        m.MAC,
+		"exit_nodes":                 m.ExitNodes,
+		"primary_exit_node":          m.PrimaryExitNode,
+		"exit_nodes_local_routing":   m.ExitNodesLocalRouting,
+		"advertise_subnets":          m.AdvertiseSubnets,
+		"disable_arp_nd_suppression": m.DisableARPNDSuppression,
+		"rt_import":                  m.RouteTargetImport,
+	}
+}
+
+// toAPI converts the model to the fields sent to the SDN zone API.
+func (m *zoneModel) toAPI(ctx context.Context, diags *diag.Diagnostics) sdn.ZoneDataBase {
+	return sdn.ZoneDataBase{
+		Nodes:                   m.Nodes.ValueStringPointer(ctx, diags, stringset.WithSeparator(",")),
+		MTU:                     m.MTU.ValueInt64Pointer(),
+		DNS:                     m.DNS.ValueStringPointer(),
+		DNSZone:                 m.DNSZone.ValueStringPointer(),
+		ReverseDNS:              m.ReverseDNS.ValueStringPointer(),
+		IPAM:                    m.IPAM.ValueStringPointer(),
+		DHCP:                    m.DHCP.ValueStringPointer(),
+		Bridge:                  m.Bridge.ValueStringPointer(),
+		ServiceVLAN:             m.ServiceVLAN.ValueInt64Pointer(),
+		ServiceVLANProtocol:     m.ServiceVLANProtocol.ValueStringPointer(),
+		Peers:                   m.Peers.ValueStringPointer(ctx, diags, stringset.WithSeparator(",")),
+		Controller:              m.Controller.ValueStringPointer(),
+		VRFVXLANID:              m.VRFVXLANID.ValueInt64Pointer(),
+		MAC:                     m.MAC.ValueStringPointer(),
+		ExitNodes:               m.ExitNodes.ValueStringPointer(ctx, diags, stringset.WithSeparator(",")),
+		PrimaryExitNode:         m.PrimaryExitNode.ValueStringPointer(),
+		ExitNodesLocalRouting:   proxmoxtypes.CustomBoolPtr(m.ExitNodesLocalRouting.ValueBoolPointer()),
+		AdvertiseSubnets:        proxmoxtypes.CustomBoolPtr(m.AdvertiseSubnets.ValueBoolPointer()),
+		DisableARPNDSuppression: proxmoxtypes.CustomBoolPtr(m.DisableARPNDSuppression.ValueBoolPointer()),
+		RouteTargetImport:       m.RouteTargetImport.ValueStringPointer(),
+	}
+}
+
+// importFromAPI sets the model fields from the SDN zone API response.
+func (m *zoneModel) importFromAPI(data *sdn.ZoneGetResponseData, diags *diag.Diagnostics) {
+	m.ID = types.StringValue(data.ID)
+	m.Name = types.StringValue(data.ID)
+	m.Type = types.StringValue(data.Type)
+	m.Nodes = stringset.NewValueString(data.Nodes, diags, stringset.WithSeparator(","))
+	m.MTU = types.Int64PointerValue(data.MTU)
+	m.DNS = types.StringPointerValue(data.DNS)
+	m.DNSZone = types.StringPointerValue(data.DNSZone)
+	m.ReverseDNS = types.StringPointerValue(data.ReverseDNS)
+	m.IPAM = types.StringPointerValue(data.IPAM)
+	m.DHCP = types.StringPointerValue(data.DHCP)
+	m.Bridge = types.StringPointerValue(data.Bridge)
+	m.ServiceVLAN = types.Int64PointerValue(data.ServiceVLAN)
+	m.ServiceVLANProtocol = types.StringPointerValue(data.ServiceVLANProtocol)
+	m.Peers = stringset.NewValueString(data.Peers, diags, stringset.WithSeparator(","))
+	m.Controller = types.StringPointerValue(data.Controller)
+	m.VRFVXLANID = types.Int64PointerValue(data.VRFVXLANID)
+	m.MAC = types.StringPointerValue(data.MAC)
+	m.ExitNodes = stringset.NewValueString(data.ExitNodes, diags, stringset.WithSeparator(","))
+	m.PrimaryExitNode = types.StringPointerValue(data.PrimaryExitNode)
+	m.ExitNodesLocalRouting = types.BoolPointerValue(data.ExitNodesLocalRouting.PointerBool())
+	m.AdvertiseSubnets = types.BoolPointerValue(data.AdvertiseSubnets.PointerBool())
+	m.DisableARPNDSuppression = types.BoolPointerValue(data.DisableARPNDSuppression.PointerBool())
+	m.RouteTargetImport = types.StringPointerValue(data.RouteTargetImport)
+}
+
+// deletedZoneFields returns the API fields that are set in the current state but not in the plan.
+func deletedZoneFields(plan, state *sdn.ZoneDataBase) []string {
+	var toDelete []string
+
+	for _, f := range []struct {
+		name      string
+		inPlan    bool
+		inCurrent bool
+	}{
+		{"nodes", plan.Nodes != nil, state.Nodes != nil},
+		{"mtu", plan.MTU != nil, state.MTU != nil},
+		{"dns", plan.DNS != nil, state.DNS != nil},
+		{"dnszone", plan.DNSZone != nil, state.DNSZone != nil},
+		{"reversedns", plan.ReverseDNS != nil, state.ReverseDNS != nil},
+		{"ipam", plan.IPAM != nil, state.IPAM != nil},
+		{"dhcp", plan.DHCP != nil, state.DHCP != nil},
+		{"vlan-protocol", plan.ServiceVLANProtocol != nil, state.ServiceVLANProtocol != nil},
+		{"mac", plan.MAC != nil, state.MAC != nil},
+		{"exitnodes", plan.ExitNodes != nil, state.ExitNodes != nil},
+		{"exitnodes-primary", plan.PrimaryExitNode != nil, state.PrimaryExitNode != nil},
+		{"exitnodes-local-routing", plan.ExitNodesLocalRouting != nil, state.ExitNodesLocalRouting != nil},
+		{"advertise-subnets", plan.AdvertiseSubnets != nil, state.AdvertiseSubnets != nil},
+		{"disable-arp-nd-suppression", plan.DisableARPNDSuppression != nil, state.DisableARPNDSuppression != nil},
+		{"rt-import", plan.RouteTargetImport != nil, state.RouteTargetImport != nil},
+	} {
+		if !f.inPlan && f.inCurrent {
+			toDelete = append(toDelete, f.name)
+		}
+	}
+
+	return toDelete
+}
@@ -0,0 +1,9 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+// Package functions provides the provider-defined functions for parsing and encoding the Proxmox VE
+// string formats, such as disk and network device property strings, volume IDs and task IDs.
+package functions
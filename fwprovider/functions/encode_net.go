@@ -0,0 +1,272 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package functions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/vms"
+	proxmoxtypes "github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+var _ function.Function = &encodeNetFunction{}
+
+type encodeNetFunction struct{}
+
+// NewEncodeNetFunction creates a new encode_net function.
+func NewEncodeNetFunction() function.Function {
+	return &encodeNetFunction{}
+}
+
+func (f *encodeNetFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "encode_net"
+}
+
+func (f *encodeNetFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Encodes a VM network device property string",
+		MarkdownDescription: "Encodes an object with the VM network device attributes, as returned by `parse_net`, " +
+			"into a Proxmox VE network device property string, e.g. `model=virtio,bridge=vmbr0,tag=10`. " +
+			"Only the `model` attribute is required, the null and missing attributes are omitted. " +
+			"The supported attributes are `bridge`, `disconnected`, `firewall`, `mac_address`, `model`, " +
+			"`mtu`, `queues`, `rate_limit`, `trunks` and `vlan_id`.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:        "net",
+				Description: "The network device object or map to encode.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *encodeNetFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var net types.Dynamic
+
+	resp.Error = req.Arguments.Get(ctx, &net)
+	if resp.Error != nil {
+		return
+	}
+
+	device, err := networkDeviceFromValue(ctx, net)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	values := url.Values{}
+
+	if err = device.EncodeValues("net", &values); err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, values.Get("net"))
+}
+
+func networkDeviceFromValue(ctx context.Context, net types.Dynamic) (*vms.CustomNetworkDevice, error) {
+	if net.IsUnderlyingValueNull() {
+		return nil, errors.New("network device must not be null")
+	}
+
+	v, err := net.UnderlyingValue().ToTerraformValue(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read network device: %w", err)
+	}
+
+	attrs := map[string]tftypes.Value{}
+
+	if err = v.As(&attrs); err != nil {
+		return nil, fmt.Errorf("network device must be an object or a map, got %s", v.Type())
+	}
+
+	device := &vms.CustomNetworkDevice{}
+
+	// sort the keys to report the errors in a stable order
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		if err = setNetworkDeviceAttribute(device, k, attrs[k]); err != nil {
+			return nil, fmt.Errorf("invalid %q: %w", k, err)
+		}
+	}
+
+	if device.Model == "" {
+		return nil, errors.New("missing network device model")
+	}
+
+	return device, nil
+}
+
+func setNetworkDeviceAttribute(device *vms.CustomNetworkDevice, name string, v tftypes.Value) error {
+	var err error
+
+	switch name {
+	case "bridge":
+		device.Bridge, err = stringFromValue(v)
+	case "disconnected":
+		device.LinkDown, err = customBoolFromValue(v)
+	case "firewall":
+		device.Firewall, err = customBoolFromValue(v)
+	case "mac_address":
+		device.MACAddress, err = stringFromValue(v)
+	case "model":
+		var model *string
+
+		model, err = stringFromValue(v)
+		if model != nil {
+			device.Model = *model
+		}
+	case "mtu":
+		device.MTU, err = intFromValue(v)
+	case "queues":
+		device.Queues, err = intFromValue(v)
+	case "rate_limit":
+		device.RateLimit, err = floatFromValue(v)
+	case "trunks":
+		device.Trunks, err = trunksFromValue(v)
+	case "vlan_id":
+		device.Tag, err = intFromValue(v)
+	default:
+		return errors.New("unsupported attribute")
+	}
+
+	return err
+}
+
+func stringFromValue(v tftypes.Value) (*string, error) {
+	if v.IsNull() {
+		return nil, nil //nolint:nilnil
+	}
+
+	var s string
+
+	if err := v.As(&s); err != nil {
+		return nil, fmt.Errorf("must be a string, got %s", v.Type())
+	}
+
+	return &s, nil
+}
+
+func customBoolFromValue(v tftypes.Value) (*proxmoxtypes.CustomBool, error) {
+	if v.IsNull() {
+		return nil, nil //nolint:nilnil
+	}
+
+	var b bool
+
+	if v.Type().Is(tftypes.String) {
+		s, _ := stringFromValue(v)
+
+		pb, err := strconv.ParseBool(*s)
+		if err != nil {
+			return nil, fmt.Errorf("must be a bool, got %q", *s)
+		}
+
+		b = pb
+	} else if err := v.As(&b); err != nil {
+		return nil, fmt.Errorf("must be a bool, got %s", v.Type())
+	}
+
+	return proxmoxtypes.CustomBool(b).Pointer(), nil
+}
+
+func floatFromValue(v tftypes.Value) (*float64, error) {
+	if v.IsNull() {
+		return nil, nil //nolint:nilnil
+	}
+
+	if v.Type().Is(tftypes.String) {
+		s, _ := stringFromValue(v)
+
+		f, err := strconv.ParseFloat(*s, 64)
+		if err != nil {
+			return nil, fmt.Errorf("must be a number, got %q", *s)
+		}
+
+		return &f, nil
+	}
+
+	n := new(big.Float)
+
+	if err := v.As(&n); err != nil {
+		return nil, fmt.Errorf("must be a number, got %s", v.Type())
+	}
+
+	f, _ := n.Float64()
+
+	return &f, nil
+}
+
+func intFromValue(v tftypes.Value) (*int, error) {
+	f, err := floatFromValue(v)
+	if f == nil || err != nil {
+		return nil, err
+	}
+
+	if *f != float64(int(*f)) {
+		return nil, fmt.Errorf("must be a whole number, got %v", *f)
+	}
+
+	i := int(*f)
+
+	return &i, nil
+}
+
+func trunksFromValue(v tftypes.Value) ([]int, error) {
+	if v.IsNull() {
+		return nil, nil
+	}
+
+	var elems []tftypes.Value
+
+	if v.Type().Is(tftypes.String) {
+		// trunks in the `<vlan_id>;<vlan_id>` format, as used by proxmox_virtual_environment_vm
+		s, _ := stringFromValue(v)
+
+		for _, t := range strings.Split(*s, ";") {
+			elems = append(elems, tftypes.NewValue(tftypes.String, strings.TrimSpace(t)))
+		}
+	} else if err := v.As(&elems); err != nil {
+		return nil, fmt.Errorf("must be a list of numbers, got %s", v.Type())
+	}
+
+	trunks := make([]int, 0, len(elems))
+
+	for i, e := range elems {
+		t, err := intFromValue(e)
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %w", i, err)
+		}
+
+		if t != nil {
+			trunks = append(trunks, *t)
+		}
+	}
+
+	return trunks, nil
+}
@@ -0,0 +1,271 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package functions
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func run(t *testing.T, f function.Function, result attr.Value, args ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	definitionResp := &function.DefinitionResponse{}
+	f.Definition(ctx, function.DefinitionRequest{}, definitionResp)
+	require.False(t, definitionResp.Diagnostics.HasError(), definitionResp.Diagnostics)
+	require.Len(t, definitionResp.Definition.Parameters, len(args))
+
+	resp := &function.RunResponse{Result: function.NewResultData(result)}
+	f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(args)}, resp)
+
+	return resp.Result.Value(), resp.Error
+}
+
+func objectAttributes(t *testing.T, v attr.Value) map[string]attr.Value {
+	t.Helper()
+
+	o, ok := v.(types.Object)
+	require.True(t, ok, "expected an object, got %T", v)
+
+	return o.Attributes()
+}
+
+func TestParseDisk(t *testing.T) {
+	t.Parallel()
+
+	result, err := run(t, NewParseDiskFunction(), types.ObjectUnknown(diskAttrTypes),
+		types.StringValue("local-lvm:vm-100-disk-0,aio=native,iothread=1,mbps_rd=200,serial=data,size=32G,ssd=0"))
+	require.Nil(t, err)
+
+	attrs := objectAttributes(t, result)
+	assert.Equal(t, types.StringValue("local-lvm:vm-100-disk-0"), attrs["file_volume"])
+	assert.Equal(t, types.StringValue("local-lvm"), attrs["datastore_id"])
+	assert.Equal(t, types.StringValue("vm-100-disk-0"), attrs["path_in_datastore"])
+	assert.Equal(t, types.StringValue("native"), attrs["aio"])
+	assert.Equal(t, types.BoolValue(true), attrs["iothread"])
+	assert.Equal(t, types.BoolValue(false), attrs["ssd"])
+	assert.Equal(t, types.StringValue("data"), attrs["serial"])
+	assert.Equal(t, types.Int64Value(32), attrs["size"])
+	assert.True(t, attrs["cache"].IsNull())
+	assert.True(t, attrs["backup"].IsNull())
+
+	speed := objectAttributes(t, attrs["speed"])
+	assert.Equal(t, types.Int64Value(200), speed["read"])
+	assert.True(t, speed["write"].IsNull())
+
+	result, err = run(t, NewParseDiskFunction(), types.ObjectUnknown(diskAttrTypes),
+		types.StringValue("local:iso/ubuntu.iso,media=cdrom"))
+	require.Nil(t, err)
+
+	attrs = objectAttributes(t, result)
+	assert.Equal(t, types.StringValue("iso/ubuntu.iso"), attrs["path_in_datastore"])
+	assert.Equal(t, types.StringValue("iso"), attrs["file_format"])
+	assert.Equal(t, types.StringValue("cdrom"), attrs["media"])
+	assert.True(t, attrs["speed"].IsNull())
+
+	_, err = run(t, NewParseDiskFunction(), types.ObjectUnknown(diskAttrTypes), types.StringValue("size=abc"))
+	require.NotNil(t, err)
+}
+
+func TestParseNet(t *testing.T) {
+	t.Parallel()
+
+	result, err := run(t, NewParseNetFunction(), types.ObjectUnknown(netAttrTypes),
+		types.StringValue("virtio=BC:24:11:2E:C5:02,bridge=vmbr0,firewall=1,link_down=1,rate=12.5,tag=10,trunks=20;30"))
+	require.Nil(t, err)
+
+	attrs := objectAttributes(t, result)
+	assert.Equal(t, types.StringValue("virtio"), attrs["model"])
+	assert.Equal(t, types.StringValue("BC:24:11:2E:C5:02"), attrs["mac_address"])
+	assert.Equal(t, types.StringValue("vmbr0"), attrs["bridge"])
+	assert.Equal(t, types.BoolValue(true), attrs["firewall"])
+	assert.Equal(t, types.BoolValue(true), attrs["disconnected"])
+	assert.Equal(t, types.Float64Value(12.5), attrs["rate_limit"])
+	assert.Equal(t, types.Int64Value(10), attrs["vlan_id"])
+	assert.Equal(t,
+		types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(20), types.Int64Value(30)}),
+		attrs["trunks"],
+	)
+	assert.True(t, attrs["mtu"].IsNull())
+	assert.True(t, attrs["queues"].IsNull())
+
+	_, err = run(t, NewParseNetFunction(), types.ObjectUnknown(netAttrTypes), types.StringValue("bridge=vmbr0"))
+	require.NotNil(t, err)
+
+	_, err = run(t, NewParseNetFunction(), types.ObjectUnknown(netAttrTypes), types.StringValue("model=e1000,mtu=x"))
+	require.NotNil(t, err)
+}
+
+func TestEncodeNet(t *testing.T) {
+	t.Parallel()
+
+	parsed, err := run(t, NewParseNetFunction(), types.ObjectUnknown(netAttrTypes),
+		types.StringValue("virtio=BC:24:11:2E:C5:02,bridge=vmbr0,tag=10,trunks=20;30"))
+	require.Nil(t, err)
+
+	tests := map[string]struct {
+		value    attr.Value
+		expected string
+		wantErr  bool
+	}{
+		"parse_net result": {
+			value:    parsed,
+			expected: "model=virtio,bridge=vmbr0,macaddr=BC:24:11:2E:C5:02,tag=10,trunks=20;30",
+		},
+		"partial object": {
+			value: types.ObjectValueMust(
+				map[string]attr.Type{
+					"model":        types.StringType,
+					"disconnected": types.BoolType,
+					"mtu":          types.NumberType,
+					"trunks":       types.TupleType{ElemTypes: []attr.Type{types.NumberType, types.NumberType}},
+				},
+				map[string]attr.Value{
+					"model":        types.StringValue("e1000"),
+					"disconnected": types.BoolValue(false),
+					"mtu":          types.NumberValue(bigFloat(1450)),
+					"trunks": types.TupleValueMust(
+						[]attr.Type{types.NumberType, types.NumberType},
+						[]attr.Value{types.NumberValue(bigFloat(1)), types.NumberValue(bigFloat(2))},
+					),
+				},
+			),
+			expected: "model=e1000,link_down=0,mtu=1450,trunks=1;2",
+		},
+		"map of strings": {
+			value: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"model":    types.StringValue("virtio"),
+				"bridge":   types.StringValue("vmbr1"),
+				"firewall": types.StringValue("true"),
+				"trunks":   types.StringValue("10;20"),
+			}),
+			expected: "model=virtio,bridge=vmbr1,firewall=1,trunks=10;20",
+		},
+		"missing model": {
+			value:   types.MapValueMust(types.StringType, map[string]attr.Value{"bridge": types.StringValue("vmbr0")}),
+			wantErr: true,
+		},
+		"unsupported attribute": {
+			value: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"model": types.StringValue("virtio"),
+				"vlan":  types.StringValue("10"),
+			}),
+			wantErr: true,
+		},
+		"invalid value": {
+			value: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"model": types.StringValue("virtio"),
+				"mtu":   types.StringValue("large"),
+			}),
+			wantErr: true,
+		},
+		"not an object": {
+			value:   types.StringValue("model=virtio"),
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := run(t, NewEncodeNetFunction(), types.StringUnknown(), types.DynamicValue(tt.value))
+
+			if tt.wantErr {
+				require.NotNil(t, err)
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, types.StringValue(tt.expected), result)
+		})
+	}
+}
+
+func TestParseVolumeID(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		volumeID    string
+		datastoreID string
+		contentType *string
+		fileName    string
+		wantErr     bool
+	}{
+		"iso":            {"local:iso/ubuntu.iso", "local", ptr("iso"), "ubuntu.iso", false},
+		"snippet":        {"local:snippets/user-data.yaml", "local", ptr("snippets"), "user-data.yaml", false},
+		"block volume":   {"local-lvm:vm-100-disk-0", "local-lvm", nil, "vm-100-disk-0", false},
+		"file volume":    {"local:100/vm-100-disk-0.qcow2", "local", nil, "100/vm-100-disk-0.qcow2", false},
+		"missing path":   {"local:", "", nil, "", true},
+		"missing colon":  {"ubuntu.iso", "", nil, "", true},
+		"missing store":  {":iso/ubuntu.iso", "", nil, "", true},
+		"trailing slash": {"local:iso/", "local", nil, "iso/", false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := run(t, NewParseVolumeIDFunction(), types.ObjectUnknown(volumeIDAttrTypes),
+				types.StringValue(tt.volumeID))
+
+			if tt.wantErr {
+				require.NotNil(t, err)
+				return
+			}
+
+			require.Nil(t, err)
+
+			attrs := objectAttributes(t, result)
+			assert.Equal(t, types.StringValue(tt.datastoreID), attrs["datastore_id"])
+			assert.Equal(t, types.StringPointerValue(tt.contentType), attrs["content_type"])
+			assert.Equal(t, types.StringValue(tt.fileName), attrs["file_name"])
+		})
+	}
+}
+
+func TestParseUPID(t *testing.T) {
+	t.Parallel()
+
+	result, err := run(t, NewParseUPIDFunction(), types.ObjectUnknown(upidAttrTypes),
+		types.StringValue("UPID:pve:000C41A5:0135F6C2:66B0B5E4:qmstart:100:root@pam:"))
+	require.Nil(t, err)
+
+	attrs := objectAttributes(t, result)
+	assert.Equal(t, types.StringValue("pve"), attrs["node_name"])
+	assert.Equal(t, types.Int64Value(0xC41A5), attrs["pid"])
+	assert.Equal(t, types.Int64Value(0x135F6C2), attrs["pstart"])
+	assert.Equal(t, types.StringValue("2024-08-05T11:22:12Z"), attrs["start_time"])
+	assert.Equal(t, types.StringValue("qmstart"), attrs["type"])
+	assert.Equal(t, types.StringValue("100"), attrs["id"])
+	assert.Equal(t, types.StringValue("root@pam"), attrs["user"])
+
+	result, err = run(t, NewParseUPIDFunction(), types.ObjectUnknown(upidAttrTypes),
+		types.StringValue("UPID:pve:000C41A5:0135F6C2:66B0B5E4:aptupdate::root@pam:"))
+	require.Nil(t, err)
+	assert.True(t, objectAttributes(t, result)["id"].IsNull())
+
+	_, err = run(t, NewParseUPIDFunction(), types.ObjectUnknown(upidAttrTypes), types.StringValue("100"))
+	require.NotNil(t, err)
+}
+
+func ptr(s string) *string {
+	return &s
+}
+
+func bigFloat(f float64) *big.Float {
+	return big.NewFloat(f)
+}
@@ -0,0 +1,43 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package functions
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	proxmoxtypes "github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+// unmarshalPropertyString decodes a PVE property string, e.g. `virtio=BC:24:11:2E:C5:02,bridge=vmbr0`,
+// using the JSON unmarshaler of the given device type.
+func unmarshalPropertyString(s string, v json.Unmarshaler) error {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("failed to encode property string: %w", err)
+	}
+
+	return v.UnmarshalJSON(b) //nolint:wrapcheck
+}
+
+func boolValue(b *proxmoxtypes.CustomBool) types.Bool {
+	if b == nil {
+		return types.BoolNull()
+	}
+
+	return b.ToValue()
+}
+
+func int64Value(i *int) types.Int64 {
+	if i == nil {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(int64(*i))
+}
@@ -0,0 +1,175 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package functions
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/vms"
+)
+
+var _ function.Function = &parseDiskFunction{}
+
+//nolint:gochecknoglobals
+var diskSpeedAttrTypes = map[string]attr.Type{
+	"iops_read":            types.Int64Type,
+	"iops_read_burstable":  types.Int64Type,
+	"iops_write":           types.Int64Type,
+	"iops_write_burstable": types.Int64Type,
+	"read":                 types.Int64Type,
+	"read_burstable":       types.Int64Type,
+	"write":                types.Int64Type,
+	"write_burstable":      types.Int64Type,
+}
+
+//nolint:gochecknoglobals
+var diskAttrTypes = map[string]attr.Type{
+	"aio":               types.StringType,
+	"backup":            types.BoolType,
+	"cache":             types.StringType,
+	"datastore_id":      types.StringType,
+	"discard":           types.StringType,
+	"file_format":       types.StringType,
+	"file_volume":       types.StringType,
+	"iothread":          types.BoolType,
+	"media":             types.StringType,
+	"path_in_datastore": types.StringType,
+	"replicate":         types.BoolType,
+	"serial":            types.StringType,
+	"size":              types.Int64Type,
+	"speed":             types.ObjectType{AttrTypes: diskSpeedAttrTypes},
+	"ssd":               types.BoolType,
+}
+
+type parseDiskFunction struct{}
+
+// NewParseDiskFunction creates a new parse_disk function.
+func NewParseDiskFunction() function.Function {
+	return &parseDiskFunction{}
+}
+
+func (f *parseDiskFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_disk"
+}
+
+func (f *parseDiskFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Parses a VM disk property string",
+		MarkdownDescription: "Parses a VM disk property string as returned by the Proxmox VE API, e.g. " +
+			"`local-lvm:vm-100-disk-0,iothread=1,size=32G`, into an object. The attribute names match " +
+			"the `disk` attributes of the `proxmox_virtual_environment_vm2` resource, with the additional `file_volume`, " +
+			"`path_in_datastore` and `media` attributes. The `size` is in gigabytes, " +
+			"and the attributes that are not present in the property string are null.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "disk",
+				Description: "The disk property string to parse.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: diskAttrTypes,
+		},
+	}
+}
+
+func (f *parseDiskFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var disk string
+
+	resp.Error = req.Arguments.Get(ctx, &disk)
+	if resp.Error != nil {
+		return
+	}
+
+	var device vms.CustomStorageDevice
+
+	if err := unmarshalPropertyString(disk, &device); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	if device.FileVolume == "" {
+		resp.Error = function.NewArgumentFuncError(0, "missing disk volume")
+		return
+	}
+
+	datastoreID := types.StringNull()
+	pathInDatastore := types.StringValue(device.FileVolume)
+
+	if id, path, found := strings.Cut(device.FileVolume, ":"); found {
+		datastoreID = types.StringValue(id)
+		pathInDatastore = types.StringValue(path)
+
+		// the volume is not allocated yet when it has the `<datastore_id>:<size>` format
+		if isNumeric(path) {
+			pathInDatastore = types.StringNull()
+		}
+	}
+
+	size := types.Int64Null()
+	if device.Size != nil {
+		size = types.Int64Value(device.Size.InGigabytes())
+	}
+
+	speed := types.ObjectNull(diskSpeedAttrTypes)
+
+	if device.IopsRead != nil || device.MaxIopsRead != nil || device.IopsWrite != nil || device.MaxIopsWrite != nil ||
+		device.MaxReadSpeedMbps != nil || device.BurstableReadSpeedMbps != nil ||
+		device.MaxWriteSpeedMbps != nil || device.BurstableWriteSpeedMbps != nil {
+		var diags diag.Diagnostics
+
+		speed, diags = types.ObjectValue(diskSpeedAttrTypes, map[string]attr.Value{
+			"iops_read":            int64Value(device.IopsRead),
+			"iops_read_burstable":  int64Value(device.MaxIopsRead),
+			"iops_write":           int64Value(device.IopsWrite),
+			"iops_write_burstable": int64Value(device.MaxIopsWrite),
+			"read":                 int64Value(device.MaxReadSpeedMbps),
+			"read_burstable":       int64Value(device.BurstableReadSpeedMbps),
+			"write":                int64Value(device.MaxWriteSpeedMbps),
+			"write_burstable":      int64Value(device.BurstableWriteSpeedMbps),
+		})
+
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		if resp.Error != nil {
+			return
+		}
+	}
+
+	result, diags := types.ObjectValue(diskAttrTypes, map[string]attr.Value{
+		"aio":               types.StringPointerValue(device.AIO),
+		"backup":            boolValue(device.Backup),
+		"cache":             types.StringPointerValue(device.Cache),
+		"datastore_id":      datastoreID,
+		"discard":           types.StringPointerValue(device.Discard),
+		"file_format":       types.StringPointerValue(device.Format),
+		"file_volume":       types.StringValue(device.FileVolume),
+		"iothread":          boolValue(device.IOThread),
+		"media":             types.StringPointerValue(device.Media),
+		"path_in_datastore": pathInDatastore,
+		"replicate":         boolValue(device.Replicate),
+		"serial":            types.StringPointerValue(device.Serial),
+		"size":              size,
+		"speed":             speed,
+		"ssd":               boolValue(device.SSD),
+	})
+
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, result)
+}
@@ -0,0 +1,128 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/vms"
+)
+
+var _ function.Function = &parseNetFunction{}
+
+//nolint:gochecknoglobals
+var netAttrTypes = map[string]attr.Type{
+	"bridge":       types.StringType,
+	"disconnected": types.BoolType,
+	"firewall":     types.BoolType,
+	"mac_address":  types.StringType,
+	"model":        types.StringType,
+	"mtu":          types.Int64Type,
+	"queues":       types.Int64Type,
+	"rate_limit":   types.Float64Type,
+	"trunks":       types.ListType{ElemType: types.Int64Type},
+	"vlan_id":      types.Int64Type,
+}
+
+type parseNetFunction struct{}
+
+// NewParseNetFunction creates a new parse_net function.
+func NewParseNetFunction() function.Function {
+	return &parseNetFunction{}
+}
+
+func (f *parseNetFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_net"
+}
+
+func (f *parseNetFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Parses a VM network device property string",
+		MarkdownDescription: "Parses a VM network device property string as returned by the Proxmox VE API, e.g. " +
+			"`virtio=BC:24:11:2E:C5:02,bridge=vmbr0,tag=10`, into an object. The attribute names match " +
+			"the `network_device` attributes of the `proxmox_virtual_environment_vm2` resource, and the " +
+			"attributes that are not present in the property string are null. The result can be modified and " +
+			"encoded back with `encode_net`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "net",
+				Description: "The network device property string to parse.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: netAttrTypes,
+		},
+	}
+}
+
+func (f *parseNetFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var net string
+
+	resp.Error = req.Arguments.Get(ctx, &net)
+	if resp.Error != nil {
+		return
+	}
+
+	var device vms.CustomNetworkDevice
+
+	if err := unmarshalPropertyString(net, &device); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	if device.Model == "" {
+		resp.Error = function.NewArgumentFuncError(0, "missing network device model")
+		return
+	}
+
+	trunks := types.ListNull(types.Int64Type)
+
+	if len(device.Trunks) > 0 {
+		elems := make([]attr.Value, len(device.Trunks))
+		for i, t := range device.Trunks {
+			elems[i] = types.Int64Value(int64(t))
+		}
+
+		var diags diag.Diagnostics
+
+		trunks, diags = types.ListValue(types.Int64Type, elems)
+
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		if resp.Error != nil {
+			return
+		}
+	}
+
+	result, diags := types.ObjectValue(netAttrTypes, map[string]attr.Value{
+		"bridge":       types.StringPointerValue(device.Bridge),
+		"disconnected": boolValue(device.LinkDown),
+		"firewall":     boolValue(device.Firewall),
+		"mac_address":  types.StringPointerValue(device.MACAddress),
+		"model":        types.StringValue(device.Model),
+		"mtu":          int64Value(device.MTU),
+		"queues":       int64Value(device.Queues),
+		"rate_limit":   types.Float64PointerValue(device.RateLimit),
+		"trunks":       trunks,
+		"vlan_id":      int64Value(device.Tag),
+	})
+
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, result)
+}
@@ -0,0 +1,102 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package functions
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/tasks"
+)
+
+var _ function.Function = &parseUPIDFunction{}
+
+//nolint:gochecknoglobals
+var upidAttrTypes = map[string]attr.Type{
+	"id":         types.StringType,
+	"node_name":  types.StringType,
+	"pid":        types.Int64Type,
+	"pstart":     types.Int64Type,
+	"start_time": types.StringType,
+	"type":       types.StringType,
+	"user":       types.StringType,
+}
+
+type parseUPIDFunction struct{}
+
+// NewParseUPIDFunction creates a new parse_upid function.
+func NewParseUPIDFunction() function.Function {
+	return &parseUPIDFunction{}
+}
+
+func (f *parseUPIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_upid"
+}
+
+func (f *parseUPIDFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Parses a task UPID",
+		MarkdownDescription: "Parses a Proxmox VE task unique process ID (UPID), e.g. " +
+			"`UPID:pve:000C41A5:0135F6C2:66B0B5E4:qmstart:100:root@pam:`, into an object with " +
+			"`node_name`, `pid`, `pstart`, `start_time` (RFC3339), `type`, `id` and `user` attributes. " +
+			"The `id` is null for the tasks that are not bound to a guest or another object.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "upid",
+				Description: "The task UPID to parse.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: upidAttrTypes,
+		},
+	}
+}
+
+func (f *parseUPIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var upid string
+
+	resp.Error = req.Arguments.Get(ctx, &upid)
+	if resp.Error != nil {
+		return
+	}
+
+	taskID, err := tasks.ParseTaskID(upid)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	id := types.StringNull()
+	if taskID.ID != "" {
+		id = types.StringValue(taskID.ID)
+	}
+
+	result, diags := types.ObjectValue(upidAttrTypes, map[string]attr.Value{
+		"id":         id,
+		"node_name":  types.StringValue(taskID.NodeName),
+		"pid":        types.Int64Value(taskID.PID),
+		"pstart":     types.Int64Value(taskID.PStart),
+		"start_time": types.StringValue(taskID.StartTime.Format(time.RFC3339)),
+		"type":       types.StringValue(taskID.Type),
+		"user":       types.StringValue(taskID.User),
+	})
+
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, result)
+}
@@ -0,0 +1,113 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package functions
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &parseVolumeIDFunction{}
+
+//nolint:gochecknoglobals
+var volumeIDAttrTypes = map[string]attr.Type{
+	"content_type": types.StringType,
+	"datastore_id": types.StringType,
+	"file_name":    types.StringType,
+}
+
+type parseVolumeIDFunction struct{}
+
+// NewParseVolumeIDFunction creates a new parse_volume_id function.
+func NewParseVolumeIDFunction() function.Function {
+	return &parseVolumeIDFunction{}
+}
+
+func (f *parseVolumeIDFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "parse_volume_id"
+}
+
+func (f *parseVolumeIDFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Parses a volume ID",
+		MarkdownDescription: "Parses a volume ID with format `<datastore_id>:<content_type>/<file_name>`, " +
+			"such as the `file_id` of a VM disk or the `id` of a downloaded file, into an object with " +
+			"`datastore_id`, `content_type` and `file_name` attributes. The `content_type` is null for " +
+			"volumes without a content type directory, e.g. `local-lvm:vm-100-disk-0` or " +
+			"`local:100/vm-100-disk-0.qcow2`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "volume_id",
+				Description: "The volume ID to parse.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: volumeIDAttrTypes,
+		},
+	}
+}
+
+func (f *parseVolumeIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var volumeID string
+
+	resp.Error = req.Arguments.Get(ctx, &volumeID)
+	if resp.Error != nil {
+		return
+	}
+
+	datastoreID, fileName, contentType, err := parseVolumeID(volumeID)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result, diags := types.ObjectValue(volumeIDAttrTypes, map[string]attr.Value{
+		"content_type": types.StringPointerValue(contentType),
+		"datastore_id": types.StringValue(datastoreID),
+		"file_name":    types.StringValue(fileName),
+	})
+
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, result)
+}
+
+func parseVolumeID(volumeID string) (string, string, *string, error) {
+	datastoreID, path, found := strings.Cut(volumeID, ":")
+	if !found || datastoreID == "" || path == "" {
+		return "", "", nil, errors.New("invalid volume ID format, expected '<datastore_id>:<content_type>/<file_name>'")
+	}
+
+	contentType, fileName, found := strings.Cut(path, "/")
+
+	// VM images on the file based datastores are stored in the '<vm_id>/' directory, which is not a content type
+	if !found || contentType == "" || fileName == "" || isNumeric(contentType) {
+		return datastoreID, path, nil, nil
+	}
+
+	return datastoreID, fileName, &contentType, nil
+}
+
+func isNumeric(s string) bool {
+	return strings.Trim(s, "0123456789") == ""
+}
@@ -0,0 +1,67 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package agent
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/vms"
+)
+
+type agentCommandModel struct {
+	ID             types.String       `tfsdk:"id"`
+	NodeName       types.String       `tfsdk:"node_name"`
+	VMID           types.Int64        `tfsdk:"vm_id"`
+	UserPassword   *userPasswordModel `tfsdk:"user_password"`
+	Files          []fileModel        `tfsdk:"file"`
+	Command        types.List         `tfsdk:"command"`
+	InputData      types.String       `tfsdk:"input_data"`
+	IgnoreExitCode types.Bool         `tfsdk:"ignore_exit_code"`
+	Triggers       types.Map          `tfsdk:"triggers"`
+	ExitCode       types.Int64        `tfsdk:"exit_code"`
+	Stdout         types.String       `tfsdk:"stdout"`
+	Stderr         types.String       `tfsdk:"stderr"`
+	Timeouts       timeouts.Value     `tfsdk:"timeouts"`
+}
+
+type userPasswordModel struct {
+	Username        types.String `tfsdk:"username"`
+	Password        types.String `tfsdk:"password"`
+	PasswordVersion types.Int64  `tfsdk:"password_wo_version"`
+	Crypted         types.Bool   `tfsdk:"crypted"`
+}
+
+type fileModel struct {
+	Path    types.String `tfsdk:"path"`
+	Content types.String `tfsdk:"content"`
+}
+
+// resourceID builds the resource identifier in the `node_name/vm_id` format.
+func (m *agentCommandModel) resourceID() string {
+	return fmt.Sprintf("%s/%d", m.NodeName.ValueString(), m.VMID.ValueInt64())
+}
+
+// importExecStatus sets the outputs of the command from its final status.
+func (m *agentCommandModel) importExecStatus(status *vms.AgentExecStatusResponseData) {
+	m.ExitCode = types.Int64Null()
+	if status.ExitCode != nil {
+		m.ExitCode = types.Int64Value(int64(*status.ExitCode))
+	}
+
+	m.Stdout = types.StringValue("")
+	if status.OutData != nil {
+		m.Stdout = types.StringValue(*status.OutData)
+	}
+
+	m.Stderr = types.StringValue("")
+	if status.ErrData != nil {
+		m.Stderr = types.StringValue(*status.ErrData)
+	}
+}
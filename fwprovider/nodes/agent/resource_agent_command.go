@@ -0,0 +1,422 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package agent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/attribute"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/config"
+	"github.com/bpg/terraform-provider-proxmox/proxmox"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/vms"
+	proxmoxtypes "github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+const defaultCreateTimeout = 10 * time.Minute
+
+var (
+	_ resource.Resource                     = &agentCommandResource{}
+	_ resource.ResourceWithConfigure        = &agentCommandResource{}
+	_ resource.ResourceWithConfigValidators = &agentCommandResource{}
+)
+
+type agentCommandResource struct {
+	client proxmox.Client
+}
+
+// NewAgentCommandResource creates a new resource for running commands in a VM using the QEMU guest agent.
+func NewAgentCommandResource() resource.Resource {
+	return &agentCommandResource{}
+}
+
+func (r *agentCommandResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_vm_agent_command"
+}
+
+func (r *agentCommandResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(config.Resource)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected config.Resource, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = cfg.Client
+}
+
+func (r *agentCommandResource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Runs commands in a VM using the QEMU guest agent.",
+		MarkdownDescription: "Runs commands in a VM using the QEMU guest agent, e.g. for post-provisioning " +
+			"steps that do not require SSH access to the guest.\n\n" +
+			"When the resource is created, the user password is set first, then the files are written, " +
+			"and finally the command is run and waited for. All of this runs again whenever any of the " +
+			"attributes change. Destroying the resource does not change the VM. " +
+			"The QEMU guest agent must be installed and running in the VM.",
+		Attributes: map[string]schema.Attribute{
+			"id": attribute.ResourceID(),
+			"node_name": schema.StringAttribute{
+				Description: "The name of the node the VM is located on.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vm_id": schema.Int64Attribute{
+				Description: "The ID of the VM.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(100),
+				},
+			},
+			"user_password": schema.SingleNestedAttribute{
+				Description: "The password of a user in the guest to set.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"username": schema.StringAttribute{
+						Description: "The name of the user.",
+						Required:    true,
+					},
+					"password": schema.StringAttribute{
+						Description: "The new password of the user.",
+						MarkdownDescription: attribute.WriteOnlyDescription("password",
+							"The new password of the user."),
+						Required:  true,
+						Sensitive: true,
+						WriteOnly: true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(5),
+						},
+					},
+					"password_wo_version": attribute.WriteOnlyVersion("password"),
+					"crypted": schema.BoolAttribute{
+						Description: "Whether the password is already encrypted.",
+						MarkdownDescription: "Whether the password is already encrypted, i.e. passed as is to " +
+							"`crypt()` instead of being encrypted by the agent (defaults to `false`).",
+						Optional: true,
+					},
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
+			"file": schema.ListNestedAttribute{
+				Description: "The files to write in the guest before running the command.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							Description: "The absolute path of the file in the guest.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"content": schema.StringAttribute{
+							Description: "The content of the file.",
+							MarkdownDescription: "The content of the file. The file is replaced if it " +
+								"already exists. PVE limits the content to 60 KiB.",
+							Required: true,
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"command": schema.ListAttribute{
+				Description: "The command to run in the guest, the program followed by its arguments.",
+				MarkdownDescription: "The command to run in the guest, the program followed by its arguments, " +
+					"e.g. `[\"/bin/sh\", \"-c\", \"apt-get update\"]`. The command is not run in a shell.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"input_data": schema.StringAttribute{
+				Description: "The data passed to the standard input of the command.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("command")),
+				},
+			},
+			"ignore_exit_code": schema.BoolAttribute{
+				Description: "Whether to succeed even if the command exits with a non-zero exit code.",
+				MarkdownDescription: "Whether to succeed even if the command exits with a non-zero exit code " +
+					"(defaults to `false`).",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, run the command again.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"exit_code": schema.Int64Attribute{
+				Description: "The exit code of the command.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"stdout": schema.StringAttribute{
+				Description: "The standard output of the command.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"stderr": schema.StringAttribute{
+				Description: "The standard error output of the command.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *agentCommandResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("command"),
+			path.MatchRoot("file"),
+			path.MatchRoot("user_password"),
+		),
+	}
+}
+
+func (r *agentCommandResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, cfg agentCommandModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, d := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(d...)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client := r.client.Node(plan.NodeName.ValueString()).VM(int(plan.VMID.ValueInt64()))
+
+	if plan.UserPassword != nil {
+		err := client.AgentSetUserPassword(ctx, &vms.AgentSetUserPasswordRequestBody{
+			Crypted:  proxmoxtypes.CustomBoolPtr(plan.UserPassword.Crypted.ValueBoolPointer()),
+			Password: cfg.UserPassword.Password.ValueString(),
+			Username: plan.UserPassword.Username.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Create Resource",
+				fmt.Sprintf("An unexpected error occurred while setting the password of user %q.\n\n",
+					plan.UserPassword.Username.ValueString())+
+					"Error: "+err.Error(),
+			)
+
+			return
+		}
+	}
+
+	for _, f := range plan.Files {
+		err := client.AgentFileWrite(ctx, &vms.AgentFileWriteRequestBody{
+			Content: f.Content.ValueString(),
+			File:    f.Path.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Create Resource",
+				fmt.Sprintf("An unexpected error occurred while writing file %q.\n\n", f.Path.ValueString())+
+					"Error: "+err.Error(),
+			)
+
+			return
+		}
+	}
+
+	plan.ExitCode = types.Int64Null()
+	plan.Stdout = types.StringNull()
+	plan.Stderr = types.StringNull()
+
+	if !plan.Command.IsNull() {
+		var command []string
+
+		resp.Diagnostics.Append(plan.Command.ElementsAs(ctx, &command, false)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		status, err := r.exec(ctx, client, command, plan.InputData.ValueStringPointer())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Create Resource",
+				"An unexpected error occurred while running the command.\n\n"+
+					"Error: "+err.Error(),
+			)
+
+			return
+		}
+
+		plan.importExecStatus(status)
+
+		if !plan.IgnoreExitCode.ValueBool() && plan.ExitCode.ValueInt64() != 0 {
+			resp.Diagnostics.AddError(
+				"Unable to Create Resource",
+				fmt.Sprintf("The command exited with code %d.\n\n", plan.ExitCode.ValueInt64())+
+					"Stderr: "+plan.Stderr.ValueString(),
+			)
+
+			return
+		}
+	}
+
+	plan.ID = types.StringValue(plan.resourceID())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// exec runs the command in the guest and waits for it to exit.
+func (r *agentCommandResource) exec(
+	ctx context.Context,
+	client *vms.Client,
+	command []string,
+	inputData *string,
+) (*vms.AgentExecStatusResponseData, error) {
+	pid, err := client.AgentExec(ctx, &vms.AgentExecRequestBody{
+		Command:   command,
+		InputData: inputData,
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return client.WaitForAgentExec(ctx, pid) //nolint:wrapcheck
+}
+
+func (r *agentCommandResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state agentCommandModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.Node(state.NodeName.ValueString()).VM(int(state.VMID.ValueInt64())).GetVMStatus(ctx)
+	if err != nil {
+		if errors.Is(err, api.ErrResourceDoesNotExist) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *agentCommandResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state agentCommandModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// only the timeouts are updated in place, the other attributes force a replacement,
+	// so the command is not run again and its results are kept
+	plan.ExitCode = state.ExitCode
+	plan.Stdout = state.Stdout
+	plan.Stderr = state.Stderr
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *agentCommandResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// nothing to delete, the changes made in the guest are left as is
+}
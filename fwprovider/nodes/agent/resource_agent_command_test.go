@@ -0,0 +1,158 @@
+//go:build acceptance || all
+
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package agent_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/test"
+)
+
+func TestAccResourceVMAgentCommand(t *testing.T) {
+	te := test.InitEnvironment(t)
+
+	vmConfig := `
+	resource "proxmox_virtual_environment_file" "cloud_config" {
+		content_type = "snippets"
+		datastore_id = "local"
+		node_name    = "{{.NodeName}}"
+		source_raw {
+			data = <<-EOF
+			#cloud-config
+			runcmd:
+			  - apt update
+			  - apt install -y qemu-guest-agent
+			  - systemctl enable qemu-guest-agent
+			  - systemctl start qemu-guest-agent
+			EOF
+			file_name = "cloud-config-agent-command.yaml"
+		}
+	}
+
+	resource "proxmox_virtual_environment_download_file" "ubuntu_cloud_image" {
+		content_type        = "iso"
+		datastore_id        = "local"
+		node_name           = "{{.NodeName}}"
+		url                 = "{{.CloudImagesServer}}/jammy/current/jammy-server-cloudimg-amd64.img"
+		overwrite_unmanaged = true
+	}
+
+	resource "proxmox_virtual_environment_vm" "test_vm" {
+		node_name = "{{.NodeName}}"
+		started   = true
+		agent {
+			enabled = true
+		}
+		memory {
+			dedicated = 2048
+		}
+		disk {
+			datastore_id = "local-lvm"
+			file_id      = proxmox_virtual_environment_download_file.ubuntu_cloud_image.id
+			interface    = "virtio0"
+			size         = 20
+		}
+		initialization {
+			ip_config {
+				ipv4 {
+					address = "dhcp"
+				}
+			}
+			user_data_file_id = proxmox_virtual_environment_file.cloud_config.id
+		}
+		network_device {
+			bridge = "vmbr0"
+		}
+	}`
+
+	tests := []struct {
+		name  string
+		steps []resource.TestStep
+	}{
+		{"write a file and run a command", []resource.TestStep{
+			{
+				Config: te.RenderConfig(vmConfig + `
+				resource "proxmox_virtual_environment_vm_agent_command" "test" {
+					node_name = "{{.NodeName}}"
+					vm_id     = proxmox_virtual_environment_vm.test_vm.vm_id
+
+					file = [{
+						path    = "/tmp/greeting"
+						content = "hello"
+					}]
+
+					command    = ["/bin/sh", "-c", "cat /tmp/greeting; cat"]
+					input_data = " world"
+				}`),
+				Check: test.ResourceAttributes("proxmox_virtual_environment_vm_agent_command.test", map[string]string{
+					"exit_code": "0",
+					"stdout":    "hello world",
+					"stderr":    "",
+				}),
+			},
+			{
+				Config: te.RenderConfig(vmConfig + `
+				resource "proxmox_virtual_environment_vm_agent_command" "test" {
+					node_name = "{{.NodeName}}"
+					vm_id     = proxmox_virtual_environment_vm.test_vm.vm_id
+
+					command = ["/bin/sh", "-c", "echo failed >&2; exit 3"]
+				}`),
+				ExpectError: regexp.MustCompile(`The command exited with code 3`),
+			},
+			{
+				Config: te.RenderConfig(vmConfig + `
+				resource "proxmox_virtual_environment_vm_agent_command" "test" {
+					node_name        = "{{.NodeName}}"
+					vm_id            = proxmox_virtual_environment_vm.test_vm.vm_id
+					ignore_exit_code = true
+
+					command = ["/bin/sh", "-c", "echo failed >&2; exit 3"]
+				}`),
+				Check: test.ResourceAttributes("proxmox_virtual_environment_vm_agent_command.test", map[string]string{
+					"exit_code": "3",
+					"stderr":    "failed\n",
+				}),
+			},
+		}},
+		{"set a user password", []resource.TestStep{
+			{
+				Config: te.RenderConfig(vmConfig + `
+				resource "proxmox_virtual_environment_vm_agent_command" "test" {
+					node_name = "{{.NodeName}}"
+					vm_id     = proxmox_virtual_environment_vm.test_vm.vm_id
+
+					user_password = {
+						username            = "root"
+						password            = "Passw0rd!"
+						password_wo_version = 1
+					}
+				}`),
+				Check: test.NoResourceAttributesSet("proxmox_virtual_environment_vm_agent_command.test", []string{
+					"user_password.password",
+					"exit_code",
+					"stdout",
+					"stderr",
+				}),
+			},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource.ParallelTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: te.AccProviders,
+				Steps:                    tt.steps,
+			})
+		})
+	}
+}
@@ -0,0 +1,71 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package agent_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/agent"
+)
+
+// TestAgentCommandUpdateTimeouts tests that changing only the timeouts keeps the results of the command.
+func TestAgentCommandUpdateTimeouts(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	r := agent.NewAgentCommandResource()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError(), schemaResp.Diagnostics)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}
+
+	for k, v := range map[string]any{
+		"id":        "pve/100",
+		"node_name": "pve",
+		"vm_id":     int64(100),
+		"command":   []string{"/bin/true"},
+		"exit_code": int64(0),
+		"stdout":    "done",
+		"stderr":    "",
+	} {
+		require.False(t, state.SetAttribute(ctx, path.Root(k), v).HasError())
+	}
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: state.Raw.Copy()}
+	require.False(t, plan.SetAttribute(ctx, path.Root("timeouts").AtName("create"), "10m").HasError())
+	require.False(t, plan.SetAttribute(ctx, path.Root("exit_code"), types.Int64Unknown()).HasError())
+	require.False(t, plan.SetAttribute(ctx, path.Root("stdout"), types.StringUnknown()).HasError())
+	require.False(t, plan.SetAttribute(ctx, path.Root("stderr"), types.StringUnknown()).HasError())
+
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var (
+		exitCode       types.Int64
+		stdout, create types.String
+	)
+
+	require.False(t, resp.State.GetAttribute(ctx, path.Root("exit_code"), &exitCode).HasError())
+	require.False(t, resp.State.GetAttribute(ctx, path.Root("stdout"), &stdout).HasError())
+	require.False(t, resp.State.GetAttribute(ctx, path.Root("timeouts").AtName("create"), &create).HasError())
+	assert.Equal(t, int64(0), exitCode.ValueInt64())
+	assert.Equal(t, "done", stdout.ValueString())
+	assert.Equal(t, "10m", create.ValueString())
+}
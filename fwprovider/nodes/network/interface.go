@@ -0,0 +1,445 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/attribute"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/config"
+	customtypes "github.com/bpg/terraform-provider-proxmox/fwprovider/types"
+	"github.com/bpg/terraform-provider-proxmox/proxmox"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes"
+)
+
+var (
+	_ resource.Resource                = &interfaceResource{}
+	_ resource.ResourceWithConfigure   = &interfaceResource{}
+	_ resource.ResourceWithImportState = &interfaceResource{}
+)
+
+// interfaceResource implements the resources of the generic network interface types, the type
+// specific behaviour is provided by the interfaceModel implementations.
+type interfaceResource struct {
+	client proxmox.Client
+
+	// interfaceType is the interface type used by the PVE API, e.g. `bond` or `OVSBridge`.
+	interfaceType string
+	// label is the human-readable name of the interface type used in diagnostics, e.g. `Linux Bond`.
+	label string
+	// typeNameSuffix is appended to the provider type name to form the resource type name.
+	typeNameSuffix string
+	// description is the Markdown description of the resource.
+	description string
+	// name is the schema attribute of the interface name.
+	name schema.StringAttribute
+	// attributes are the type specific schema attributes.
+	attributes map[string]schema.Attribute
+	// newModel returns an empty model of the interface type.
+	newModel func() interfaceModel
+}
+
+func (r *interfaceResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + r.typeNameSuffix
+}
+
+// Schema defines the schema for the resource.
+func (r *interfaceResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	attributes := map[string]schema.Attribute{
+		"id": attribute.ResourceID("A unique identifier with format `<node name>:<iface>`"),
+		"node_name": schema.StringAttribute{
+			Description: "The name of the node.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"name": r.name,
+		"address": schema.StringAttribute{
+			Description: "The interface IPv4/CIDR address.",
+			CustomType:  customtypes.IPCIDRType{},
+			Optional:    true,
+		},
+		"gateway": schema.StringAttribute{
+			Description: "Default gateway address.",
+			CustomType:  customtypes.IPAddrType{},
+			Optional:    true,
+		},
+		"address6": schema.StringAttribute{
+			Description: "The interface IPv6/CIDR address.",
+			CustomType:  customtypes.IPCIDRType{},
+			Optional:    true,
+		},
+		"gateway6": schema.StringAttribute{
+			Description: "Default IPv6 gateway address.",
+			CustomType:  customtypes.IPAddrType{},
+			Optional:    true,
+		},
+		"autostart": schema.BoolAttribute{
+			Description: "Automatically start interface on boot (defaults to `true`).",
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(true),
+		},
+		"mtu": schema.Int64Attribute{
+			Description: "The interface MTU.",
+			Optional:    true,
+		},
+		"comment": schema.StringAttribute{
+			Description: "Comment for the interface.",
+			Optional:    true,
+			Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+	}
+
+	maps.Copy(attributes, r.attributes)
+
+	resp.Schema = schema.Schema{
+		Description:         r.description,
+		MarkdownDescription: r.description,
+		Attributes:          attributes,
+	}
+}
+
+func (r *interfaceResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(config.Resource)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected config.Resource, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = cfg.Client
+}
+
+// exportToAPI returns the create / update request body of the model.
+func (r *interfaceResource) exportToAPI(
+	ctx context.Context,
+	model interfaceModel,
+	diags *diag.Diagnostics,
+) *nodes.NetworkInterfaceCreateUpdateRequestBody {
+	body := &nodes.NetworkInterfaceCreateUpdateRequestBody{
+		Type: r.interfaceType,
+	}
+
+	model.base().exportBaseToAPI(body)
+	model.exportToAPI(ctx, body, diags)
+
+	return body
+}
+
+// read refreshes the model from the API. Returns false if the interface does not exist.
+func (r *interfaceResource) read(ctx context.Context, model interfaceModel, diags *diag.Diagnostics) bool {
+	name := model.base().Name.ValueString()
+
+	ifaces, err := r.client.Node(model.base().NodeName.ValueString()).ListNetworkInterfaces(ctx)
+	if err != nil {
+		diags.AddError(
+			"Error listing network interfaces",
+			"Could not list network interfaces, unexpected error: "+err.Error(),
+		)
+
+		return false
+	}
+
+	for _, iface := range ifaces {
+		if iface.Iface != name {
+			continue
+		}
+
+		if iface.Type != r.interfaceType {
+			diags.AddError(
+				"Unexpected network interface type",
+				fmt.Sprintf("Network interface %q is of type %q, expected %q.", name, iface.Type, r.interfaceType),
+			)
+
+			return false
+		}
+
+		model.base().importBaseFromAPI(iface)
+		model.importFromAPI(ctx, iface, diags)
+
+		return true
+	}
+
+	return false
+}
+
+func (r *interfaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := r.newModel()
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := r.exportToAPI(ctx, plan, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nodeClient := r.client.Node(plan.base().NodeName.ValueString())
+
+	err := nodeClient.CreateNetworkInterface(ctx, body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error creating %s interface", r.label),
+			fmt.Sprintf("Could not create %s, unexpected error: %s", r.label, err.Error()),
+		)
+
+		return
+	}
+
+	reloadNetworkConfiguration(ctx, nodeClient, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.base().ID = types.StringValue(plan.base().NodeName.ValueString() + ":" + plan.base().Name.ValueString())
+
+	if !r.read(ctx, plan, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Error creating %s interface", r.label),
+				fmt.Sprintf("%s %q was not found after creation.", r.label, body.Iface),
+			)
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *interfaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := r.newModel()
+
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.read(ctx, state, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.State.RemoveResource(ctx)
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *interfaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := r.newModel()
+	state := r.newModel()
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := r.exportToAPI(ctx, plan, &resp.Diagnostics)
+	stateBody := r.exportToAPI(ctx, state, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if toDelete := deletedInterfaceFields(body, stateBody); len(toDelete) > 0 {
+		body.Delete = &toDelete
+	}
+
+	nodeClient := r.client.Node(plan.base().NodeName.ValueString())
+
+	err := nodeClient.UpdateNetworkInterface(ctx, body.Iface, body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error updating %s interface", r.label),
+			fmt.Sprintf("Could not update %s, unexpected error: %s", r.label, err.Error()),
+		)
+
+		return
+	}
+
+	reloadNetworkConfiguration(ctx, nodeClient, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.read(ctx, plan, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Error updating %s interface", r.label),
+				fmt.Sprintf("%s %q no longer exists.", r.label, body.Iface),
+			)
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *interfaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := r.newModel()
+
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.base().Name.ValueString()
+	nodeClient := r.client.Node(state.base().NodeName.ValueString())
+
+	err := nodeClient.DeleteNetworkInterface(ctx, name)
+	if err != nil {
+		if strings.Contains(err.Error(), "interface does not exist") {
+			resp.Diagnostics.AddWarning(
+				fmt.Sprintf("%s interface does not exist", r.label),
+				fmt.Sprintf("Could not delete %s '%s', interface does not exist, "+
+					"or has already been deleted outside of Terraform.", r.label, name),
+			)
+		} else {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Error deleting %s interface", r.label),
+				fmt.Sprintf("Could not delete %s '%s', unexpected error: %s", r.label, name, err.Error()),
+			)
+		}
+
+		return
+	}
+
+	reloadNetworkConfiguration(ctx, nodeClient, &resp.Diagnostics)
+}
+
+func (r *interfaceResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	nodeName, iface, found := strings.Cut(req.ID, ":")
+	if !found || nodeName == "" || iface == "" || strings.Contains(iface, ":") {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: `node_name:iface`. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	state := r.newModel()
+	state.base().ID = types.StringValue(req.ID)
+	state.base().NodeName = types.StringValue(nodeName)
+	state.base().Name = types.StringValue(iface)
+
+	if !r.read(ctx, state, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError(
+				"Resource does not exist",
+				fmt.Sprintf("%s %q does not exist on node %q.", r.label, iface, nodeName),
+			)
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// nameAttribute returns the `name` attribute of an interface type, validated by the given expression.
+func nameAttribute(description string, expr *regexp.Regexp, message string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: description,
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(expr, message),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// interfacesAttribute returns a set attribute holding the names of other network interfaces.
+func interfacesAttribute(description string) schema.SetAttribute {
+	return schema.SetAttribute{
+		Description: description,
+		ElementType: types.StringType,
+		Required:    true,
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+			setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+		},
+	}
+}
+
+// reloadNetworkConfiguration applies the pending network configuration of the node. When the reload fails,
+// the pending changes are reverted, so they are not applied together with unrelated changes later on.
+func reloadNetworkConfiguration(ctx context.Context, nodeClient *nodes.Client, diags *diag.Diagnostics) {
+	err := nodeClient.ReloadNetworkConfiguration(ctx)
+	if err == nil {
+		return
+	}
+
+	diags.AddError(
+		"Error reloading network configuration",
+		fmt.Sprintf("Could not reload network configuration on node '%s', unexpected error: %s",
+			nodeClient.NodeName, err.Error()),
+	)
+
+	err = nodeClient.RevertNetworkConfiguration(ctx)
+	if err != nil {
+		diags.AddError(
+			"Error reverting network configuration",
+			fmt.Sprintf("Could not revert pending network configuration on node '%s', unexpected error: %s",
+				nodeClient.NodeName, err.Error()),
+		)
+	}
+}
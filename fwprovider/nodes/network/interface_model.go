@@ -0,0 +1,163 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package network
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	customtypes "github.com/bpg/terraform-provider-proxmox/fwprovider/types"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes"
+	proxmoxtypes "github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+// interfaceModel is implemented by the models of all generic network interface types.
+type interfaceModel interface {
+	// base returns the attributes shared by all interface types.
+	base() *interfaceModelBase
+	// exportToAPI sets the type specific fields of the request body.
+	exportToAPI(ctx context.Context, body *nodes.NetworkInterfaceCreateUpdateRequestBody, diags *diag.Diagnostics)
+	// importFromAPI sets the type specific attributes from the API response.
+	importFromAPI(ctx context.Context, iface *nodes.NetworkInterfaceListResponseData, diags *diag.Diagnostics)
+}
+
+// interfaceModelBase contains the attributes shared by all generic network interface types.
+type interfaceModelBase struct {
+	ID        types.String            `tfsdk:"id"`
+	NodeName  types.String            `tfsdk:"node_name"`
+	Name      types.String            `tfsdk:"name"`
+	Address   customtypes.IPCIDRValue `tfsdk:"address"`
+	Gateway   customtypes.IPAddrValue `tfsdk:"gateway"`
+	Address6  customtypes.IPCIDRValue `tfsdk:"address6"`
+	Gateway6  customtypes.IPAddrValue `tfsdk:"gateway6"`
+	Autostart types.Bool              `tfsdk:"autostart"`
+	MTU       types.Int64             `tfsdk:"mtu"`
+	Comment   types.String            `tfsdk:"comment"`
+}
+
+func (m *interfaceModelBase) base() *interfaceModelBase {
+	return m
+}
+
+func (m *interfaceModelBase) exportBaseToAPI(body *nodes.NetworkInterfaceCreateUpdateRequestBody) {
+	body.Iface = m.Name.ValueString()
+	body.Autostart = proxmoxtypes.CustomBool(m.Autostart.ValueBool()).Pointer()
+	body.CIDR = m.Address.ValueStringPointer()
+	body.Gateway = m.Gateway.ValueStringPointer()
+	body.CIDR6 = m.Address6.ValueStringPointer()
+	body.Gateway6 = m.Gateway6.ValueStringPointer()
+	body.MTU = m.MTU.ValueInt64Pointer()
+	body.Comments = m.Comment.ValueStringPointer()
+}
+
+func (m *interfaceModelBase) importBaseFromAPI(iface *nodes.NetworkInterfaceListResponseData) {
+	m.Address = customtypes.NewIPCIDRPointerValue(iface.CIDR)
+	m.Gateway = customtypes.NewIPAddrPointerValue(iface.Gateway)
+	m.Address6 = customtypes.NewIPCIDRPointerValue(iface.CIDR6)
+	m.Gateway6 = customtypes.NewIPAddrPointerValue(iface.Gateway6)
+	m.Autostart = types.BoolValue(iface.Autostart != nil && bool(*iface.Autostart))
+	m.MTU = types.Int64Null()
+
+	if iface.MTU != nil {
+		if v, err := strconv.ParseInt(*iface.MTU, 10, 64); err == nil {
+			m.MTU = types.Int64Value(v)
+		}
+	}
+
+	m.Comment = types.StringNull()
+
+	if iface.Comments != nil && strings.TrimSpace(*iface.Comments) != "" {
+		m.Comment = types.StringValue(strings.TrimSpace(*iface.Comments))
+	}
+}
+
+// joinInterfaces returns the interface names of the set as a space separated list, or nil if the set is empty.
+func joinInterfaces(ctx context.Context, set types.Set, diags *diag.Diagnostics) *string {
+	if set.IsNull() || set.IsUnknown() {
+		return nil
+	}
+
+	var names []string
+
+	diags.Append(set.ElementsAs(ctx, &names, false)...)
+
+	if len(names) == 0 {
+		return nil
+	}
+
+	sort.Strings(names)
+
+	s := strings.Join(names, " ")
+
+	return &s
+}
+
+// splitInterfaces returns the interface names of a space separated list as a set, or a null set if the list is empty.
+func splitInterfaces(ctx context.Context, s *string, diags *diag.Diagnostics) types.Set {
+	if s == nil || strings.TrimSpace(*s) == "" {
+		return types.SetNull(types.StringType)
+	}
+
+	set, d := types.SetValueFrom(ctx, types.StringType, strings.Fields(*s))
+	diags.Append(d...)
+
+	return set
+}
+
+// ovsTag returns the OVS VLAN tag in the format expected by the API.
+func ovsTag(tag types.Int64) *string {
+	if tag.IsNull() || tag.IsUnknown() {
+		return nil
+	}
+
+	s := strconv.FormatInt(tag.ValueInt64(), 10)
+
+	return &s
+}
+
+// stringValue returns a null value for empty API strings.
+func stringValue(s *string) types.String {
+	if s == nil || *s == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(*s)
+}
+
+// deletedInterfaceFields returns the API fields that are set in the current state but not in the plan.
+//
+// The addresses are not part of the list: removing the last address of an interface makes PVE drop the
+// whole interface from the configuration.
+func deletedInterfaceFields(plan, state *nodes.NetworkInterfaceCreateUpdateRequestBody) []string {
+	var toDelete []string
+
+	for _, f := range []struct {
+		name      string
+		inPlan    bool
+		inCurrent bool
+	}{
+		{"bond-primary", plan.BondPrimary != nil, state.BondPrimary != nil},
+		{"bond_xmit_hash_policy", plan.BondXmitHashPolicy != nil, state.BondXmitHashPolicy != nil},
+		{"comments", plan.Comments != nil, state.Comments != nil},
+		{"gateway", plan.Gateway != nil, state.Gateway != nil},
+		{"gateway6", plan.Gateway6 != nil, state.Gateway6 != nil},
+		{"mtu", plan.MTU != nil, state.MTU != nil},
+		{"ovs_options", plan.OVSOptions != nil, state.OVSOptions != nil},
+		{"ovs_tag", plan.OVSTag != nil, state.OVSTag != nil},
+	} {
+		if !f.inPlan && f.inCurrent {
+			toDelete = append(toDelete, f.name)
+		}
+	}
+
+	return toDelete
+}
@@ -0,0 +1,100 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package network
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes"
+)
+
+var bondNameRegex = regexp.MustCompile(`^bond\d{1,4}$`)
+
+type linuxBondModel struct {
+	interfaceModelBase
+
+	Slaves      types.Set    `tfsdk:"slaves"`
+	BondMode    types.String `tfsdk:"bond_mode"`
+	BondPrimary types.String `tfsdk:"bond_primary"`
+	HashPolicy  types.String `tfsdk:"hash_policy"`
+}
+
+func (m *linuxBondModel) exportToAPI(
+	ctx context.Context,
+	body *nodes.NetworkInterfaceCreateUpdateRequestBody,
+	diags *diag.Diagnostics,
+) {
+	body.Slaves = joinInterfaces(ctx, m.Slaves, diags)
+	body.BondMode = m.BondMode.ValueStringPointer()
+	body.BondPrimary = m.BondPrimary.ValueStringPointer()
+	body.BondXmitHashPolicy = m.HashPolicy.ValueStringPointer()
+}
+
+func (m *linuxBondModel) importFromAPI(
+	ctx context.Context,
+	iface *nodes.NetworkInterfaceListResponseData,
+	diags *diag.Diagnostics,
+) {
+	m.Slaves = splitInterfaces(ctx, iface.Slaves, diags)
+	m.BondMode = stringValue(iface.BondMode)
+	m.BondPrimary = stringValue(iface.BondPrimary)
+	m.HashPolicy = stringValue(iface.BondXmitHashPolicy)
+}
+
+// NewLinuxBondResource creates a new resource for managing Linux Bond network interfaces.
+func NewLinuxBondResource() resource.Resource {
+	return &interfaceResource{
+		interfaceType:  "bond",
+		label:          "Linux Bond",
+		typeNameSuffix: "_network_linux_bond",
+		description:    "Manages a Linux Bond network interface in a Proxmox VE node.",
+		name: nameAttribute(
+			"The interface name, must be in the `bond[N]` format, e.g. `bond0`.",
+			bondNameRegex,
+			"must be in the bond[N] format",
+		),
+		attributes: map[string]schema.Attribute{
+			"slaves": interfacesAttribute("The interfaces aggregated by the bond, e.g. `[\"eno1\", \"eno2\"]`."),
+			"bond_mode": schema.StringAttribute{
+				Description: "The bonding mode. Choice is between `balance-rr` | `active-backup` | `balance-xor` | " +
+					"`broadcast` | `802.3ad` | `balance-tlb` | `balance-alb` (defaults to `balance-rr`).",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("balance-rr"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"balance-rr", "active-backup", "balance-xor", "broadcast", "802.3ad", "balance-tlb", "balance-alb",
+					),
+				},
+			},
+			"bond_primary": schema.StringAttribute{
+				Description: "The primary interface of the bond, only used in the `active-backup` mode.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"hash_policy": schema.StringAttribute{
+				Description: "The transmit hash policy, used in the `balance-xor` and `802.3ad` modes. " +
+					"Choice is between `layer2` | `layer2+3` | `layer3+4` | `encap2+3` | `encap3+4`. " +
+					"If not set, PVE default is `layer2`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("layer2", "layer2+3", "layer3+4", "encap2+3", "encap3+4"),
+				},
+			},
+		},
+		newModel: func() interfaceModel { return &linuxBondModel{} },
+	}
+}
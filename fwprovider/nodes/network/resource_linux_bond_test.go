@@ -0,0 +1,126 @@
+//go:build acceptance || all
+
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package network_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/test"
+)
+
+const (
+	accTestLinuxBondName = "proxmox_virtual_environment_network_linux_bond.test"
+)
+
+func TestAccResourceLinuxBond(t *testing.T) {
+	te := test.InitEnvironment(t)
+
+	iface := os.Getenv("PROXMOX_VE_ACC_IFACE_NAME")
+	if iface == "" {
+		iface = "ens18"
+	}
+
+	name := fmt.Sprintf("bond%d", gofakeit.Number(10, 99))
+	vlan1 := gofakeit.Number(10, 2000)
+	vlan2 := gofakeit.Number(2001, 4094)
+
+	te.AddTemplateVars(map[string]any{
+		"Iface": iface,
+		"Name":  name,
+		"VLAN1": vlan1,
+		"VLAN2": vlan2,
+	})
+
+	slaves := `
+	resource "proxmox_virtual_environment_network_linux_vlan" "slave1" {
+		node_name = "{{.NodeName}}"
+		name      = "{{.Iface}}.{{.VLAN1}}"
+	}
+
+	resource "proxmox_virtual_environment_network_linux_vlan" "slave2" {
+		node_name = "{{.NodeName}}"
+		name      = "{{.Iface}}.{{.VLAN2}}"
+	}`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: te.AccProviders,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: te.RenderConfig(slaves + `
+				resource "proxmox_virtual_environment_network_linux_bond" "test" {
+					node_name   = "{{.NodeName}}"
+					name        = "{{.Name}}"
+					slaves      = [
+						proxmox_virtual_environment_network_linux_vlan.slave1.name,
+						proxmox_virtual_environment_network_linux_vlan.slave2.name,
+					]
+					bond_mode   = "balance-xor"
+					hash_policy = "layer2+3"
+					mtu         = 1499
+					comment     = "created by terraform"
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					test.ResourceAttributes(accTestLinuxBondName, map[string]string{
+						"id":          fmt.Sprintf("%s:%s", te.NodeName, name),
+						"name":        name,
+						"slaves.#":    "2",
+						"bond_mode":   "balance-xor",
+						"hash_policy": "layer2+3",
+						"mtu":         "1499",
+						"comment":     "created by terraform",
+						"autostart":   "true",
+					}),
+					test.NoResourceAttributesSet(accTestLinuxBondName, []string{
+						"address",
+						"bond_primary",
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      accTestLinuxBondName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update testing
+			{
+				Config: te.RenderConfig(slaves + `
+				resource "proxmox_virtual_environment_network_linux_bond" "test" {
+					node_name    = "{{.NodeName}}"
+					name         = "{{.Name}}"
+					slaves       = [
+						proxmox_virtual_environment_network_linux_vlan.slave1.name,
+						proxmox_virtual_environment_network_linux_vlan.slave2.name,
+					]
+					bond_mode    = "active-backup"
+					bond_primary = proxmox_virtual_environment_network_linux_vlan.slave1.name
+					address      = "10.99.99.2/24"
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					test.ResourceAttributes(accTestLinuxBondName, map[string]string{
+						"bond_mode":    "active-backup",
+						"bond_primary": fmt.Sprintf("%s.%d", iface, vlan1),
+						"address":      "10.99.99.2/24",
+					}),
+					test.NoResourceAttributesSet(accTestLinuxBondName, []string{
+						"comment",
+						"hash_policy",
+						"mtu",
+					}),
+				),
+			},
+		},
+	})
+}
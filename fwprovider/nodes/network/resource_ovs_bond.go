@@ -0,0 +1,84 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package network
+
+import (
+	"context"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes"
+)
+
+type ovsBondModel struct {
+	ovsPortModel
+
+	Slaves   types.Set    `tfsdk:"slaves"`
+	BondMode types.String `tfsdk:"bond_mode"`
+}
+
+func (m *ovsBondModel) exportToAPI(
+	ctx context.Context,
+	body *nodes.NetworkInterfaceCreateUpdateRequestBody,
+	diags *diag.Diagnostics,
+) {
+	m.ovsPortModel.exportToAPI(ctx, body, diags)
+
+	body.OVSBonds = joinInterfaces(ctx, m.Slaves, diags)
+	body.BondMode = m.BondMode.ValueStringPointer()
+}
+
+func (m *ovsBondModel) importFromAPI(
+	ctx context.Context,
+	iface *nodes.NetworkInterfaceListResponseData,
+	diags *diag.Diagnostics,
+) {
+	m.ovsPortModel.importFromAPI(ctx, iface, diags)
+
+	m.Slaves = splitInterfaces(ctx, iface.OVSBonds, diags)
+	m.BondMode = stringValue(iface.BondMode)
+}
+
+// NewOVSBondResource creates a new resource for managing OVS Bond network interfaces.
+func NewOVSBondResource() resource.Resource {
+	attributes := ovsPortAttributes()
+
+	maps.Copy(attributes, map[string]schema.Attribute{
+		"slaves": interfacesAttribute("The interfaces aggregated by the bond, e.g. `[\"eno1\", \"eno2\"]`."),
+		"bond_mode": schema.StringAttribute{
+			Description: "The bonding mode. Choice is between `active-backup` | `balance-slb` | " +
+				"`lacp-balance-slb` | `lacp-balance-tcp` (defaults to `active-backup`).",
+			Optional: true,
+			Computed: true,
+			Default:  stringdefault.StaticString("active-backup"),
+			Validators: []validator.String{
+				stringvalidator.OneOf("active-backup", "balance-slb", "lacp-balance-slb", "lacp-balance-tcp"),
+			},
+		},
+	})
+
+	return &interfaceResource{
+		interfaceType:  "OVSBond",
+		label:          "OVS Bond",
+		typeNameSuffix: "_network_ovs_bond",
+		description:    "Manages an Open vSwitch Bond network interface in a Proxmox VE node.",
+		name: nameAttribute(
+			"The interface name, must be in the `bond[N]` format, e.g. `bond0`.",
+			bondNameRegex,
+			"must be in the bond[N] format",
+		),
+		attributes: attributes,
+		newModel:   func() interfaceModel { return &ovsBondModel{} },
+	}
+}
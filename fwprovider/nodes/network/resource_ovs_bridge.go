@@ -0,0 +1,89 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package network
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes"
+)
+
+var ovsInterfaceNameRegex = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]{0,14}$`)
+
+type ovsBridgeModel struct {
+	interfaceModelBase
+
+	Ports      types.Set    `tfsdk:"ports"`
+	OVSOptions types.String `tfsdk:"ovs_options"`
+}
+
+func (m *ovsBridgeModel) exportToAPI(
+	ctx context.Context,
+	body *nodes.NetworkInterfaceCreateUpdateRequestBody,
+	diags *diag.Diagnostics,
+) {
+	body.OVSPorts = joinInterfaces(ctx, m.Ports, diags)
+	body.OVSOptions = m.OVSOptions.ValueStringPointer()
+}
+
+func (m *ovsBridgeModel) importFromAPI(
+	ctx context.Context,
+	iface *nodes.NetworkInterfaceListResponseData,
+	diags *diag.Diagnostics,
+) {
+	m.Ports = splitInterfaces(ctx, iface.OVSPorts, diags)
+	m.OVSOptions = stringValue(iface.OVSOptions)
+}
+
+// NewOVSBridgeResource creates a new resource for managing OVS Bridge network interfaces.
+func NewOVSBridgeResource() resource.Resource {
+	return &interfaceResource{
+		interfaceType:  "OVSBridge",
+		label:          "OVS Bridge",
+		typeNameSuffix: "_network_ovs_bridge",
+		description: "Manages an Open vSwitch Bridge network interface in a Proxmox VE node. " +
+			"The `openvswitch-switch` package must be installed on the node.",
+		name: nameAttribute(
+			"The interface name, commonly `vmbr[N]`.",
+			ovsInterfaceNameRegex,
+			"must be an alphanumeric string that starts with a character and is at most 15 characters long",
+		),
+		attributes: map[string]schema.Attribute{
+			"ports": schema.SetAttribute{
+				Description: "The interfaces attached to the bridge. Interfaces attached with the `bridge` attribute " +
+					"of the OVS Bond, OVS IntPort and OVS Port resources are added by PVE automatically, so either " +
+					"list them here or leave this attribute unset.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"ovs_options": ovsOptionsAttribute(),
+		},
+		newModel: func() interfaceModel { return &ovsBridgeModel{} },
+	}
+}
+
+// ovsOptionsAttribute returns the `ovs_options` attribute shared by all OVS interface types.
+func ovsOptionsAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "Additional OVS options for the interface, e.g. `vlan_mode=native-untagged`.",
+		Optional:    true,
+		Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+	}
+}
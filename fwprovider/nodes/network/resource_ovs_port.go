@@ -0,0 +1,102 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package network
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes"
+)
+
+// ovsPortModel is the model of the OVS IntPort and OVS Port interface types.
+type ovsPortModel struct {
+	interfaceModelBase
+
+	Bridge     types.String `tfsdk:"bridge"`
+	Tag        types.Int64  `tfsdk:"tag"`
+	OVSOptions types.String `tfsdk:"ovs_options"`
+}
+
+func (m *ovsPortModel) exportToAPI(
+	_ context.Context,
+	body *nodes.NetworkInterfaceCreateUpdateRequestBody,
+	_ *diag.Diagnostics,
+) {
+	body.OVSBridge = m.Bridge.ValueStringPointer()
+	body.OVSTag = ovsTag(m.Tag)
+	body.OVSOptions = m.OVSOptions.ValueStringPointer()
+}
+
+func (m *ovsPortModel) importFromAPI(
+	_ context.Context,
+	iface *nodes.NetworkInterfaceListResponseData,
+	_ *diag.Diagnostics,
+) {
+	m.Bridge = stringValue(iface.OVSBridge)
+	m.Tag = types.Int64PointerValue(iface.OVSTag.PointerInt64())
+	m.OVSOptions = stringValue(iface.OVSOptions)
+}
+
+// ovsPortAttributes returns the attributes shared by the OVS interface types attached to an OVS bridge.
+func ovsPortAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"bridge": schema.StringAttribute{
+			Description: "The OVS bridge the interface is attached to.",
+			Required:    true,
+			Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"tag": schema.Int64Attribute{
+			Description: "The VLAN tag of the interface.",
+			Optional:    true,
+			Validators:  []validator.Int64{int64validator.Between(1, 4094)},
+		},
+		"ovs_options": ovsOptionsAttribute(),
+	}
+}
+
+// NewOVSIntPortResource creates a new resource for managing OVS IntPort network interfaces.
+func NewOVSIntPortResource() resource.Resource {
+	return &interfaceResource{
+		interfaceType:  "OVSIntPort",
+		label:          "OVS IntPort",
+		typeNameSuffix: "_network_ovs_int_port",
+		description: "Manages an Open vSwitch internal port in a Proxmox VE node. Internal ports are " +
+			"commonly used to give the host an address on a VLAN of an OVS bridge.",
+		name: nameAttribute(
+			"The interface name.",
+			ovsInterfaceNameRegex,
+			"must be an alphanumeric string that starts with a character and is at most 15 characters long",
+		),
+		attributes: ovsPortAttributes(),
+		newModel:   func() interfaceModel { return &ovsPortModel{} },
+	}
+}
+
+// NewOVSPortResource creates a new resource for managing OVS Port network interfaces.
+func NewOVSPortResource() resource.Resource {
+	return &interfaceResource{
+		interfaceType:  "OVSPort",
+		label:          "OVS Port",
+		typeNameSuffix: "_network_ovs_port",
+		description:    "Manages an existing network interface of a Proxmox VE node as an Open vSwitch port.",
+		name: nameAttribute(
+			"The name of the existing network interface, e.g. `eno1`.",
+			ovsInterfaceNameRegex,
+			"must be an alphanumeric string that starts with a character and is at most 15 characters long",
+		),
+		attributes: ovsPortAttributes(),
+		newModel:   func() interfaceModel { return &ovsPortModel{} },
+	}
+}
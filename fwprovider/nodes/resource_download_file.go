@@ -36,6 +36,7 @@ import (
 	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes"
 	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/storage"
 	proxmoxtypes "github.com/bpg/terraform-provider-proxmox/proxmox/types"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/version"
 )
 
 var (
@@ -205,10 +206,13 @@ func (r *downloadFileResource) Schema(
 		Attributes: map[string]schema.Attribute{
 			"id": attribute.ResourceID(),
 			"content_type": schema.StringAttribute{
-				Description: "The file content type. Must be `iso` for VM images or `vztmpl` for LXC images.",
-				Required:    true,
+				Description: "The file content type. Must be `iso` for VM images, `import` for VM disk images " +
+					"or `vztmpl` for LXC images. The `import` content type requires Proxmox VE 8.4 or later, " +
+					"its images can be imported by the VM disks without SSH access to the node.",
+				Required: true,
 				Validators: []validator.String{stringvalidator.OneOf([]string{
 					"iso",
+					"import",
 					"vztmpl",
 				}...)},
 				PlanModifiers: []planmodifier.String{
@@ -375,6 +379,25 @@ func (r *downloadFileResource) Create(
 		return
 	}
 
+	if plan.Content.ValueString() == "import" {
+		ver, err := r.client.Version().ProxmoxVersion(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Error getting Proxmox VE version", err.Error())
+
+			return
+		}
+
+		if !ver.SupportImportContentType() {
+			resp.Diagnostics.AddError(
+				"Unsupported content type",
+				fmt.Sprintf("The `import` content type requires Proxmox VE %s or later, the node runs %s.",
+					version.MinimumProxmoxVersionImportContentType, ver),
+			)
+
+			return
+		}
+	}
+
 	timeout := time.Duration(plan.UploadTimeout.ValueInt64()) * time.Second
 
 	ctx, cancel := context.WithTimeout(ctx, timeout)
@@ -0,0 +1,135 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package snapshot
+
+import (
+	"context"
+	"time"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/containers"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/vms"
+	proxmoxtypes "github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+// snapshotData is the guest-agnostic representation of a snapshot.
+type snapshotData struct {
+	Name        string
+	Description *string
+	Parent      *string
+	Time        *time.Time
+	VMState     bool
+}
+
+// snapshotClient hides the differences between the VM and container snapshot APIs.
+type snapshotClient interface {
+	list(ctx context.Context) ([]snapshotData, error)
+	create(ctx context.Context, name string, description *string, vmState bool) error
+	update(ctx context.Context, name string, description *string) error
+	rollback(ctx context.Context, name string) error
+	delete(ctx context.Context, name string) error
+}
+
+func newSnapshotClient(c proxmox.Client, nodeName string, vmID int64, containerID int64) snapshotClient {
+	if containerID != 0 {
+		return &containerSnapshotClient{client: c.Node(nodeName).Container(int(containerID))}
+	}
+
+	return &vmSnapshotClient{client: c.Node(nodeName).VM(int(vmID))}
+}
+
+type vmSnapshotClient struct {
+	client *vms.Client
+}
+
+func (c *vmSnapshotClient) list(ctx context.Context) ([]snapshotData, error) {
+	list, err := c.client.ListSnapshots(ctx)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	snapshots := make([]snapshotData, len(list))
+
+	for i, s := range list {
+		snapshots[i] = snapshotData{
+			Name:        s.Name,
+			Description: s.Description,
+			Parent:      s.Parent,
+			Time:        (*time.Time)(s.Time),
+			VMState:     s.VMState != nil && bool(*s.VMState),
+		}
+	}
+
+	return snapshots, nil
+}
+
+func (c *vmSnapshotClient) create(ctx context.Context, name string, description *string, vmState bool) error {
+	//nolint:wrapcheck
+	return c.client.CreateSnapshot(ctx, &vms.SnapshotCreateRequestBody{
+		Name:        name,
+		Description: description,
+		VMState:     proxmoxtypes.CustomBool(vmState).Pointer(),
+	})
+}
+
+func (c *vmSnapshotClient) update(ctx context.Context, name string, description *string) error {
+	//nolint:wrapcheck
+	return c.client.UpdateSnapshot(ctx, name, &vms.SnapshotUpdateRequestBody{Description: description})
+}
+
+func (c *vmSnapshotClient) rollback(ctx context.Context, name string) error {
+	return c.client.RollbackSnapshot(ctx, name, &vms.SnapshotRollbackRequestBody{}) //nolint:wrapcheck
+}
+
+func (c *vmSnapshotClient) delete(ctx context.Context, name string) error {
+	return c.client.DeleteSnapshot(ctx, name, &vms.SnapshotDeleteRequestBody{}) //nolint:wrapcheck
+}
+
+type containerSnapshotClient struct {
+	client *containers.Client
+}
+
+func (c *containerSnapshotClient) list(ctx context.Context) ([]snapshotData, error) {
+	list, err := c.client.ListSnapshots(ctx)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	snapshots := make([]snapshotData, len(list))
+
+	for i, s := range list {
+		snapshots[i] = snapshotData{
+			Name:        s.Name,
+			Description: s.Description,
+			Parent:      s.Parent,
+			Time:        (*time.Time)(s.Time),
+		}
+	}
+
+	return snapshots, nil
+}
+
+func (c *containerSnapshotClient) create(ctx context.Context, name string, description *string, _ bool) error {
+	//nolint:wrapcheck
+	return c.client.CreateSnapshot(ctx, &containers.SnapshotCreateRequestBody{
+		Name:        name,
+		Description: description,
+	})
+}
+
+func (c *containerSnapshotClient) update(ctx context.Context, name string, description *string) error {
+	//nolint:wrapcheck
+	return c.client.UpdateSnapshot(ctx, name, &containers.SnapshotUpdateRequestBody{Description: description})
+}
+
+func (c *containerSnapshotClient) rollback(ctx context.Context, name string) error {
+	return c.client.RollbackSnapshot(ctx, name, &containers.SnapshotRollbackRequestBody{}) //nolint:wrapcheck
+}
+
+func (c *containerSnapshotClient) delete(ctx context.Context, name string) error {
+	return c.client.DeleteSnapshot(ctx, name, &containers.SnapshotDeleteRequestBody{}) //nolint:wrapcheck
+}
@@ -0,0 +1,180 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/attribute"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/config"
+	"github.com/bpg/terraform-provider-proxmox/proxmox"
+)
+
+var (
+	_ datasource.DataSource              = &snapshotsDataSource{}
+	_ datasource.DataSourceWithConfigure = &snapshotsDataSource{}
+)
+
+type snapshotsDataSource struct {
+	client proxmox.Client
+}
+
+type snapshotsDataSourceModel struct {
+	ID          types.String                  `tfsdk:"id"`
+	NodeName    types.String                  `tfsdk:"node_name"`
+	VMID        types.Int64                   `tfsdk:"vm_id"`
+	ContainerID types.Int64                   `tfsdk:"container_id"`
+	Snapshots   []snapshotsDataSourceSnapshot `tfsdk:"snapshots"`
+}
+
+type snapshotsDataSourceSnapshot struct {
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	Parent       types.String `tfsdk:"parent"`
+	SnapshotTime types.String `tfsdk:"snapshot_time"`
+	VMState      types.Bool   `tfsdk:"vmstate"`
+}
+
+// NewSnapshotsDataSource creates a new data source for listing VM and container snapshots.
+func NewSnapshotsDataSource() datasource.DataSource {
+	return &snapshotsDataSource{}
+}
+
+func (d *snapshotsDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_snapshots"
+}
+
+func (d *snapshotsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the list of snapshots of a VM or a container.",
+		Attributes: map[string]schema.Attribute{
+			"id": attribute.ResourceID(),
+			"node_name": schema.StringAttribute{
+				Description: "The name of the node the guest is located on.",
+				Required:    true,
+			},
+			"vm_id": schema.Int64Attribute{
+				Description: "The ID of the VM.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.MatchRoot("container_id")),
+				},
+			},
+			"container_id": schema.Int64Attribute{
+				Description: "The ID of the container.",
+				Optional:    true,
+			},
+			"snapshots": schema.ListNestedAttribute{
+				Description: "The snapshots of the guest, excluding the current state.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the snapshot.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the snapshot.",
+							Computed:    true,
+						},
+						"parent": schema.StringAttribute{
+							Description: "The name of the parent snapshot.",
+							Computed:    true,
+						},
+						"snapshot_time": schema.StringAttribute{
+							Description: "The time the snapshot was taken, in RFC 3339 format.",
+							Computed:    true,
+						},
+						"vmstate": schema.BoolAttribute{
+							Description: "Whether the snapshot includes the VM RAM.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *snapshotsDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(config.DataSource)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected config.DataSource, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = cfg.Client
+}
+
+func (d *snapshotsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state snapshotsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := newSnapshotClient(d.client, state.NodeName.ValueString(), state.VMID.ValueInt64(),
+		state.ContainerID.ValueInt64())
+
+	list, err := client.list(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read snapshots",
+			err.Error(),
+		)
+
+		return
+	}
+
+	state.Snapshots = make([]snapshotsDataSourceSnapshot, len(list))
+
+	for i, s := range list {
+		state.Snapshots[i] = snapshotsDataSourceSnapshot{
+			Name:         types.StringValue(s.Name),
+			Description:  formatDescription(s.Description),
+			Parent:       types.StringPointerValue(s.Parent),
+			SnapshotTime: formatSnapshotTime(s.Time),
+			VMState:      types.BoolValue(s.VMState),
+		}
+	}
+
+	guest := snapshotModel{NodeName: state.NodeName, VMID: state.VMID, ContainerID: state.ContainerID}
+	guestType, guestID := guest.guestID()
+	state.ID = types.StringValue(fmt.Sprintf("%s:%s:%d", state.NodeName.ValueString(), guestType, guestID))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,88 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package snapshot
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	guestTypeVM        = "vm"
+	guestTypeContainer = "container"
+)
+
+type snapshotModel struct {
+	ID               types.String   `tfsdk:"id"`
+	NodeName         types.String   `tfsdk:"node_name"`
+	VMID             types.Int64    `tfsdk:"vm_id"`
+	ContainerID      types.Int64    `tfsdk:"container_id"`
+	Name             types.String   `tfsdk:"name"`
+	Description      types.String   `tfsdk:"description"`
+	VMState          types.Bool     `tfsdk:"vmstate"`
+	RollbackOnChange types.Bool     `tfsdk:"rollback_on_change"`
+	Triggers         types.Map      `tfsdk:"triggers"`
+	Parent           types.String   `tfsdk:"parent"`
+	SnapshotTime     types.String   `tfsdk:"snapshot_time"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
+
+// guestID returns the guest type and identifier the snapshot belongs to.
+func (m *snapshotModel) guestID() (string, int64) {
+	if !m.ContainerID.IsNull() && !m.ContainerID.IsUnknown() {
+		return guestTypeContainer, m.ContainerID.ValueInt64()
+	}
+
+	return guestTypeVM, m.VMID.ValueInt64()
+}
+
+// resourceID builds the resource identifier in the `node_name:vm|container:id:name` format.
+func (m *snapshotModel) resourceID() string {
+	guestType, guestID := m.guestID()
+
+	return fmt.Sprintf("%s:%s:%d:%s", m.NodeName.ValueString(), guestType, guestID, m.Name.ValueString())
+}
+
+// importFromAPI sets the snapshot attributes returned by the PVE API.
+func (m *snapshotModel) importFromAPI(data *snapshotData) {
+	m.Name = types.StringValue(data.Name)
+
+	m.Description = formatDescription(data.Description)
+	m.VMState = types.BoolValue(data.VMState)
+	m.Parent = types.StringPointerValue(data.Parent)
+	m.SnapshotTime = formatSnapshotTime(data.Time)
+}
+
+// formatDescription maps an empty description returned by PVE to null.
+func formatDescription(description *string) types.String {
+	if description == nil || *description == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(*description)
+}
+
+func formatSnapshotTime(t *time.Time) types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+
+	return types.StringValue(t.UTC().Format(time.RFC3339))
+}
+
+func findSnapshot(snapshots []snapshotData, name string) *snapshotData {
+	for i := range snapshots {
+		if snapshots[i].Name == name {
+			return &snapshots[i]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,458 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package snapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/attribute"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/config"
+	"github.com/bpg/terraform-provider-proxmox/proxmox"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+)
+
+const (
+	defaultCreateTimeout = 30 * time.Minute
+	defaultUpdateTimeout = 30 * time.Minute
+	defaultDeleteTimeout = 10 * time.Minute
+)
+
+var snapshotNameRegex = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_-]*$`)
+
+var (
+	_ resource.Resource                = &snapshotResource{}
+	_ resource.ResourceWithConfigure   = &snapshotResource{}
+	_ resource.ResourceWithImportState = &snapshotResource{}
+)
+
+type snapshotResource struct {
+	client proxmox.Client
+}
+
+// NewSnapshotResource creates a new resource for managing VM and container snapshots.
+func NewSnapshotResource() resource.Resource {
+	return &snapshotResource{}
+}
+
+func (r *snapshotResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_snapshot"
+}
+
+// Schema defines the schema for the resource.
+func (r *snapshotResource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a snapshot of a VM or a container.",
+		MarkdownDescription: "Manages a snapshot of a VM or a container.\n\n" +
+			"PVE locks the guest while a snapshot operation is running, so multiple snapshots of the same " +
+			"guest should be chained using `depends_on`.",
+		Attributes: map[string]schema.Attribute{
+			"id": attribute.ResourceID(),
+			"node_name": schema.StringAttribute{
+				Description: "The name of the node the guest is located on.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vm_id": schema.Int64Attribute{
+				Description: "The ID of the VM to snapshot.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.MatchRoot("container_id")),
+					int64validator.AtLeast(100),
+				},
+			},
+			"container_id": schema.Int64Attribute{
+				Description: "The ID of the container to snapshot.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(100),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the snapshot.",
+				MarkdownDescription: "The name of the snapshot. Must start with a letter and contain only " +
+					"letters, digits, `-` and `_`.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 40),
+					stringvalidator.RegexMatches(snapshotNameRegex, "must start with a letter and contain "+
+						"only letters, digits, '-' and '_'"),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the snapshot.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"vmstate": schema.BoolAttribute{
+				Description: "Whether to include the VM RAM in the snapshot.",
+				MarkdownDescription: "Whether to include the VM RAM in the snapshot (defaults to `false`). " +
+					"Only supported for VMs.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("container_id")),
+				},
+			},
+			"rollback_on_change": schema.BoolAttribute{
+				Description: "Whether to roll the guest back to this snapshot when the triggers change.",
+				MarkdownDescription: "Whether to roll the guest back to this snapshot when `triggers` " +
+					"change (defaults to `false`).",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values that cause a rollback to this snapshot when changed.",
+				MarkdownDescription: "Arbitrary values that cause a rollback to this snapshot when changed. " +
+					"Only used when `rollback_on_change` is `true`.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"parent": schema.StringAttribute{
+				Description: "The name of the parent snapshot.",
+				Computed:    true,
+			},
+			"snapshot_time": schema.StringAttribute{
+				Description: "The time the snapshot was taken, in RFC 3339 format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *snapshotResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(config.Resource)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected config.Resource, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = cfg.Client
+}
+
+func (r *snapshotResource) snapshotClient(m *snapshotModel) snapshotClient {
+	return newSnapshotClient(r.client, m.NodeName.ValueString(), m.VMID.ValueInt64(), m.ContainerID.ValueInt64())
+}
+
+// read refreshes the model from the API. Returns false if the snapshot does not exist.
+func (r *snapshotResource) read(ctx context.Context, model *snapshotModel, diags *diag.Diagnostics) bool {
+	snapshots, err := r.snapshotClient(model).list(ctx)
+	if err != nil {
+		if errors.Is(err, api.ErrResourceDoesNotExist) {
+			return false
+		}
+
+		diags.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return false
+	}
+
+	snapshot := findSnapshot(snapshots, model.Name.ValueString())
+	if snapshot == nil {
+		return false
+	}
+
+	model.importFromAPI(snapshot)
+
+	return true
+}
+
+func (r *snapshotResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan snapshotModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, d := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(d...)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err := r.snapshotClient(&plan).create(
+		ctx,
+		plan.Name.ValueString(),
+		plan.Description.ValueStringPointer(),
+		plan.VMState.ValueBool(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while creating the snapshot.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	plan.ID = types.StringValue(plan.resourceID())
+
+	if !r.read(ctx, &plan, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError(
+				"Unable to Create Resource",
+				fmt.Sprintf("Snapshot %q was not found after creation.", plan.Name.ValueString()),
+			)
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *snapshotResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state snapshotModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.read(ctx, &state, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.State.RemoveResource(ctx)
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *snapshotResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state snapshotModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, d := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(d...)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client := r.snapshotClient(&plan)
+
+	if !plan.Description.Equal(state.Description) {
+		// an empty description removes the existing one
+		description := plan.Description.ValueString()
+
+		err := client.update(ctx, plan.Name.ValueString(), &description)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Update Resource",
+				"An unexpected error occurred while updating the snapshot.\n\n"+
+					"Error: "+err.Error(),
+			)
+
+			return
+		}
+	}
+
+	if plan.RollbackOnChange.ValueBool() && !plan.Triggers.Equal(state.Triggers) {
+		err := client.rollback(ctx, plan.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Update Resource",
+				fmt.Sprintf("An unexpected error occurred while rolling back to snapshot %q.\n\n",
+					plan.Name.ValueString())+
+					"Error: "+err.Error(),
+			)
+
+			return
+		}
+	}
+
+	if !r.read(ctx, &plan, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError(
+				"Unable to Update Resource",
+				fmt.Sprintf("Snapshot %q no longer exists.", plan.Name.ValueString()),
+			)
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *snapshotResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state snapshotModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, d := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(d...)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err := r.snapshotClient(&state).delete(ctx, state.Name.ValueString())
+	if err != nil {
+		if errors.Is(err, api.ErrResourceDoesNotExist) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while deleting the snapshot.\n\n"+
+				"Error: "+err.Error(),
+		)
+	}
+}
+
+func (r *snapshotResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	idParts := strings.Split(req.ID, ":")
+	if len(idParts) != 4 || idParts[0] == "" || idParts[3] == "" ||
+		(idParts[1] != guestTypeVM && idParts[1] != guestTypeContainer) {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: node_name:vm|container:id:name. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	guestID, err := strconv.ParseInt(idParts[2], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Invalid guest ID %q: %s", idParts[2], err.Error()),
+		)
+
+		return
+	}
+
+	var ts timeouts.Value
+
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &ts)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := snapshotModel{
+		NodeName:         types.StringValue(idParts[0]),
+		VMID:             types.Int64Null(),
+		ContainerID:      types.Int64Null(),
+		Name:             types.StringValue(idParts[3]),
+		RollbackOnChange: types.BoolValue(false),
+		Triggers:         types.MapNull(types.StringType),
+		Timeouts:         ts,
+	}
+
+	if idParts[1] == guestTypeContainer {
+		state.ContainerID = types.Int64Value(guestID)
+	} else {
+		state.VMID = types.Int64Value(guestID)
+	}
+
+	state.ID = types.StringValue(state.resourceID())
+
+	if !r.read(ctx, &state, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError(
+				"Resource does not exist",
+				fmt.Sprintf("Snapshot %q does not exist.", req.ID),
+			)
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
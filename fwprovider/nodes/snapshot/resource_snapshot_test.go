@@ -0,0 +1,105 @@
+//go:build acceptance || all
+
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package snapshot_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/test"
+)
+
+func TestAccResourceSnapshot(t *testing.T) {
+	te := test.InitEnvironment(t)
+
+	vmID := 100000 + rand.Intn(99999)
+	te.AddTemplateVars(map[string]any{
+		"TestVMID": vmID,
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: te.AccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_vm" "test_vm" {
+					node_name = "{{.NodeName}}"
+					vm_id     = {{.TestVMID}}
+					started   = false
+				}
+
+				resource "proxmox_virtual_environment_snapshot" "test_snapshot" {
+					node_name   = "{{.NodeName}}"
+					vm_id       = proxmox_virtual_environment_vm.test_vm.vm_id
+					name        = "acc_snapshot"
+					description = "created by acceptance test"
+				}
+
+				data "proxmox_virtual_environment_snapshots" "test_snapshots" {
+					node_name = "{{.NodeName}}"
+					vm_id     = proxmox_virtual_environment_vm.test_vm.vm_id
+
+					depends_on = [proxmox_virtual_environment_snapshot.test_snapshot]
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					test.ResourceAttributes("proxmox_virtual_environment_snapshot.test_snapshot", map[string]string{
+						"id":                 fmt.Sprintf("%s:vm:%d:acc_snapshot", te.NodeName, vmID),
+						"name":               "acc_snapshot",
+						"description":        "created by acceptance test",
+						"vmstate":            "false",
+						"rollback_on_change": "false",
+					}),
+					resource.TestCheckResourceAttrSet("proxmox_virtual_environment_snapshot.test_snapshot", "snapshot_time"),
+					test.ResourceAttributes("data.proxmox_virtual_environment_snapshots.test_snapshots", map[string]string{
+						"snapshots.#":             "1",
+						"snapshots.0.name":        "acc_snapshot",
+						"snapshots.0.description": "created by acceptance test",
+					}),
+				),
+			},
+			{
+				Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_vm" "test_vm" {
+					node_name = "{{.NodeName}}"
+					vm_id     = {{.TestVMID}}
+					started   = false
+				}
+
+				resource "proxmox_virtual_environment_snapshot" "test_snapshot" {
+					node_name          = "{{.NodeName}}"
+					vm_id              = proxmox_virtual_environment_vm.test_vm.vm_id
+					name               = "acc_snapshot"
+					rollback_on_change = true
+					triggers = {
+						run = "1"
+					}
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					test.ResourceAttributes("proxmox_virtual_environment_snapshot.test_snapshot", map[string]string{
+						"rollback_on_change": "true",
+						"triggers.run":       "1",
+					}),
+					test.NoResourceAttributesSet("proxmox_virtual_environment_snapshot.test_snapshot", []string{
+						"description",
+					}),
+				),
+			},
+			{
+				ResourceName:            "proxmox_virtual_environment_snapshot.test_snapshot",
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("%s:vm:%d:acc_snapshot", te.NodeName, vmID),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rollback_on_change", "triggers"},
+			},
+		},
+	})
+}
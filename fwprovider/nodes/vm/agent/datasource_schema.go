@@ -0,0 +1,41 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package agent
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// DataSourceSchema defines the schema for the QEMU guest agent datasource.
+func DataSourceSchema() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		CustomType: basetypes.ObjectType{
+			AttrTypes: attributeTypes(),
+		},
+		Description: "The QEMU guest agent configuration.",
+		Optional:    true,
+		Computed:    true,
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the QEMU guest agent is enabled.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"trim": schema.BoolAttribute{
+				Description: "Whether to run `fstrim` in the guest after moving or cloning a disk.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "The QEMU guest agent interface type.",
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+	}
+}
@@ -0,0 +1,27 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package agent
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Model represents the QEMU guest agent model.
+type Model struct {
+	Enabled types.Bool   `tfsdk:"enabled"`
+	Trim    types.Bool   `tfsdk:"trim"`
+	Type    types.String `tfsdk:"type"`
+}
+
+func attributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"enabled": types.BoolType,
+		"trim":    types.BoolType,
+		"type":    types.StringType,
+	}
+}
@@ -0,0 +1,157 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package agent
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/attribute"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/vms"
+	proxmoxtypes "github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+// Value represents the type for QEMU guest agent settings.
+type Value = types.Object
+
+// NewValue returns a new Value with the given QEMU guest agent settings from the PVE API.
+func NewValue(ctx context.Context, config *vms.GetResponseData, diags *diag.Diagnostics) Value {
+	agent := Model{
+		Enabled: types.BoolValue(false),
+		Trim:    types.BoolValue(false),
+		Type:    types.StringValue("virtio"),
+	}
+
+	if config.Agent != nil {
+		if config.Agent.Enabled != nil {
+			agent.Enabled = types.BoolValue(bool(*config.Agent.Enabled))
+		}
+
+		if config.Agent.TrimClonedDisks != nil {
+			agent.Trim = types.BoolValue(bool(*config.Agent.TrimClonedDisks))
+		}
+
+		if config.Agent.Type != nil && *config.Agent.Type != "" {
+			agent.Type = types.StringValue(*config.Agent.Type)
+		}
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, attributeTypes(), agent)
+	diags.Append(d...)
+
+	return obj
+}
+
+// createAgent creates a new CustomAgent from the given Model, skipping the attributes that are not defined.
+func createAgent(model Model) *vms.CustomAgent {
+	agent := &vms.CustomAgent{}
+
+	if attribute.IsDefined(model.Enabled) {
+		agent.Enabled = proxmoxtypes.CustomBoolPtr(model.Enabled.ValueBoolPointer())
+	}
+
+	if attribute.IsDefined(model.Trim) {
+		agent.TrimClonedDisks = proxmoxtypes.CustomBoolPtr(model.Trim.ValueBoolPointer())
+	}
+
+	if attribute.IsDefined(model.Type) {
+		agent.Type = model.Type.ValueStringPointer()
+	}
+
+	return agent
+}
+
+// FillCreateBody fills the CreateRequestBody with the QEMU guest agent settings from the Value.
+//
+// In the 'create' context, v is the plan.
+func FillCreateBody(ctx context.Context, planValue Value, body *vms.CreateRequestBody, diags *diag.Diagnostics) {
+	var plan Model
+
+	if planValue.IsNull() || planValue.IsUnknown() {
+		return
+	}
+
+	d := planValue.As(ctx, &plan, basetypes.ObjectAsOptions{})
+	diags.Append(d...)
+
+	if d.HasError() {
+		return
+	}
+
+	agent := createAgent(plan)
+
+	if !reflect.DeepEqual(agent, &vms.CustomAgent{}) {
+		body.Agent = agent
+	}
+}
+
+// FillUpdateBody fills the UpdateRequestBody with the QEMU guest agent settings from the Value.
+//
+// In the 'update' context, v is the plan and stateValue is the current state.
+func FillUpdateBody(
+	ctx context.Context,
+	planValue, stateValue Value,
+	updateBody *vms.UpdateRequestBody,
+	isClone bool,
+	diags *diag.Diagnostics,
+) {
+	var plan, state Model
+
+	if planValue.IsNull() || planValue.IsUnknown() || planValue.Equal(stateValue) {
+		return
+	}
+
+	d := planValue.As(ctx, &plan, basetypes.ObjectAsOptions{})
+	diags.Append(d...)
+	d = stateValue.As(ctx, &state, basetypes.ObjectAsOptions{})
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return
+	}
+
+	// the agent settings are a single PVE property, so the whole property is sent with the changed values
+	agent := createAgent(state)
+
+	if !plan.Enabled.Equal(state.Enabled) {
+		if attribute.ShouldBeRemoved(plan.Enabled, state.Enabled, isClone) {
+			agent.Enabled = nil
+		} else if attribute.IsDefined(plan.Enabled) {
+			agent.Enabled = proxmoxtypes.CustomBoolPtr(plan.Enabled.ValueBoolPointer())
+		}
+	}
+
+	if !plan.Trim.Equal(state.Trim) {
+		if attribute.ShouldBeRemoved(plan.Trim, state.Trim, isClone) {
+			agent.TrimClonedDisks = nil
+		} else if attribute.IsDefined(plan.Trim) {
+			agent.TrimClonedDisks = proxmoxtypes.CustomBoolPtr(plan.Trim.ValueBoolPointer())
+		}
+	}
+
+	if !plan.Type.Equal(state.Type) {
+		if attribute.ShouldBeRemoved(plan.Type, state.Type, isClone) {
+			agent.Type = nil
+		} else if attribute.IsDefined(plan.Type) {
+			agent.Type = plan.Type.ValueStringPointer()
+		}
+	}
+
+	if reflect.DeepEqual(agent, &vms.CustomAgent{}) {
+		if err := updateBody.ToDelete("Agent"); err != nil {
+			diags.AddError("Failed to update QEMU guest agent settings", err.Error())
+		}
+
+		return
+	}
+
+	updateBody.Agent = agent
+}
@@ -0,0 +1,56 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package agent
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// ResourceSchema defines the schema for the QEMU guest agent resource.
+func ResourceSchema() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		CustomType: basetypes.ObjectType{
+			AttrTypes: attributeTypes(),
+		},
+		Description: "The QEMU guest agent configuration.",
+		MarkdownDescription: "The QEMU guest agent configuration. The agent must also be installed and " +
+			"running in the guest.",
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.UseStateForUnknown(),
+		},
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Description:         "Whether to enable the QEMU guest agent.",
+				MarkdownDescription: "Whether to enable the QEMU guest agent. If not set, PVE default is `false`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"trim": schema.BoolAttribute{
+				Description: "Whether to run `fstrim` in the guest after moving or cloning a disk.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "The QEMU guest agent interface type.",
+				MarkdownDescription: "The QEMU guest agent interface type. Choice is between `virtio` | `isa`. " +
+					"If not set, PVE default is `virtio`.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("virtio", "isa"),
+				},
+			},
+		},
+	}
+}
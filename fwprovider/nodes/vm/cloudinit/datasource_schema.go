@@ -0,0 +1,118 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package cloudinit
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// DataSourceSchema defines the schema for the cloud-init datasource.
+func DataSourceSchema() schema.Attribute {
+	address := map[string]schema.Attribute{
+		"address": schema.StringAttribute{
+			Description: "The IP address in CIDR notation.",
+			Computed:    true,
+		},
+		"gateway": schema.StringAttribute{
+			Description: "The gateway.",
+			Computed:    true,
+		},
+	}
+
+	return schema.SingleNestedAttribute{
+		CustomType: basetypes.ObjectType{
+			AttrTypes: attributeTypes(),
+		},
+		Description: "The cloud-init configuration.",
+		Computed:    true,
+		Attributes: map[string]schema.Attribute{
+			"datastore_id": schema.StringAttribute{
+				Description: "The identifier of the datastore the cloud-init drive is stored on.",
+				Computed:    true,
+			},
+			"interface": schema.StringAttribute{
+				Description: "The interface of the cloud-init drive.",
+				Computed:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "The cloud-init configuration format.",
+				Computed:    true,
+			},
+			"dns": schema.SingleNestedAttribute{
+				Description: "The DNS configuration.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"domain": schema.StringAttribute{
+						Description: "The DNS search domain.",
+						Computed:    true,
+					},
+					"servers": schema.ListAttribute{
+						Description: "The list of DNS servers.",
+						ElementType: types.StringType,
+						Computed:    true,
+					},
+				},
+			},
+			"ip_config": schema.MapNestedAttribute{
+				Description: "The IP configuration of the network devices, keyed by the name of the device.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"ipv4": schema.SingleNestedAttribute{
+							Description: "The IPv4 configuration.",
+							Computed:    true,
+							Attributes:  address,
+						},
+						"ipv6": schema.SingleNestedAttribute{
+							Description: "The IPv6 configuration.",
+							Computed:    true,
+							Attributes:  address,
+						},
+					},
+				},
+			},
+			"user_account": schema.SingleNestedAttribute{
+				Description: "The user account configuration.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"username": schema.StringAttribute{
+						Description: "The username.",
+						Computed:    true,
+					},
+					"password": schema.StringAttribute{
+						Description: "The password.",
+						Computed:    true,
+						Sensitive:   true,
+					},
+					"keys": schema.ListAttribute{
+						Description: "The list of SSH public keys.",
+						ElementType: types.StringType,
+						Computed:    true,
+					},
+				},
+			},
+			"user_data_file_id": schema.StringAttribute{
+				Description: "The file ID of the custom user data file.",
+				Computed:    true,
+			},
+			"vendor_data_file_id": schema.StringAttribute{
+				Description: "The file ID of the custom vendor data file.",
+				Computed:    true,
+			},
+			"network_data_file_id": schema.StringAttribute{
+				Description: "The file ID of the custom network data file.",
+				Computed:    true,
+			},
+			"meta_data_file_id": schema.StringAttribute{
+				Description: "The file ID of the custom meta data file.",
+				Computed:    true,
+			},
+		},
+	}
+}
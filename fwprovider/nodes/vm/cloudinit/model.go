@@ -0,0 +1,275 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package cloudinit
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/attribute"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/vms"
+)
+
+// maxIPConfigs is the maximum number of IP configurations, one per network device.
+const maxIPConfigs = 32
+
+// Model represents the cloud-init model.
+type Model struct {
+	DatastoreID       types.String             `tfsdk:"datastore_id"`
+	Interface         types.String             `tfsdk:"interface"`
+	Type              types.String             `tfsdk:"type"`
+	DNS               *DNSModel                `tfsdk:"dns"`
+	IPConfig          map[string]IPConfigModel `tfsdk:"ip_config"`
+	UserAccount       *UserAccountModel        `tfsdk:"user_account"`
+	UserDataFileID    types.String             `tfsdk:"user_data_file_id"`
+	VendorDataFileID  types.String             `tfsdk:"vendor_data_file_id"`
+	NetworkDataFileID types.String             `tfsdk:"network_data_file_id"`
+	MetaDataFileID    types.String             `tfsdk:"meta_data_file_id"`
+}
+
+// DNSModel represents the cloud-init DNS model.
+type DNSModel struct {
+	Domain  types.String   `tfsdk:"domain"`
+	Servers []types.String `tfsdk:"servers"`
+}
+
+// IPConfigModel represents the cloud-init IP configuration model of a network device.
+type IPConfigModel struct {
+	IPv4 *AddressModel `tfsdk:"ipv4"`
+	IPv6 *AddressModel `tfsdk:"ipv6"`
+}
+
+// AddressModel represents the cloud-init IP address model.
+type AddressModel struct {
+	Address types.String `tfsdk:"address"`
+	Gateway types.String `tfsdk:"gateway"`
+}
+
+// UserAccountModel represents the cloud-init user account model.
+type UserAccountModel struct {
+	Username types.String   `tfsdk:"username"`
+	Password types.String   `tfsdk:"password"`
+	Keys     []types.String `tfsdk:"keys"`
+}
+
+func addressAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"address": types.StringType,
+		"gateway": types.StringType,
+	}
+}
+
+func ipConfigAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"ipv4": types.ObjectType{AttrTypes: addressAttributeTypes()},
+		"ipv6": types.ObjectType{AttrTypes: addressAttributeTypes()},
+	}
+}
+
+func attributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"datastore_id": types.StringType,
+		"interface":    types.StringType,
+		"type":         types.StringType,
+		"dns": types.ObjectType{AttrTypes: map[string]attr.Type{
+			"domain":  types.StringType,
+			"servers": types.ListType{ElemType: types.StringType},
+		}},
+		"ip_config": types.MapType{ElemType: types.ObjectType{AttrTypes: ipConfigAttributeTypes()}},
+		"user_account": types.ObjectType{AttrTypes: map[string]attr.Type{
+			"username": types.StringType,
+			"password": types.StringType,
+			"keys":     types.ListType{ElemType: types.StringType},
+		}},
+		"user_data_file_id":    types.StringType,
+		"vendor_data_file_id":  types.StringType,
+		"network_data_file_id": types.StringType,
+		"meta_data_file_id":    types.StringType,
+	}
+}
+
+// exportToCustomCloudInitConfig creates the cloud-init configuration, skipping the attributes that are not defined.
+func (m *Model) exportToCustomCloudInitConfig() *vms.CustomCloudInitConfig {
+	config := &vms.CustomCloudInitConfig{
+		Type: stringPtr(m.Type),
+	}
+
+	if m.DNS != nil {
+		config.SearchDomain = stringPtr(m.DNS.Domain)
+
+		if servers := stringValues(m.DNS.Servers); len(servers) > 0 {
+			nameserver := strings.Join(servers, " ")
+			config.Nameserver = &nameserver
+		}
+	}
+
+	for name, ipConfig := range m.IPConfig {
+		i, err := strconv.Atoi(strings.TrimPrefix(name, "net"))
+		if err != nil || i < 0 || i >= maxIPConfigs {
+			// the name is validated by the schema
+			continue
+		}
+
+		// the IP configurations are encoded by their index in the list, empty ones are skipped
+		for len(config.IPConfig) <= i {
+			config.IPConfig = append(config.IPConfig, vms.CustomCloudInitIPConfig{})
+		}
+
+		if ipConfig.IPv4 != nil {
+			config.IPConfig[i].IPv4 = stringPtr(ipConfig.IPv4.Address)
+			config.IPConfig[i].GatewayIPv4 = stringPtr(ipConfig.IPv4.Gateway)
+		}
+
+		if ipConfig.IPv6 != nil {
+			config.IPConfig[i].IPv6 = stringPtr(ipConfig.IPv6.Address)
+			config.IPConfig[i].GatewayIPv6 = stringPtr(ipConfig.IPv6.Gateway)
+		}
+	}
+
+	if m.UserAccount != nil {
+		config.Username = stringPtr(m.UserAccount.Username)
+		config.Password = stringPtr(m.UserAccount.Password)
+
+		if keys := stringValues(m.UserAccount.Keys); len(keys) > 0 {
+			sshKeys := vms.CustomCloudInitSSHKeys(keys)
+			config.SSHKeys = &sshKeys
+		}
+	}
+
+	files := vms.CustomCloudInitFiles{
+		MetaVolume:    stringPtr(m.MetaDataFileID),
+		NetworkVolume: stringPtr(m.NetworkDataFileID),
+		UserVolume:    stringPtr(m.UserDataFileID),
+		VendorVolume:  stringPtr(m.VendorDataFileID),
+	}
+
+	if !reflect.DeepEqual(files, vms.CustomCloudInitFiles{}) {
+		config.Files = &files
+	}
+
+	return config
+}
+
+// encodedValues returns the PVE properties of the cloud-init configuration of the model.
+func (m *Model) encodedValues() url.Values {
+	values := url.Values{}
+
+	// the encoder never fails for the cloud-init configuration
+	_ = m.exportToCustomCloudInitConfig().EncodeValues("", &values)
+
+	return values
+}
+
+func (m *Model) importFromGetResponseData(config *vms.GetResponseData, previous *Model) {
+	m.Type = types.StringPointerValue(config.CloudInitType)
+
+	m.DNS = nil
+
+	if config.CloudInitDNSDomain != nil || config.CloudInitDNSServer != nil {
+		m.DNS = &DNSModel{
+			Domain: types.StringPointerValue(config.CloudInitDNSDomain),
+		}
+
+		if config.CloudInitDNSServer != nil {
+			for _, server := range strings.Fields(*config.CloudInitDNSServer) {
+				m.DNS.Servers = append(m.DNS.Servers, types.StringValue(server))
+			}
+		}
+	}
+
+	m.IPConfig = nil
+
+	v := reflect.ValueOf(config).Elem()
+
+	for i := 0; i < maxIPConfigs; i++ {
+		f := v.FieldByName(fmt.Sprintf("IPConfig%d", i))
+		if !f.IsValid() || f.IsNil() {
+			continue
+		}
+
+		ipConfig := f.Interface().(*vms.CustomCloudInitIPConfig)
+		model := IPConfigModel{}
+
+		if ipConfig.IPv4 != nil || ipConfig.GatewayIPv4 != nil {
+			model.IPv4 = &AddressModel{
+				Address: types.StringPointerValue(ipConfig.IPv4),
+				Gateway: types.StringPointerValue(ipConfig.GatewayIPv4),
+			}
+		}
+
+		if ipConfig.IPv6 != nil || ipConfig.GatewayIPv6 != nil {
+			model.IPv6 = &AddressModel{
+				Address: types.StringPointerValue(ipConfig.IPv6),
+				Gateway: types.StringPointerValue(ipConfig.GatewayIPv6),
+			}
+		}
+
+		if m.IPConfig == nil {
+			m.IPConfig = map[string]IPConfigModel{}
+		}
+
+		m.IPConfig[fmt.Sprintf("net%d", i)] = model
+	}
+
+	m.UserAccount = nil
+
+	if config.CloudInitUsername != nil || config.CloudInitPassword != nil || config.CloudInitSSHKeys != nil {
+		m.UserAccount = &UserAccountModel{
+			Username: types.StringPointerValue(config.CloudInitUsername),
+			Password: types.StringNull(),
+		}
+
+		// PVE returns a masked password, so the configured one is kept
+		if config.CloudInitPassword != nil && previous != nil && previous.UserAccount != nil {
+			m.UserAccount.Password = previous.UserAccount.Password
+		}
+
+		if config.CloudInitSSHKeys != nil {
+			for _, key := range *config.CloudInitSSHKeys {
+				m.UserAccount.Keys = append(m.UserAccount.Keys, types.StringValue(key))
+			}
+		}
+	}
+
+	m.MetaDataFileID = types.StringNull()
+	m.NetworkDataFileID = types.StringNull()
+	m.UserDataFileID = types.StringNull()
+	m.VendorDataFileID = types.StringNull()
+
+	if config.CloudInitFiles != nil {
+		m.MetaDataFileID = types.StringPointerValue(config.CloudInitFiles.MetaVolume)
+		m.NetworkDataFileID = types.StringPointerValue(config.CloudInitFiles.NetworkVolume)
+		m.UserDataFileID = types.StringPointerValue(config.CloudInitFiles.UserVolume)
+		m.VendorDataFileID = types.StringPointerValue(config.CloudInitFiles.VendorVolume)
+	}
+}
+
+func stringPtr(v types.String) *string {
+	if !attribute.IsDefined(v) {
+		return nil
+	}
+
+	return v.ValueStringPointer()
+}
+
+func stringValues(values []types.String) []string {
+	var result []string
+
+	for _, v := range values {
+		if attribute.IsDefined(v) {
+			result = append(result, v.ValueString())
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,162 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package cloudinit
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/attribute"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/vms"
+)
+
+// Value represents the type for cloud-init settings.
+type Value = types.Object
+
+// NewValue returns a new Value with the given cloud-init settings from the PVE API.
+//
+// The previous value is used to retain the user password, which PVE returns masked.
+func NewValue(
+	ctx context.Context,
+	config *vms.GetResponseData,
+	vmID int,
+	previousValue Value,
+	diags *diag.Diagnostics,
+) Value {
+	iface, drive := Drive(config, vmID)
+	if drive == nil {
+		return types.ObjectNull(attributeTypes())
+	}
+
+	var previous *Model
+
+	if attribute.IsDefined(previousValue) {
+		previous = &Model{}
+		diags.Append(previousValue.As(ctx, previous, basetypes.ObjectAsOptions{})...)
+	}
+
+	datastoreID, _, _ := strings.Cut(drive.FileVolume, ":")
+
+	cloudInit := Model{
+		DatastoreID: types.StringValue(datastoreID),
+		Interface:   types.StringValue(iface),
+	}
+
+	cloudInit.importFromGetResponseData(config, previous)
+
+	obj, d := types.ObjectValueFrom(ctx, attributeTypes(), cloudInit)
+	diags.Append(d...)
+
+	return obj
+}
+
+// Drive returns the interface and the cloud-init drive of the VM, or nil if the VM does not have one.
+func Drive(config *vms.GetResponseData, vmID int) (string, *vms.CustomStorageDevice) {
+	for iface, device := range config.StorageDevices {
+		if device.IsCloudInitDrive(vmID) {
+			return iface, device
+		}
+	}
+
+	return "", nil
+}
+
+// newDrive creates a new cloud-init drive allocated on the datastore.
+func newDrive(datastoreID string) vms.CustomStorageDevice {
+	media := "cdrom"
+
+	return vms.CustomStorageDevice{
+		FileVolume: fmt.Sprintf("%s:cloudinit", datastoreID),
+		Media:      &media,
+	}
+}
+
+// FillCreateBody fills the CreateRequestBody with the cloud-init settings from the Value.
+//
+// In the 'create' context, v is the plan.
+func FillCreateBody(ctx context.Context, planValue Value, body *vms.CreateRequestBody, diags *diag.Diagnostics) {
+	var plan Model
+
+	if planValue.IsNull() || planValue.IsUnknown() {
+		return
+	}
+
+	d := planValue.As(ctx, &plan, basetypes.ObjectAsOptions{})
+	diags.Append(d...)
+
+	if d.HasError() {
+		return
+	}
+
+	body.CloudInitConfig = plan.exportToCustomCloudInitConfig()
+	body.AddCustomStorageDevice(plan.Interface.ValueString(), newDrive(plan.DatastoreID.ValueString()))
+}
+
+// Update fills the UpdateRequestBody with the cloud-init settings from the Value.
+//
+// The settings removed from the plan are deleted from the VM. If the cloud-init drive is moved to another interface
+// or datastore, the existing drive is removed immediately, as PVE does not allow deleting and creating a device
+// with the same name in a single request.
+//
+// In the 'update' context, v is the plan and stateValue is the current state.
+func Update(
+	ctx context.Context,
+	vmAPI *vms.Client,
+	planValue, stateValue Value,
+	updateBody *vms.UpdateRequestBody,
+	diags *diag.Diagnostics,
+) {
+	var plan, state Model
+
+	if planValue.IsNull() || planValue.IsUnknown() || planValue.Equal(stateValue) {
+		return
+	}
+
+	d := planValue.As(ctx, &plan, basetypes.ObjectAsOptions{})
+	diags.Append(d...)
+
+	if attribute.IsDefined(stateValue) {
+		d = stateValue.As(ctx, &state, basetypes.ObjectAsOptions{})
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return
+	}
+
+	planValues, stateValues := plan.encodedValues(), state.encodedValues()
+
+	if !reflect.DeepEqual(planValues, stateValues) {
+		updateBody.CloudInitConfig = plan.exportToCustomCloudInitConfig()
+
+		for name := range stateValues {
+			if _, ok := planValues[name]; !ok {
+				updateBody.Delete = append(updateBody.Delete, name)
+			}
+		}
+	}
+
+	if plan.Interface.Equal(state.Interface) && plan.DatastoreID.Equal(state.DatastoreID) {
+		return
+	}
+
+	if attribute.IsDefined(state.Interface) {
+		err := vmAPI.UpdateVM(ctx, &vms.UpdateRequestBody{Delete: []string{state.Interface.ValueString()}})
+		if err != nil {
+			diags.AddError("Failed to remove cloud-init drive", err.Error())
+			return
+		}
+	}
+
+	updateBody.AddCustomStorageDevice(plan.Interface.ValueString(), newDrive(plan.DatastoreID.ValueString()))
+}
@@ -0,0 +1,178 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package cloudinit
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/validators"
+)
+
+// ResourceSchema defines the schema for the cloud-init resource.
+func ResourceSchema() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		CustomType: basetypes.ObjectType{
+			AttrTypes: attributeTypes(),
+		},
+		Description: "The cloud-init configuration.",
+		MarkdownDescription: "The cloud-init configuration. A cloud-init drive is attached to the VM on the " +
+			"`interface`, and rebuilt when the configuration changes.",
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.UseStateForUnknown(),
+		},
+		Attributes: map[string]schema.Attribute{
+			"datastore_id": schema.StringAttribute{
+				Description: "The identifier of the datastore the cloud-init drive is stored on.",
+				MarkdownDescription: "The identifier of the datastore the cloud-init drive is stored on " +
+					"(defaults to `local-lvm`).",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("local-lvm"),
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"interface": schema.StringAttribute{
+				Description: "The interface of the cloud-init drive.",
+				MarkdownDescription: "The interface of the cloud-init drive, could be one of `ideN`, `sataN`, " +
+					"`scsiN` (defaults to `ide2`).",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("ide2"),
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^(ide[0-3]|sata[0-5]|scsi([0-9]|[12][0-9]|30))$`),
+						"one of `ide[0-3]`, `sata[0-5]`, `scsi[0-30]`",
+					),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "The cloud-init configuration format.",
+				MarkdownDescription: "The cloud-init configuration format. Choice is between `configdrive2` | " +
+					"`nocloud` | `opennebula`. If not set, PVE uses `nocloud` for Linux and " +
+					"`configdrive2` for Windows guests.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("configdrive2", "nocloud", "opennebula"),
+				},
+			},
+			"dns": schema.SingleNestedAttribute{
+				Description: "The DNS configuration.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"domain": schema.StringAttribute{
+						Description: "The DNS search domain.",
+						Optional:    true,
+					},
+					"servers": schema.ListAttribute{
+						Description: "The list of DNS servers.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+				},
+			},
+			"ip_config": schema.MapNestedAttribute{
+				Description: "The IP configuration of the network devices.",
+				MarkdownDescription: "The IP configuration of the network devices. The key is the name of the " +
+					"network device, `netN`, where N is the index of the device between `0` and `31`.",
+				Optional: true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(
+						stringvalidator.RegexMatches(
+							regexp.MustCompile(`^net([0-9]|[12][0-9]|3[01])$`),
+							"one of `net[0-31]`",
+						),
+					),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"ipv4": schema.SingleNestedAttribute{
+							Description: "The IPv4 configuration.",
+							Optional:    true,
+							Attributes: map[string]schema.Attribute{
+								"address": schema.StringAttribute{
+									Description: "The IPv4 address in CIDR notation, or `dhcp`.",
+									MarkdownDescription: "The IPv4 address in CIDR notation, e.g. " +
+										"`192.168.1.10/24`, or `dhcp`.",
+									Optional: true,
+								},
+								"gateway": schema.StringAttribute{
+									Description: "The IPv4 gateway.",
+									Optional:    true,
+								},
+							},
+						},
+						"ipv6": schema.SingleNestedAttribute{
+							Description: "The IPv6 configuration.",
+							Optional:    true,
+							Attributes: map[string]schema.Attribute{
+								"address": schema.StringAttribute{
+									Description: "The IPv6 address in CIDR notation, `dhcp` or `auto`.",
+									MarkdownDescription: "The IPv6 address in CIDR notation, `dhcp` or `auto` " +
+										"for stateless autoconfiguration.",
+									Optional: true,
+								},
+								"gateway": schema.StringAttribute{
+									Description: "The IPv6 gateway.",
+									Optional:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"user_account": schema.SingleNestedAttribute{
+				Description: "The user account configuration.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"username": schema.StringAttribute{
+						Description: "The username.",
+						Optional:    true,
+					},
+					"password": schema.StringAttribute{
+						Description: "The password.",
+						Optional:    true,
+						Sensitive:   true,
+					},
+					"keys": schema.ListAttribute{
+						Description: "The list of SSH public keys.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+				},
+			},
+			"user_data_file_id":    fileIDAttribute("The file ID of a custom user data file."),
+			"vendor_data_file_id":  fileIDAttribute("The file ID of a custom vendor data file."),
+			"network_data_file_id": fileIDAttribute("The file ID of a custom network data file."),
+			"meta_data_file_id":    fileIDAttribute("The file ID of a custom meta data file."),
+		},
+	}
+}
+
+func fileIDAttribute(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: description,
+		MarkdownDescription: description + " The file must be a snippet, e.g. `local:snippets/user-data.yaml`, " +
+			"and replaces the corresponding configuration generated by PVE.",
+		Optional: true,
+		Validators: []validator.String{
+			validators.FileID(),
+		},
+	}
+}
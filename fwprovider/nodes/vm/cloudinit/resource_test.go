@@ -0,0 +1,104 @@
+//go:build acceptance || all
+
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package cloudinit_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/test"
+)
+
+func TestAccResourceVM2CloudInit(t *testing.T) {
+	t.Parallel()
+
+	te := test.InitEnvironment(t)
+
+	tests := []struct {
+		name  string
+		steps []resource.TestStep
+	}{
+		{"create VM with cloud-init and then update it", []resource.TestStep{
+			{
+				Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_vm2" "test_vm" {
+					node_name = "{{.NodeName}}"
+					name = "test-cloudinit"
+					initialization = {
+						datastore_id = "local-lvm"
+						dns = {
+							servers = ["1.1.1.1"]
+						}
+						ip_config = {
+							"net0" = {
+								ipv4 = {
+									address = "dhcp"
+								}
+							}
+						}
+						user_account = {
+							username = "ubuntu"
+							password = "password"
+						}
+					}
+				}`),
+				Check: test.ResourceAttributes("proxmox_virtual_environment_vm2.test_vm", map[string]string{
+					"initialization.interface":                   "ide2",
+					"initialization.dns.servers.0":               "1.1.1.1",
+					"initialization.ip_config.net0.ipv4.address": "dhcp",
+					"initialization.user_account.username":       "ubuntu",
+					"initialization.user_account.password":       "password",
+				}),
+			},
+			{
+				Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_vm2" "test_vm" {
+					node_name = "{{.NodeName}}"
+					name = "test-cloudinit"
+					initialization = {
+						datastore_id = "local-lvm"
+						interface    = "scsi1"
+						ip_config = {
+							"net0" = {
+								ipv4 = {
+									address = "10.0.0.10/24"
+									gateway = "10.0.0.1"
+								}
+							}
+						}
+					}
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					test.ResourceAttributes("proxmox_virtual_environment_vm2.test_vm", map[string]string{
+						"initialization.interface":                   "scsi1",
+						"initialization.ip_config.net0.ipv4.address": "10.0.0.10/24",
+						"initialization.ip_config.net0.ipv4.gateway": "10.0.0.1",
+					}),
+					test.NoResourceAttributesSet("proxmox_virtual_environment_vm2.test_vm", []string{
+						"initialization.dns",
+						"initialization.user_account",
+					}),
+				),
+			},
+			{
+				RefreshState: true,
+			},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource.ParallelTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: te.AccProviders,
+				Steps:                    tt.steps,
+			})
+		})
+	}
+}
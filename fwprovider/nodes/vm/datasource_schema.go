@@ -13,8 +13,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/agent"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/cloudinit"
 	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/cpu"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/disk"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/efidisk"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/memory"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/network"
 	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/rng"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/tpmstate"
 	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/vga"
 	"github.com/bpg/terraform-provider-proxmox/fwprovider/types/stringset"
 )
@@ -28,6 +35,7 @@ func (d *Datasource) Schema(
 	resp.Schema = schema.Schema{
 		Description: "This is an experimental implementation of a Proxmox VM datasource using Plugin Framework.",
 		Attributes: map[string]schema.Attribute{
+			"agent": agent.DataSourceSchema(),
 			"clone": schema.SingleNestedAttribute{
 				Description: "The cloning configuration.",
 				Optional:    true,
@@ -48,14 +56,19 @@ func (d *Datasource) Schema(
 				Description: "The description of the VM.",
 				Optional:    true,
 			},
+			"disk":     disk.DataSourceSchema(),
+			"efi_disk": efidisk.DataSourceSchema(),
 			"id": schema.Int64Attribute{
 				Required:    true,
 				Description: "The unique identifier of the VM in the Proxmox cluster.",
 			},
+			"initialization": cloudinit.DataSourceSchema(),
+			"memory":         memory.DataSourceSchema(),
 			"name": schema.StringAttribute{
 				Description: "The name of the VM.",
 				Optional:    true,
 			},
+			"network_device": network.DataSourceSchema(),
 			"node_name": schema.StringAttribute{
 				Description: "The name of the node where the VM is provisioned.",
 				Required:    true,
@@ -69,7 +82,8 @@ func (d *Datasource) Schema(
 			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
 				Read: true,
 			}),
-			"vga": vga.DataSourceSchema(),
+			"tpm_state": tpmstate.DataSourceSchema(),
+			"vga":       vga.DataSourceSchema(),
 		},
 	}
 }
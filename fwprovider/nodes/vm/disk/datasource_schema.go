@@ -0,0 +1,86 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package disk
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// DataSourceSchema defines the schema for the disk datasource.
+func DataSourceSchema() schema.Attribute {
+	return schema.MapNestedAttribute{
+		Description: "The disks, keyed by the interface of the disk.",
+		Optional:    true,
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"aio": schema.StringAttribute{
+					Description: "The disk AIO mode.",
+					Computed:    true,
+				},
+				"backup": schema.BoolAttribute{
+					Description: "Whether the disk is included in backups.",
+					Computed:    true,
+				},
+				"cache": schema.StringAttribute{
+					Description: "The cache type of the disk.",
+					Computed:    true,
+				},
+				"datastore_id": schema.StringAttribute{
+					Description: "The identifier of the datastore the disk is stored on.",
+					Computed:    true,
+				},
+				"discard": schema.StringAttribute{
+					Description: "Whether to pass discard/trim requests to the underlying storage.",
+					Computed:    true,
+				},
+				"file_format": schema.StringAttribute{
+					Description: "The file format of the disk.",
+					Computed:    true,
+				},
+				"import_from": schema.StringAttribute{
+					Description: "The file ID of the disk image imported into the disk.",
+					Computed:    true,
+				},
+				"iothread": schema.BoolAttribute{
+					Description: "Whether to use an I/O thread for the disk.",
+					Computed:    true,
+				},
+				"replicate": schema.BoolAttribute{
+					Description: "Whether the disk is included in storage replication jobs.",
+					Computed:    true,
+				},
+				"serial": schema.StringAttribute{
+					Description: "The serial number of the disk.",
+					Computed:    true,
+				},
+				"size": schema.Int64Attribute{
+					Description: "The size of the disk in gigabytes.",
+					Computed:    true,
+				},
+				"speed": schema.SingleNestedAttribute{
+					Description: "The speed limits of the disk.",
+					Computed:    true,
+					Attributes: map[string]schema.Attribute{
+						"iops_read":            schema.Int64Attribute{Computed: true},
+						"iops_read_burstable":  schema.Int64Attribute{Computed: true},
+						"iops_write":           schema.Int64Attribute{Computed: true},
+						"iops_write_burstable": schema.Int64Attribute{Computed: true},
+						"read":                 schema.Int64Attribute{Computed: true},
+						"read_burstable":       schema.Int64Attribute{Computed: true},
+						"write":                schema.Int64Attribute{Computed: true},
+						"write_burstable":      schema.Int64Attribute{Computed: true},
+					},
+				},
+				"ssd": schema.BoolAttribute{
+					Description: "Whether the disk is presented to the guest as an SSD.",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,201 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package disk
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/attribute"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/vms"
+	proxmoxtypes "github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+// Model represents the disk model.
+type Model struct {
+	AIO         types.String `tfsdk:"aio"`
+	Backup      types.Bool   `tfsdk:"backup"`
+	Cache       types.String `tfsdk:"cache"`
+	DatastoreID types.String `tfsdk:"datastore_id"`
+	Discard     types.String `tfsdk:"discard"`
+	FileFormat  types.String `tfsdk:"file_format"`
+	ImportFrom  types.String `tfsdk:"import_from"`
+	IOThread    types.Bool   `tfsdk:"iothread"`
+	Replicate   types.Bool   `tfsdk:"replicate"`
+	Serial      types.String `tfsdk:"serial"`
+	Size        types.Int64  `tfsdk:"size"`
+	Speed       *SpeedModel  `tfsdk:"speed"`
+	SSD         types.Bool   `tfsdk:"ssd"`
+}
+
+// SpeedModel represents the disk speed limits model.
+type SpeedModel struct {
+	IopsRead           types.Int64 `tfsdk:"iops_read"`
+	IopsReadBurstable  types.Int64 `tfsdk:"iops_read_burstable"`
+	IopsWrite          types.Int64 `tfsdk:"iops_write"`
+	IopsWriteBurstable types.Int64 `tfsdk:"iops_write_burstable"`
+	Read               types.Int64 `tfsdk:"read"`
+	ReadBurstable      types.Int64 `tfsdk:"read_burstable"`
+	Write              types.Int64 `tfsdk:"write"`
+	WriteBurstable     types.Int64 `tfsdk:"write_burstable"`
+}
+
+func speedAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"iops_read":            types.Int64Type,
+		"iops_read_burstable":  types.Int64Type,
+		"iops_write":           types.Int64Type,
+		"iops_write_burstable": types.Int64Type,
+		"read":                 types.Int64Type,
+		"read_burstable":       types.Int64Type,
+		"write":                types.Int64Type,
+		"write_burstable":      types.Int64Type,
+	}
+}
+
+func attributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"aio":          types.StringType,
+		"backup":       types.BoolType,
+		"cache":        types.StringType,
+		"datastore_id": types.StringType,
+		"discard":      types.StringType,
+		"file_format":  types.StringType,
+		"import_from":  types.StringType,
+		"iothread":     types.BoolType,
+		"replicate":    types.BoolType,
+		"serial":       types.StringType,
+		"size":         types.Int64Type,
+		"speed":        types.ObjectType{AttrTypes: speedAttributeTypes()},
+		"ssd":          types.BoolType,
+	}
+}
+
+// exportToCustomStorageDevice creates a new storage device, allocated on the datastore or imported from a file.
+func (m *Model) exportToCustomStorageDevice() vms.CustomStorageDevice {
+	device := vms.CustomStorageDevice{}
+
+	if attribute.IsDefined(m.ImportFrom) {
+		// the size is ignored when importing, the disk is resized afterward if needed
+		device.FileVolume = fmt.Sprintf("%s:0", m.DatastoreID.ValueString())
+		device.ImportFrom = m.ImportFrom.ValueStringPointer()
+	} else {
+		device.FileVolume = fmt.Sprintf("%s:%d", m.DatastoreID.ValueString(), m.Size.ValueInt64())
+	}
+
+	if attribute.IsDefined(m.FileFormat) {
+		device.Format = m.FileFormat.ValueStringPointer()
+	}
+
+	m.exportOptions(&device)
+
+	return device
+}
+
+// exportOptions sets the options of the storage device, replacing the existing ones.
+func (m *Model) exportOptions(device *vms.CustomStorageDevice) {
+	device.AIO = m.AIO.ValueStringPointer()
+	device.Backup = proxmoxtypes.CustomBoolPtr(m.Backup.ValueBoolPointer())
+	device.Cache = m.Cache.ValueStringPointer()
+	device.Discard = m.Discard.ValueStringPointer()
+	device.IOThread = proxmoxtypes.CustomBoolPtr(m.IOThread.ValueBoolPointer())
+	device.Replicate = proxmoxtypes.CustomBoolPtr(m.Replicate.ValueBoolPointer())
+	device.Serial = m.Serial.ValueStringPointer()
+	device.SSD = proxmoxtypes.CustomBoolPtr(m.SSD.ValueBoolPointer())
+
+	device.IopsRead = nil
+	device.MaxIopsRead = nil
+	device.IopsWrite = nil
+	device.MaxIopsWrite = nil
+	device.MaxReadSpeedMbps = nil
+	device.BurstableReadSpeedMbps = nil
+	device.MaxWriteSpeedMbps = nil
+	device.BurstableWriteSpeedMbps = nil
+
+	if m.Speed != nil {
+		device.IopsRead = intPtr(m.Speed.IopsRead)
+		device.MaxIopsRead = intPtr(m.Speed.IopsReadBurstable)
+		device.IopsWrite = intPtr(m.Speed.IopsWrite)
+		device.MaxIopsWrite = intPtr(m.Speed.IopsWriteBurstable)
+		device.MaxReadSpeedMbps = intPtr(m.Speed.Read)
+		device.BurstableReadSpeedMbps = intPtr(m.Speed.ReadBurstable)
+		device.MaxWriteSpeedMbps = intPtr(m.Speed.Write)
+		device.BurstableWriteSpeedMbps = intPtr(m.Speed.WriteBurstable)
+	}
+}
+
+// optionsEqual returns true if the options of the disks, i.e. everything but the location and size, are equal.
+func (m *Model) optionsEqual(other Model) bool {
+	a, b := vms.CustomStorageDevice{}, vms.CustomStorageDevice{}
+
+	m.exportOptions(&a)
+	other.exportOptions(&b)
+
+	return reflect.DeepEqual(a, b)
+}
+
+func (m *Model) importFromCustomStorageDevice(d vms.CustomStorageDevice, previous *Model) {
+	datastoreID, _, _ := strings.Cut(d.FileVolume, ":")
+
+	m.DatastoreID = types.StringValue(datastoreID)
+	m.FileFormat = types.StringPointerValue(d.Format)
+	m.Size = types.Int64Value(d.Size.InGigabytes())
+
+	m.AIO = types.StringPointerValue(d.AIO)
+	m.Backup = types.BoolPointerValue(d.Backup.PointerBool())
+	m.Cache = types.StringPointerValue(d.Cache)
+	m.Discard = types.StringPointerValue(d.Discard)
+	m.IOThread = types.BoolPointerValue(d.IOThread.PointerBool())
+	m.Replicate = types.BoolPointerValue(d.Replicate.PointerBool())
+	m.Serial = types.StringPointerValue(d.Serial)
+	m.SSD = types.BoolPointerValue(d.SSD.PointerBool())
+
+	m.Speed = nil
+
+	if d.IopsRead != nil || d.MaxIopsRead != nil || d.IopsWrite != nil || d.MaxIopsWrite != nil ||
+		d.MaxReadSpeedMbps != nil || d.BurstableReadSpeedMbps != nil ||
+		d.MaxWriteSpeedMbps != nil || d.BurstableWriteSpeedMbps != nil {
+		m.Speed = &SpeedModel{
+			IopsRead:           int64Value(d.IopsRead),
+			IopsReadBurstable:  int64Value(d.MaxIopsRead),
+			IopsWrite:          int64Value(d.IopsWrite),
+			IopsWriteBurstable: int64Value(d.MaxIopsWrite),
+			Read:               int64Value(d.MaxReadSpeedMbps),
+			ReadBurstable:      int64Value(d.BurstableReadSpeedMbps),
+			Write:              int64Value(d.MaxWriteSpeedMbps),
+			WriteBurstable:     int64Value(d.BurstableWriteSpeedMbps),
+		}
+	}
+
+	// PVE does not return the source of imported disks
+	m.ImportFrom = types.StringNull()
+	if previous != nil {
+		m.ImportFrom = previous.ImportFrom
+	}
+}
+
+func intPtr(v types.Int64) *int {
+	if !attribute.IsDefined(v) {
+		return nil
+	}
+
+	i := int(v.ValueInt64())
+
+	return &i
+}
+
+func int64Value(i *int) types.Int64 {
+	if i == nil {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(int64(*i))
+}
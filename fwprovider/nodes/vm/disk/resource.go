@@ -0,0 +1,249 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package disk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/attribute"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/vms"
+	proxmoxtypes "github.com/bpg/terraform-provider-proxmox/proxmox/types"
+	"github.com/bpg/terraform-provider-proxmox/utils"
+)
+
+// Value represents the type for disk settings.
+type Value = types.Map
+
+// NewValue returns a new Value with the given disk settings from the PVE API.
+//
+// The previous value is used to retain the attributes that are not returned by the API, e.g. `import_from`.
+func NewValue(
+	ctx context.Context,
+	config *vms.GetResponseData,
+	previousValue Value,
+	diags *diag.Diagnostics,
+) Value {
+	var previous map[string]Model
+
+	if !previousValue.IsNull() && !previousValue.IsUnknown() {
+		diags.Append(previousValue.ElementsAs(ctx, &previous, false)...)
+	}
+
+	// CD-ROMs and the cloud-init drive are managed by other blocks
+	disks := config.StorageDevices.Filter(func(device *vms.CustomStorageDevice) bool {
+		return device.Media == nil || *device.Media != "cdrom"
+	})
+
+	elements := make(map[string]Model, len(disks))
+
+	for iface, disk := range disks {
+		m := Model{}
+
+		if p, ok := previous[iface]; ok {
+			m.importFromCustomStorageDevice(*disk, &p)
+		} else {
+			m.importFromCustomStorageDevice(*disk, nil)
+		}
+
+		elements[iface] = m
+	}
+
+	obj, d := types.MapValueFrom(ctx, types.ObjectType{}.WithAttributeTypes(attributeTypes()), elements)
+	diags.Append(d...)
+
+	return obj
+}
+
+// FillCreateBody fills the CreateRequestBody with the disk settings from the Value.
+//
+// In the 'create' context, v is the plan.
+func FillCreateBody(ctx context.Context, planValue Value, body *vms.CreateRequestBody, diags *diag.Diagnostics) {
+	if planValue.IsNull() || planValue.IsUnknown() {
+		return
+	}
+
+	var plan map[string]Model
+	d := planValue.ElementsAs(ctx, &plan, false)
+	diags.Append(d...)
+
+	if d.HasError() {
+		return
+	}
+
+	for iface, disk := range plan {
+		body.AddCustomStorageDevice(iface, disk.exportToCustomStorageDevice())
+	}
+}
+
+// Update moves and resizes the existing disks as needed, then fills the UpdateRequestBody with the remaining
+// disk settings from the Value.
+//
+// Moving and resizing disks are separate PVE operations, so they are executed immediately, before the VM
+// configuration is updated. Disks that are not in the plan are removed, unless the VM is being cloned, in which
+// case the disks copied from the source VM are kept.
+//
+// In the 'update' context, v is the plan and stateValue is the current state.
+func Update(
+	ctx context.Context,
+	vmAPI *vms.Client,
+	planValue, stateValue Value,
+	updateBody *vms.UpdateRequestBody,
+	isClone bool,
+	diags *diag.Diagnostics,
+) {
+	if planValue.IsNull() || planValue.IsUnknown() || planValue.Equal(stateValue) {
+		return
+	}
+
+	var plan, state map[string]Model
+	d := planValue.ElementsAs(ctx, &plan, false)
+	diags.Append(d...)
+
+	if !stateValue.IsNull() && !stateValue.IsUnknown() {
+		d = stateValue.ElementsAs(ctx, &state, false)
+		diags.Append(d...)
+	}
+
+	if diags.HasError() {
+		return
+	}
+
+	toCreate, toUpdate, toDelete := utils.MapDiff(plan, state)
+
+	changedOptions := map[string]Model{}
+
+	for iface, disk := range toUpdate {
+		current := state[iface]
+
+		formatChanged := attribute.IsDefined(disk.FileFormat) && !disk.FileFormat.Equal(current.FileFormat)
+
+		if !disk.DatastoreID.Equal(current.DatastoreID) || formatChanged {
+			moveBody := &vms.MoveDiskRequestBody{
+				DeleteOriginalDisk: proxmoxtypes.CustomBool(true).Pointer(),
+				Disk:               iface,
+				TargetStorage:      disk.DatastoreID.ValueString(),
+			}
+
+			if attribute.IsDefined(disk.FileFormat) {
+				moveBody.TargetStorageFormat = disk.FileFormat.ValueStringPointer()
+			}
+
+			err := vmAPI.MoveVMDisk(ctx, moveBody)
+			if err != nil {
+				diags.AddError(fmt.Sprintf("Failed to move disk %s", iface), err.Error())
+				return
+			}
+		}
+
+		if disk.Size.ValueInt64() < current.Size.ValueInt64() {
+			diags.AddError(
+				fmt.Sprintf("Failed to resize disk %s", iface),
+				fmt.Sprintf("disk shrinking is not supported, the current size is %dG, requested %dG",
+					current.Size.ValueInt64(), disk.Size.ValueInt64()),
+			)
+
+			return
+		}
+
+		if disk.Size.ValueInt64() > current.Size.ValueInt64() {
+			err := vmAPI.ResizeVMDisk(ctx, &vms.ResizeDiskRequestBody{
+				Disk: iface,
+				Size: *proxmoxtypes.DiskSizeFromGigabytes(disk.Size.ValueInt64()),
+			})
+			if err != nil {
+				diags.AddError(fmt.Sprintf("Failed to resize disk %s", iface), err.Error())
+				return
+			}
+		}
+
+		if !disk.optionsEqual(current) {
+			changedOptions[iface] = disk
+		}
+	}
+
+	if len(changedOptions) > 0 {
+		// the disk volumes may have been moved above, so re-read them to get their current location
+		config, err := vmAPI.GetVM(ctx)
+		if err != nil {
+			diags.AddError("Failed to get VM configuration", err.Error())
+			return
+		}
+
+		for iface, disk := range changedOptions {
+			device, ok := config.StorageDevices[iface]
+			if !ok {
+				diags.AddError(fmt.Sprintf("Failed to update disk %s", iface), "the disk does not exist")
+				return
+			}
+
+			disk.exportOptions(device)
+			updateBody.AddCustomStorageDevice(iface, *device)
+		}
+	}
+
+	for iface, disk := range toCreate {
+		updateBody.AddCustomStorageDevice(iface, disk.exportToCustomStorageDevice())
+	}
+
+	if !isClone {
+		for iface := range toDelete {
+			updateBody.Delete = append(updateBody.Delete, iface)
+		}
+	}
+}
+
+// ResizeImported grows the disks imported from an image to the size configured in the Value, as the size of an
+// imported disk is the size of its image.
+func ResizeImported(ctx context.Context, vmAPI *vms.Client, planValue Value, diags *diag.Diagnostics) {
+	if planValue.IsNull() || planValue.IsUnknown() {
+		return
+	}
+
+	var plan map[string]Model
+	d := planValue.ElementsAs(ctx, &plan, false)
+	diags.Append(d...)
+
+	if d.HasError() {
+		return
+	}
+
+	var config *vms.GetResponseData
+
+	for iface, disk := range plan {
+		if !attribute.IsDefined(disk.ImportFrom) {
+			continue
+		}
+
+		if config == nil {
+			var err error
+
+			config, err = vmAPI.GetVM(ctx)
+			if err != nil {
+				diags.AddError("Failed to get VM configuration", err.Error())
+				return
+			}
+		}
+
+		device, ok := config.StorageDevices[iface]
+		if !ok || device.Size.InGigabytes() >= disk.Size.ValueInt64() {
+			continue
+		}
+
+		err := vmAPI.ResizeVMDisk(ctx, &vms.ResizeDiskRequestBody{
+			Disk: iface,
+			Size: *proxmoxtypes.DiskSizeFromGigabytes(disk.Size.ValueInt64()),
+		})
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Failed to resize disk %s", iface), err.Error())
+			return
+		}
+	}
+}
@@ -0,0 +1,174 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package disk
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/validators"
+)
+
+// ResourceSchema defines the schema for the disk resource.
+func ResourceSchema() schema.Attribute {
+	return schema.MapNestedAttribute{
+		Description: "The disks",
+		MarkdownDescription: "The disks. The key is the interface of the disk, " +
+			"could be one of `ideN`, `sataN`, `scsiN`, `virtioN`, where N is the index of the interface. " +
+			"Changing the `datastore_id` of a disk moves it to the new datastore, and increasing its " +
+			"`size` resizes it. Disks can not be shrunk.",
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.Map{
+			mapplanmodifier.UseStateForUnknown(),
+		},
+		Validators: []validator.Map{
+			mapvalidator.KeysAre(
+				stringvalidator.RegexMatches(
+					regexp.MustCompile(`^(ide[0-3]|sata[0-5]|scsi([0-9]|[12][0-9]|30)|virtio([0-9]|1[0-5]))$`),
+					"one of `ide[0-3]`, `sata[0-5]`, `scsi[0-30]`, `virtio[0-15]`",
+				),
+			),
+		},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"aio": schema.StringAttribute{
+					Description: "The disk AIO mode.",
+					MarkdownDescription: "The disk AIO mode. Choice is between `io_uring` | `native` | `threads`. " +
+						"If not set, PVE default is `io_uring`.",
+					Optional: true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("io_uring", "native", "threads"),
+					},
+				},
+				"backup": schema.BoolAttribute{
+					Description:         "Whether the disk is included in backups.",
+					MarkdownDescription: "Whether the disk is included in backups. If not set, PVE default is `true`.",
+					Optional:            true,
+				},
+				"cache": schema.StringAttribute{
+					Description: "The cache type of the disk.",
+					MarkdownDescription: "The cache type of the disk. Choice is between `none` | `directsync` | " +
+						"`writethrough` | `writeback` | `unsafe`. If not set, PVE default is `none`.",
+					Optional: true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("none", "directsync", "writethrough", "writeback", "unsafe"),
+					},
+				},
+				"datastore_id": schema.StringAttribute{
+					Description:         "The identifier of the datastore the disk is stored on.",
+					MarkdownDescription: "The identifier of the datastore the disk is stored on (defaults to `local-lvm`).",
+					Optional:            true,
+					Computed:            true,
+					Default:             stringdefault.StaticString("local-lvm"),
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				"discard": schema.StringAttribute{
+					Description: "Whether to pass discard/trim requests to the underlying storage.",
+					MarkdownDescription: "Whether to pass discard/trim requests to the underlying storage. " +
+						"Choice is between `on` | `ignore`. If not set, PVE default is `ignore`.",
+					Optional: true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("on", "ignore"),
+					},
+				},
+				"file_format": schema.StringAttribute{
+					Description: "The file format of the disk.",
+					MarkdownDescription: "The file format of the disk. Choice is between `qcow2` | `raw` | `vmdk`. " +
+						"If not set, PVE uses the default format of the datastore.",
+					Optional: true,
+					Computed: true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+					Validators: []validator.String{
+						stringvalidator.OneOf("qcow2", "raw", "vmdk"),
+					},
+				},
+				"import_from": schema.StringAttribute{
+					Description: "The file ID of a disk image to import into the disk.",
+					MarkdownDescription: "The file ID of a disk image to import into the disk, e.g. " +
+						"`local:import/image.qcow2`. The image is only imported when the disk is created, and " +
+						"the disk is then resized to `size` if the image is smaller.",
+					Optional: true,
+					Validators: []validator.String{
+						validators.FileID(),
+					},
+				},
+				"iothread": schema.BoolAttribute{
+					Description: "Whether to use an I/O thread for the disk.",
+					Optional:    true,
+				},
+				"replicate": schema.BoolAttribute{
+					Description: "Whether the disk is included in storage replication jobs.",
+					MarkdownDescription: "Whether the disk is included in storage replication jobs. " +
+						"If not set, PVE default is `true`.",
+					Optional: true,
+				},
+				"serial": schema.StringAttribute{
+					Description: "The serial number of the disk.",
+					Optional:    true,
+					Validators: []validator.String{
+						stringvalidator.LengthBetween(1, 20),
+					},
+				},
+				"size": schema.Int64Attribute{
+					Description:         "The size of the disk in gigabytes.",
+					MarkdownDescription: "The size of the disk in gigabytes (defaults to `8`).",
+					Optional:            true,
+					Computed:            true,
+					Default:             int64default.StaticInt64(8),
+					Validators: []validator.Int64{
+						int64validator.AtLeast(1),
+					},
+				},
+				"speed": schema.SingleNestedAttribute{
+					Description: "The speed limits of the disk.",
+					Optional:    true,
+					Attributes: map[string]schema.Attribute{
+						"iops_read":            speedAttribute("The maximum read I/O operations per second."),
+						"iops_read_burstable":  speedAttribute("The maximum unthrottled read I/O pool per second."),
+						"iops_write":           speedAttribute("The maximum write I/O operations per second."),
+						"iops_write_burstable": speedAttribute("The maximum unthrottled write I/O pool per second."),
+						"read":                 speedAttribute("The maximum read speed in megabytes per second."),
+						"read_burstable":       speedAttribute("The maximum burstable read speed in megabytes per second."),
+						"write":                speedAttribute("The maximum write speed in megabytes per second."),
+						"write_burstable":      speedAttribute("The maximum burstable write speed in megabytes per second."),
+					},
+				},
+				"ssd": schema.BoolAttribute{
+					Description: "Whether the disk is presented to the guest as an SSD.",
+					MarkdownDescription: "Whether the disk is presented to the guest as an SSD. " +
+						"Not supported by the `virtio` interface.",
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func speedAttribute(description string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Description: description,
+		Optional:    true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	}
+}
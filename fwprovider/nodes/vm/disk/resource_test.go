@@ -0,0 +1,131 @@
+//go:build acceptance || all
+
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package disk_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/test"
+)
+
+func TestAccResourceVM2Disk(t *testing.T) {
+	t.Parallel()
+
+	te := test.InitEnvironment(t)
+
+	tests := []struct {
+		name  string
+		steps []resource.TestStep
+	}{
+		{"create VM with a default disk", []resource.TestStep{{
+			Config: te.RenderConfig(`
+			resource "proxmox_virtual_environment_vm2" "test_vm" {
+				node_name = "{{.NodeName}}"
+				name = "test-disk"
+				disk = {
+					"scsi0" = {
+						datastore_id = "local-lvm"
+					}
+				}
+			}`),
+			Check: test.ResourceAttributes("proxmox_virtual_environment_vm2.test_vm", map[string]string{
+				"disk.scsi0.datastore_id": "local-lvm",
+				"disk.scsi0.size":         "8",
+			}),
+		}}},
+		{"create VM with disk options and then resize and update them", []resource.TestStep{
+			{
+				Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_vm2" "test_vm" {
+					node_name = "{{.NodeName}}"
+					name = "test-disk"
+					disk = {
+						"scsi0" = {
+							datastore_id = "local-lvm"
+							size         = 10
+							iothread     = true
+							speed = {
+								read = 100
+							}
+						}
+					}
+				}`),
+				Check: test.ResourceAttributes("proxmox_virtual_environment_vm2.test_vm", map[string]string{
+					"disk.scsi0.size":       "10",
+					"disk.scsi0.iothread":   "true",
+					"disk.scsi0.speed.read": "100",
+				}),
+			},
+			{
+				Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_vm2" "test_vm" {
+					node_name = "{{.NodeName}}"
+					name = "test-disk"
+					disk = {
+						"scsi0" = {
+							datastore_id = "local-lvm"
+							size         = 12
+							discard      = "on"
+						}
+						"virtio0" = {
+							datastore_id = "local-lvm"
+							size         = 1
+						}
+					}
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					test.ResourceAttributes("proxmox_virtual_environment_vm2.test_vm", map[string]string{
+						"disk.scsi0.size":    "12",
+						"disk.scsi0.discard": "on",
+						"disk.virtio0.size":  "1",
+					}),
+					test.NoResourceAttributesSet("proxmox_virtual_environment_vm2.test_vm", []string{
+						"disk.scsi0.iothread",
+						"disk.scsi0.speed.read",
+					}),
+				),
+			},
+			{
+				RefreshState: true,
+			},
+		}},
+		{"create VM with EFI disk and TPM state", []resource.TestStep{{
+			Config: te.RenderConfig(`
+			resource "proxmox_virtual_environment_vm2" "test_vm" {
+				node_name = "{{.NodeName}}"
+				name = "test-disk"
+				efi_disk = {
+					datastore_id = "local-lvm"
+					type         = "4m"
+				}
+				tpm_state = {
+					datastore_id = "local-lvm"
+				}
+			}`),
+			Check: test.ResourceAttributes("proxmox_virtual_environment_vm2.test_vm", map[string]string{
+				"efi_disk.datastore_id":      "local-lvm",
+				"efi_disk.type":              "4m",
+				"efi_disk.pre_enrolled_keys": "false",
+				"tpm_state.datastore_id":     "local-lvm",
+				"tpm_state.version":          "v2.0",
+			}),
+		}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource.ParallelTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: te.AccProviders,
+				Steps:                    tt.steps,
+			})
+		})
+	}
+}
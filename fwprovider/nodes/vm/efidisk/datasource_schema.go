@@ -0,0 +1,42 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package efidisk
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// DataSourceSchema defines the schema for the EFI disk datasource.
+func DataSourceSchema() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		CustomType: basetypes.ObjectType{
+			AttrTypes: attributeTypes(),
+		},
+		Description: "The EFI disk used to store the EFI variables.",
+		Computed:    true,
+		Attributes: map[string]schema.Attribute{
+			"datastore_id": schema.StringAttribute{
+				Description: "The identifier of the datastore the EFI disk is stored on.",
+				Computed:    true,
+			},
+			"file_format": schema.StringAttribute{
+				Description: "The file format of the EFI disk.",
+				Computed:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "The size and type of the OVMF EFI disk.",
+				Computed:    true,
+			},
+			"pre_enrolled_keys": schema.BoolAttribute{
+				Description: "Whether the EFI vars template has distribution-specific and Microsoft Standard " +
+					"keys enrolled.",
+				Computed: true,
+			},
+		},
+	}
+}
@@ -0,0 +1,29 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package efidisk
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Model represents the EFI disk model.
+type Model struct {
+	DatastoreID     types.String `tfsdk:"datastore_id"`
+	FileFormat      types.String `tfsdk:"file_format"`
+	Type            types.String `tfsdk:"type"`
+	PreEnrolledKeys types.Bool   `tfsdk:"pre_enrolled_keys"`
+}
+
+func attributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"datastore_id":      types.StringType,
+		"file_format":       types.StringType,
+		"type":              types.StringType,
+		"pre_enrolled_keys": types.BoolType,
+	}
+}
@@ -0,0 +1,162 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package efidisk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/attribute"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/vms"
+	proxmoxtypes "github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+// Value represents the type for EFI disk settings.
+type Value = types.Object
+
+// NewValue returns a new Value with the given EFI disk settings from the PVE API.
+//
+// The previous value is used to retain the file format, which PVE does not return for some datastore types.
+func NewValue(
+	ctx context.Context,
+	config *vms.GetResponseData,
+	previousValue Value,
+	diags *diag.Diagnostics,
+) Value {
+	if config.EFIDisk == nil {
+		return types.ObjectNull(attributeTypes())
+	}
+
+	datastoreID, _, _ := strings.Cut(config.EFIDisk.FileVolume, ":")
+
+	efiDisk := Model{
+		DatastoreID:     types.StringValue(datastoreID),
+		FileFormat:      types.StringPointerValue(config.EFIDisk.Format),
+		Type:            types.StringValue("2m"),
+		PreEnrolledKeys: types.BoolValue(false),
+	}
+
+	if config.EFIDisk.Format == nil && attribute.IsDefined(previousValue) {
+		var previous Model
+
+		diags.Append(previousValue.As(ctx, &previous, basetypes.ObjectAsOptions{})...)
+
+		efiDisk.FileFormat = previous.FileFormat
+	}
+
+	if config.EFIDisk.Type != nil {
+		efiDisk.Type = types.StringValue(*config.EFIDisk.Type)
+	}
+
+	if config.EFIDisk.PreEnrolledKeys != nil {
+		efiDisk.PreEnrolledKeys = types.BoolValue(bool(*config.EFIDisk.PreEnrolledKeys))
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, attributeTypes(), efiDisk)
+	diags.Append(d...)
+
+	return obj
+}
+
+// exportToCustomEFIDisk creates a new EFI disk allocated on the datastore.
+func (m *Model) exportToCustomEFIDisk() *vms.CustomEFIDisk {
+	efiDisk := &vms.CustomEFIDisk{
+		// the size is ignored by PVE, it is derived from the type
+		FileVolume: fmt.Sprintf("%s:1", m.DatastoreID.ValueString()),
+	}
+
+	if attribute.IsDefined(m.FileFormat) {
+		efiDisk.Format = m.FileFormat.ValueStringPointer()
+	}
+
+	if attribute.IsDefined(m.Type) {
+		efiDisk.Type = m.Type.ValueStringPointer()
+	}
+
+	if attribute.IsDefined(m.PreEnrolledKeys) {
+		efiDisk.PreEnrolledKeys = proxmoxtypes.CustomBoolPtr(m.PreEnrolledKeys.ValueBoolPointer())
+	}
+
+	return efiDisk
+}
+
+// FillCreateBody fills the CreateRequestBody with the EFI disk settings from the Value.
+//
+// In the 'create' context, v is the plan.
+func FillCreateBody(ctx context.Context, planValue Value, body *vms.CreateRequestBody, diags *diag.Diagnostics) {
+	var plan Model
+
+	if planValue.IsNull() || planValue.IsUnknown() {
+		return
+	}
+
+	d := planValue.As(ctx, &plan, basetypes.ObjectAsOptions{})
+	diags.Append(d...)
+
+	if d.HasError() {
+		return
+	}
+
+	body.EFIDisk = plan.exportToCustomEFIDisk()
+}
+
+// Update moves the EFI disk to another datastore, or fills the UpdateRequestBody with a new EFI disk if the VM
+// does not have one yet.
+//
+// In the 'update' context, v is the plan and stateValue is the current state.
+func Update(
+	ctx context.Context,
+	vmAPI *vms.Client,
+	planValue, stateValue Value,
+	updateBody *vms.UpdateRequestBody,
+	diags *diag.Diagnostics,
+) {
+	var plan, state Model
+
+	if planValue.IsNull() || planValue.IsUnknown() || planValue.Equal(stateValue) {
+		return
+	}
+
+	d := planValue.As(ctx, &plan, basetypes.ObjectAsOptions{})
+	diags.Append(d...)
+
+	if d.HasError() {
+		return
+	}
+
+	if stateValue.IsNull() || stateValue.IsUnknown() {
+		updateBody.EFIDisk = plan.exportToCustomEFIDisk()
+		return
+	}
+
+	d = stateValue.As(ctx, &state, basetypes.ObjectAsOptions{})
+	diags.Append(d...)
+
+	if d.HasError() || plan.DatastoreID.Equal(state.DatastoreID) {
+		return
+	}
+
+	moveBody := &vms.MoveDiskRequestBody{
+		DeleteOriginalDisk: proxmoxtypes.CustomBool(true).Pointer(),
+		Disk:               "efidisk0",
+		TargetStorage:      plan.DatastoreID.ValueString(),
+	}
+
+	if attribute.IsDefined(plan.FileFormat) {
+		moveBody.TargetStorageFormat = plan.FileFormat.ValueStringPointer()
+	}
+
+	err := vmAPI.MoveVMDisk(ctx, moveBody)
+	if err != nil {
+		diags.AddError("Failed to move EFI disk", err.Error())
+	}
+}
@@ -0,0 +1,86 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package efidisk
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// ResourceSchema defines the schema for the EFI disk resource.
+func ResourceSchema() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		CustomType: basetypes.ObjectType{
+			AttrTypes: attributeTypes(),
+		},
+		Description: "The EFI disk used to store the EFI variables.",
+		MarkdownDescription: "The EFI disk used to store the EFI variables. Only used by VMs booting with the " +
+			"OVMF (UEFI) firmware. Changing the `datastore_id` moves the disk to the new datastore.",
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.UseStateForUnknown(),
+		},
+		Attributes: map[string]schema.Attribute{
+			"datastore_id": schema.StringAttribute{
+				Description: "The identifier of the datastore the EFI disk is stored on.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"file_format": schema.StringAttribute{
+				Description: "The file format of the EFI disk.",
+				MarkdownDescription: "The file format of the EFI disk. Choice is between `qcow2` | `raw` | `vmdk`. " +
+					"If not set, PVE uses the default format of the datastore.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("qcow2", "raw", "vmdk"),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "The size and type of the OVMF EFI disk.",
+				MarkdownDescription: "The size and type of the OVMF EFI disk. Choice is between `2m` | `4m` " +
+					"(defaults to `2m`). `4m` is required for Secure Boot.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("2m"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("2m", "4m"),
+				},
+			},
+			"pre_enrolled_keys": schema.BoolAttribute{
+				Description: "Whether to use an EFI vars template with distribution-specific and Microsoft " +
+					"Standard keys enrolled.",
+				MarkdownDescription: "Whether to use an EFI vars template with distribution-specific and Microsoft " +
+					"Standard keys enrolled, if used with `type` = `4m` (defaults to `false`).",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,51 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package memory
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// DataSourceSchema defines the schema for the memory datasource.
+func DataSourceSchema() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		CustomType: basetypes.ObjectType{
+			AttrTypes: attributeTypes(),
+		},
+		Description: "The memory configuration.",
+		Optional:    true,
+		Computed:    true,
+		Attributes: map[string]schema.Attribute{
+			"dedicated": schema.Int64Attribute{
+				Description: "The dedicated memory in megabytes.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"floating": schema.Int64Attribute{
+				Description: "The floating memory in megabytes.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"shared": schema.Int64Attribute{
+				Description: "The shared memory in megabytes.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"hugepages": schema.StringAttribute{
+				Description: "The size of the hugepages used for the memory.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"keep_hugepages": schema.BoolAttribute{
+				Description: "Whether to keep the hugepages allocated after the VM is shut down.",
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+	}
+}
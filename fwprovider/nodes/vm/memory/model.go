@@ -0,0 +1,31 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package memory
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Model represents the memory model.
+type Model struct {
+	Dedicated     types.Int64  `tfsdk:"dedicated"`
+	Floating      types.Int64  `tfsdk:"floating"`
+	Shared        types.Int64  `tfsdk:"shared"`
+	Hugepages     types.String `tfsdk:"hugepages"`
+	KeepHugepages types.Bool   `tfsdk:"keep_hugepages"`
+}
+
+func attributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"dedicated":      types.Int64Type,
+		"floating":       types.Int64Type,
+		"shared":         types.Int64Type,
+		"hugepages":      types.StringType,
+		"keep_hugepages": types.BoolType,
+	}
+}
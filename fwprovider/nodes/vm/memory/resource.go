@@ -0,0 +1,172 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package memory
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/attribute"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/vms"
+	proxmoxtypes "github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+// Value represents the type for memory settings.
+type Value = types.Object
+
+// NewValue returns a new Value with the given memory settings from the PVE API.
+func NewValue(ctx context.Context, config *vms.GetResponseData, diags *diag.Diagnostics) Value {
+	memory := Model{}
+
+	// PVE does not return the dedicated memory if the default is used
+	if config.DedicatedMemory != nil {
+		memory.Dedicated = types.Int64PointerValue(config.DedicatedMemory.PointerInt64())
+	} else {
+		memory.Dedicated = types.Int64Value(512)
+	}
+
+	memory.Floating = types.Int64PointerValue(config.FloatingMemory.PointerInt64())
+	memory.Hugepages = types.StringPointerValue(config.Hugepages)
+	memory.KeepHugepages = types.BoolPointerValue(config.KeepHugepages.PointerBool())
+
+	if config.SharedMemory != nil {
+		memory.Shared = types.Int64Value(int64(config.SharedMemory.Size))
+	} else {
+		memory.Shared = types.Int64Null()
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, attributeTypes(), memory)
+	diags.Append(d...)
+
+	return obj
+}
+
+// FillCreateBody fills the CreateRequestBody with the memory settings from the Value.
+//
+// In the 'create' context, v is the plan.
+func FillCreateBody(ctx context.Context, planValue Value, body *vms.CreateRequestBody, diags *diag.Diagnostics) {
+	var plan Model
+
+	if planValue.IsNull() || planValue.IsUnknown() {
+		return
+	}
+
+	d := planValue.As(ctx, &plan, basetypes.ObjectAsOptions{})
+	diags.Append(d...)
+
+	if d.HasError() {
+		return
+	}
+
+	// for computed fields, we need to check if they are unknown
+	if attribute.IsDefined(plan.Dedicated) {
+		body.DedicatedMemory = intPtr(plan.Dedicated)
+	}
+
+	if attribute.IsDefined(plan.Floating) {
+		body.FloatingMemory = intPtr(plan.Floating)
+	}
+
+	if attribute.IsDefined(plan.Shared) {
+		body.SharedMemory = &vms.CustomSharedMemory{Size: int(plan.Shared.ValueInt64())}
+	}
+
+	if attribute.IsDefined(plan.Hugepages) {
+		body.Hugepages = plan.Hugepages.ValueStringPointer()
+	}
+
+	if attribute.IsDefined(plan.KeepHugepages) {
+		body.KeepHugepages = proxmoxtypes.CustomBoolPtr(plan.KeepHugepages.ValueBoolPointer())
+	}
+}
+
+// FillUpdateBody fills the UpdateRequestBody with the memory settings from the Value.
+//
+// In the 'update' context, v is the plan and stateValue is the current state.
+func FillUpdateBody(
+	ctx context.Context,
+	planValue, stateValue Value,
+	updateBody *vms.UpdateRequestBody,
+	isClone bool,
+	diags *diag.Diagnostics,
+) {
+	var plan, state Model
+
+	if planValue.IsNull() || planValue.IsUnknown() || planValue.Equal(stateValue) {
+		return
+	}
+
+	d := planValue.As(ctx, &plan, basetypes.ObjectAsOptions{})
+	diags.Append(d...)
+	d = stateValue.As(ctx, &state, basetypes.ObjectAsOptions{})
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return
+	}
+
+	var errs []error
+
+	del := func(field string) {
+		errs = append(errs, updateBody.ToDelete(field))
+	}
+
+	if !plan.Dedicated.Equal(state.Dedicated) {
+		if attribute.ShouldBeRemoved(plan.Dedicated, state.Dedicated, isClone) {
+			del("DedicatedMemory")
+		} else if attribute.IsDefined(plan.Dedicated) {
+			updateBody.DedicatedMemory = intPtr(plan.Dedicated)
+		}
+	}
+
+	if !plan.Floating.Equal(state.Floating) {
+		if attribute.ShouldBeRemoved(plan.Floating, state.Floating, isClone) {
+			del("FloatingMemory")
+		} else if attribute.IsDefined(plan.Floating) {
+			updateBody.FloatingMemory = intPtr(plan.Floating)
+		}
+	}
+
+	if !plan.Shared.Equal(state.Shared) {
+		if attribute.ShouldBeRemoved(plan.Shared, state.Shared, isClone) {
+			del("SharedMemory")
+		} else if attribute.IsDefined(plan.Shared) {
+			updateBody.SharedMemory = &vms.CustomSharedMemory{Size: int(plan.Shared.ValueInt64())}
+		}
+	}
+
+	if !plan.Hugepages.Equal(state.Hugepages) {
+		if attribute.ShouldBeRemoved(plan.Hugepages, state.Hugepages, isClone) {
+			del("Hugepages")
+		} else if attribute.IsDefined(plan.Hugepages) {
+			updateBody.Hugepages = plan.Hugepages.ValueStringPointer()
+		}
+	}
+
+	if !plan.KeepHugepages.Equal(state.KeepHugepages) {
+		if attribute.ShouldBeRemoved(plan.KeepHugepages, state.KeepHugepages, isClone) {
+			del("KeepHugepages")
+		} else if attribute.IsDefined(plan.KeepHugepages) {
+			updateBody.KeepHugepages = proxmoxtypes.CustomBoolPtr(plan.KeepHugepages.ValueBoolPointer())
+		}
+	}
+
+	for _, err := range errs {
+		if err != nil {
+			diags.AddError("Failed to update memory settings", err.Error())
+		}
+	}
+}
+
+func intPtr(v types.Int64) *int {
+	i := int(v.ValueInt64())
+
+	return &i
+}
@@ -0,0 +1,77 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package memory
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// ResourceSchema defines the schema for the memory resource.
+func ResourceSchema() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		CustomType: basetypes.ObjectType{
+			AttrTypes: attributeTypes(),
+		},
+		Description: "The memory configuration.",
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.UseStateForUnknown(),
+		},
+		Attributes: map[string]schema.Attribute{
+			"dedicated": schema.Int64Attribute{
+				Description:         "The dedicated memory in megabytes.",
+				MarkdownDescription: "The dedicated memory in megabytes. If not set, PVE default is `512`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(64, 268435456),
+				},
+			},
+			"floating": schema.Int64Attribute{
+				Description: "The floating memory in megabytes, i.e. the minimum memory of the balloon device.",
+				MarkdownDescription: "The floating memory in megabytes, i.e. the minimum memory of the balloon " +
+					"device. Use `0` to disable the balloon device.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 268435456),
+				},
+			},
+			"shared": schema.Int64Attribute{
+				Description:         "The shared memory in megabytes.",
+				MarkdownDescription: "The shared memory in megabytes, exposed to the guest as an `ivshmem` device.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 268435456),
+				},
+			},
+			"hugepages": schema.StringAttribute{
+				Description: "The size of the hugepages used for the memory.",
+				MarkdownDescription: "The size of the hugepages used for the memory. " +
+					"Choice is between `2` | `1024` | `any`.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("2", "1024", "any"),
+				},
+			},
+			"keep_hugepages": schema.BoolAttribute{
+				Description: "Whether to keep the hugepages allocated after the VM is shut down.",
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+	}
+}
@@ -0,0 +1,120 @@
+//go:build acceptance || all
+
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/test"
+)
+
+func TestAccResourceVM2Memory(t *testing.T) {
+	t.Parallel()
+
+	te := test.InitEnvironment(t)
+
+	tests := []struct {
+		name  string
+		steps []resource.TestStep
+	}{
+		{"create VM with no memory params", []resource.TestStep{{
+			Config: te.RenderConfig(`
+			resource "proxmox_virtual_environment_vm2" "test_vm" {
+				node_name = "{{.NodeName}}"
+				name = "test-memory"
+			}`),
+			Check: resource.ComposeTestCheckFunc(
+				test.ResourceAttributes("proxmox_virtual_environment_vm2.test_vm", map[string]string{
+					"memory.dedicated": "512",
+				}),
+				test.NoResourceAttributesSet("proxmox_virtual_environment_vm2.test_vm", []string{
+					"memory.floating",
+					"memory.shared",
+					"memory.hugepages",
+				}),
+			),
+		}}},
+		{"create VM with memory params and then update them", []resource.TestStep{
+			{
+				Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_vm2" "test_vm" {
+					node_name = "{{.NodeName}}"
+					name = "test-memory"
+					memory = {
+						dedicated = 2048
+						floating  = 1024
+					}
+				}`),
+				Check: test.ResourceAttributes("proxmox_virtual_environment_vm2.test_vm", map[string]string{
+					"memory.dedicated": "2048",
+					"memory.floating":  "1024",
+				}),
+			},
+			{
+				Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_vm2" "test_vm" {
+					node_name = "{{.NodeName}}"
+					name = "test-memory"
+					memory = {
+						dedicated = 4096
+						shared    = 128
+					}
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					test.ResourceAttributes("proxmox_virtual_environment_vm2.test_vm", map[string]string{
+						"memory.dedicated": "4096",
+						"memory.shared":    "128",
+					}),
+					test.NoResourceAttributesSet("proxmox_virtual_environment_vm2.test_vm", []string{
+						"memory.floating",
+					}),
+				),
+			},
+			{
+				RefreshState: true,
+			},
+		}},
+		{"clone VM with memory params and update them in the clone", []resource.TestStep{{
+			Config: te.RenderConfig(`
+			resource "proxmox_virtual_environment_vm2" "template_vm" {
+				node_name = "{{.NodeName}}"
+				name = "template-memory"
+				memory = {
+					dedicated = 2048
+					floating  = 1024
+				}
+			}
+			resource "proxmox_virtual_environment_vm2" "test_vm" {
+				node_name = "{{.NodeName}}"
+				name = "test-memory"
+				clone = {
+					id = proxmox_virtual_environment_vm2.template_vm.id
+				}
+				memory = {
+					dedicated = 3072
+				}
+			}`),
+			Check: test.ResourceAttributes("proxmox_virtual_environment_vm2.test_vm", map[string]string{
+				"memory.dedicated": "3072",
+				"memory.floating":  "1024",
+			}),
+		}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource.ParallelTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: te.AccProviders,
+				Steps:                    tt.steps,
+			})
+		})
+	}
+}
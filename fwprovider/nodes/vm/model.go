@@ -15,9 +15,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/agent"
 	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/cdrom"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/cloudinit"
 	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/cpu"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/disk"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/efidisk"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/memory"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/network"
 	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/rng"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/tpmstate"
 	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/vga"
 	"github.com/bpg/terraform-provider-proxmox/fwprovider/types/stringset"
 	"github.com/bpg/terraform-provider-proxmox/proxmox"
@@ -29,6 +36,7 @@ import (
 // Note: for computed fields / blocks we have to use an Object type (or an alias),
 // or a custom type in order to hold an unknown value.
 type Model struct {
+	Agent       agent.Value  `tfsdk:"agent"`
 	Description types.String `tfsdk:"description"`
 	CDROM       cdrom.Value  `tfsdk:"cdrom"`
 	CPU         cpu.Value    `tfsdk:"cpu"`
@@ -36,15 +44,21 @@ type Model struct {
 		ID      types.Int64 `tfsdk:"id"`
 		Retries types.Int64 `tfsdk:"retries"`
 	} `tfsdk:"clone"`
-	ID            types.Int64     `tfsdk:"id"`
-	Name          types.String    `tfsdk:"name"`
-	NodeName      types.String    `tfsdk:"node_name"`
-	RNG           rng.Value       `tfsdk:"rng"`
-	StopOnDestroy types.Bool      `tfsdk:"stop_on_destroy"`
-	Tags          stringset.Value `tfsdk:"tags"`
-	Template      types.Bool      `tfsdk:"template"`
-	Timeouts      timeouts.Value  `tfsdk:"timeouts"`
-	VGA           vga.Value       `tfsdk:"vga"`
+	Disk           disk.Value      `tfsdk:"disk"`
+	EFIDisk        efidisk.Value   `tfsdk:"efi_disk"`
+	ID             types.Int64     `tfsdk:"id"`
+	Initialization cloudinit.Value `tfsdk:"initialization"`
+	Memory         memory.Value    `tfsdk:"memory"`
+	Name           types.String    `tfsdk:"name"`
+	NetworkDevices network.Value   `tfsdk:"network_device"`
+	NodeName       types.String    `tfsdk:"node_name"`
+	RNG            rng.Value       `tfsdk:"rng"`
+	StopOnDestroy  types.Bool      `tfsdk:"stop_on_destroy"`
+	Tags           stringset.Value `tfsdk:"tags"`
+	Template       types.Bool      `tfsdk:"template"`
+	Timeouts       timeouts.Value  `tfsdk:"timeouts"`
+	TPMState       tpmstate.Value  `tfsdk:"tpm_state"`
+	VGA            vga.Value       `tfsdk:"vga"`
 }
 
 // read retrieves the current state of the resource from the API and updates the state.
@@ -86,11 +100,21 @@ func read(ctx context.Context, client proxmox.Client, model *Model, diags *diag.
 	model.Template = types.BoolPointerValue(config.Template.PointerBool())
 
 	// Blocks
+	model.Agent = agent.NewValue(ctx, config, diags)
 	model.CPU = cpu.NewValue(ctx, config, diags)
+	model.Memory = memory.NewValue(ctx, config, diags)
+	model.NetworkDevices = network.NewValue(ctx, config, diags)
 	model.RNG = rng.NewValue(ctx, config, diags)
+	model.TPMState = tpmstate.NewValue(ctx, config, diags)
 	model.VGA = vga.NewValue(ctx, config, diags)
 
-	model.CDROM = cdrom.NewValue(ctx, config, diags)
+	vmID := int(model.ID.ValueInt64())
+
+	// the previous values are used for the attributes that are not returned by the API
+	model.CDROM = cdrom.NewValue(ctx, config, vmID, diags)
+	model.Disk = disk.NewValue(ctx, config, model.Disk, diags)
+	model.EFIDisk = efidisk.NewValue(ctx, config, model.EFIDisk, diags)
+	model.Initialization = cloudinit.NewValue(ctx, config, vmID, model.Initialization, diags)
 
 	return true
 }
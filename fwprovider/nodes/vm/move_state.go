@@ -0,0 +1,453 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package vm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/agent"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/cloudinit"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/disk"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/efidisk"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/memory"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/network"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/tpmstate"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/types/stringset"
+)
+
+// sdkVMResourceType is the type name of the SDKv2 VM resource, which state can be moved to this resource.
+const sdkVMResourceType = "proxmox_virtual_environment_vm"
+
+var _ resource.ResourceWithMoveState = &Resource{}
+
+// sdkVMState is the subset of the SDKv2 VM resource state that is translated to this resource.
+//
+// The nested blocks of the SDKv2 resource are stored as lists, with at most one element for the single blocks.
+type sdkVMState struct {
+	VMID          int64    `json:"vm_id"`
+	NodeName      string   `json:"node_name"`
+	Name          string   `json:"name"`
+	Description   string   `json:"description"`
+	Tags          []string `json:"tags"`
+	Template      bool     `json:"template"`
+	StopOnDestroy bool     `json:"stop_on_destroy"`
+
+	Agent []struct {
+		Enabled bool   `json:"enabled"`
+		Trim    bool   `json:"trim"`
+		Type    string `json:"type"`
+	} `json:"agent"`
+
+	Memory []struct {
+		Dedicated     int64  `json:"dedicated"`
+		Floating      int64  `json:"floating"`
+		Shared        int64  `json:"shared"`
+		Hugepages     string `json:"hugepages"`
+		KeepHugepages bool   `json:"keep_hugepages"`
+	} `json:"memory"`
+
+	Disk []struct {
+		AIO         string `json:"aio"`
+		Backup      bool   `json:"backup"`
+		Cache       string `json:"cache"`
+		DatastoreID string `json:"datastore_id"`
+		Discard     string `json:"discard"`
+		FileFormat  string `json:"file_format"`
+		FileID      string `json:"file_id"`
+		Interface   string `json:"interface"`
+		IOThread    bool   `json:"iothread"`
+		Replicate   bool   `json:"replicate"`
+		Serial      string `json:"serial"`
+		Size        int64  `json:"size"`
+		SSD         bool   `json:"ssd"`
+		Speed       []struct {
+			IopsRead           int64 `json:"iops_read"`
+			IopsReadBurstable  int64 `json:"iops_read_burstable"`
+			IopsWrite          int64 `json:"iops_write"`
+			IopsWriteBurstable int64 `json:"iops_write_burstable"`
+			Read               int64 `json:"read"`
+			ReadBurstable      int64 `json:"read_burstable"`
+			Write              int64 `json:"write"`
+			WriteBurstable     int64 `json:"write_burstable"`
+		} `json:"speed"`
+	} `json:"disk"`
+
+	EFIDisk []struct {
+		DatastoreID     string `json:"datastore_id"`
+		FileFormat      string `json:"file_format"`
+		Type            string `json:"type"`
+		PreEnrolledKeys bool   `json:"pre_enrolled_keys"`
+	} `json:"efi_disk"`
+
+	TPMState []struct {
+		DatastoreID string `json:"datastore_id"`
+		Version     string `json:"version"`
+	} `json:"tpm_state"`
+
+	NetworkDevice []struct {
+		Bridge       string  `json:"bridge"`
+		Disconnected bool    `json:"disconnected"`
+		Enabled      bool    `json:"enabled"`
+		Firewall     bool    `json:"firewall"`
+		MACAddress   string  `json:"mac_address"`
+		Model        string  `json:"model"`
+		MTU          int64   `json:"mtu"`
+		Queues       int64   `json:"queues"`
+		RateLimit    float64 `json:"rate_limit"`
+		Trunks       string  `json:"trunks"`
+		VLANID       int64   `json:"vlan_id"`
+	} `json:"network_device"`
+
+	Initialization []struct {
+		DatastoreID string `json:"datastore_id"`
+		Interface   string `json:"interface"`
+		Type        string `json:"type"`
+		DNS         []struct {
+			Domain  string   `json:"domain"`
+			Servers []string `json:"servers"`
+		} `json:"dns"`
+		IPConfig []struct {
+			IPv4 []sdkAddressState `json:"ipv4"`
+			IPv6 []sdkAddressState `json:"ipv6"`
+		} `json:"ip_config"`
+		UserAccount []struct {
+			Keys     []string `json:"keys"`
+			Password string   `json:"password"`
+			Username string   `json:"username"`
+		} `json:"user_account"`
+		UserDataFileID    string `json:"user_data_file_id"`
+		VendorDataFileID  string `json:"vendor_data_file_id"`
+		NetworkDataFileID string `json:"network_data_file_id"`
+		MetaDataFileID    string `json:"meta_data_file_id"`
+	} `json:"initialization"`
+}
+
+type sdkAddressState struct {
+	Address string `json:"address"`
+	Gateway string `json:"gateway"`
+}
+
+// MoveState returns the state movers of the resource.
+//
+// The state of `proxmox_virtual_environment_vm` can be moved to this resource with a `moved` block, without
+// re-creating the VM. Only the blocks supported by this resource are translated, the rest of the attributes are
+// populated by the refresh following the move.
+func (r *Resource) MoveState(_ context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: moveSDKVMState,
+		},
+	}
+}
+
+func moveSDKVMState(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	if req.SourceTypeName != sdkVMResourceType || !strings.HasSuffix(req.SourceProviderAddress, "bpg/proxmox") {
+		return
+	}
+
+	if req.SourceRawState == nil {
+		resp.Diagnostics.AddError("Unable to Move Resource State", "The source resource state is missing.")
+		return
+	}
+
+	var source sdkVMState
+
+	if err := json.Unmarshal(req.SourceRawState.JSON, &source); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Move Resource State",
+			"An unexpected error occurred while parsing the source resource state.\n\nError: "+err.Error(),
+		)
+
+		return
+	}
+
+	if source.VMID == 0 || source.NodeName == "" {
+		resp.Diagnostics.AddError(
+			"Unable to Move Resource State",
+			fmt.Sprintf("The source resource state has no VM ID or node name, got %d and %q.",
+				source.VMID, source.NodeName),
+		)
+
+		return
+	}
+
+	diags := &resp.Diagnostics
+	state := &resp.TargetState
+
+	set := func(name string, value any) {
+		diags.Append(state.SetAttribute(ctx, path.Root(name), value)...)
+	}
+
+	set("id", types.Int64Value(source.VMID))
+	set("node_name", types.StringValue(source.NodeName))
+	set("name", stringValue(source.Name))
+	set("description", stringValue(source.Description))
+	set("template", types.BoolValue(source.Template))
+	set("stop_on_destroy", types.BoolValue(source.StopOnDestroy))
+
+	if len(source.Tags) > 0 {
+		set("tags", stringset.NewValueList(source.Tags, diags))
+	}
+
+	if len(source.Agent) > 0 {
+		a := source.Agent[0]
+		set("agent", agent.Model{
+			Enabled: types.BoolValue(a.Enabled),
+			Trim:    types.BoolValue(a.Trim),
+			Type:    stringValue(a.Type),
+		})
+	}
+
+	if len(source.Memory) > 0 {
+		m := source.Memory[0]
+		set("memory", memory.Model{
+			Dedicated:     types.Int64Value(m.Dedicated),
+			Floating:      types.Int64Value(m.Floating),
+			Shared:        int64Value(m.Shared),
+			Hugepages:     stringValue(m.Hugepages),
+			KeepHugepages: types.BoolValue(m.KeepHugepages),
+		})
+	}
+
+	if len(source.Disk) > 0 {
+		set("disk", moveSDKDisks(source))
+	}
+
+	if len(source.EFIDisk) > 0 {
+		e := source.EFIDisk[0]
+		set("efi_disk", efidisk.Model{
+			DatastoreID:     types.StringValue(e.DatastoreID),
+			FileFormat:      stringValue(e.FileFormat),
+			Type:            stringValue(e.Type),
+			PreEnrolledKeys: types.BoolValue(e.PreEnrolledKeys),
+		})
+	}
+
+	if len(source.TPMState) > 0 {
+		t := source.TPMState[0]
+		set("tpm_state", tpmstate.Model{
+			DatastoreID: types.StringValue(t.DatastoreID),
+			Version:     stringValue(t.Version),
+		})
+	}
+
+	if len(source.NetworkDevice) > 0 {
+		set("network_device", moveSDKNetworkDevices(ctx, source, diags))
+	}
+
+	if len(source.Initialization) > 0 {
+		set("initialization", moveSDKInitialization(source))
+	}
+}
+
+// moveSDKDisks translates the SDKv2 disk list to the disk map, keyed by the disk interface.
+func moveSDKDisks(source sdkVMState) map[string]disk.Model {
+	disks := make(map[string]disk.Model, len(source.Disk))
+
+	for _, d := range source.Disk {
+		m := disk.Model{
+			AIO:         stringValue(d.AIO),
+			Backup:      types.BoolValue(d.Backup),
+			Cache:       stringValue(d.Cache),
+			DatastoreID: types.StringValue(d.DatastoreID),
+			Discard:     stringValue(d.Discard),
+			FileFormat:  stringValue(d.FileFormat),
+			ImportFrom:  stringValue(d.FileID),
+			IOThread:    types.BoolValue(d.IOThread),
+			Replicate:   types.BoolValue(d.Replicate),
+			Serial:      stringValue(d.Serial),
+			Size:        types.Int64Value(d.Size),
+			SSD:         types.BoolValue(d.SSD),
+		}
+
+		// the SDKv2 resource uses zero values for the speed limits that are not set
+		if len(d.Speed) > 0 {
+			s := d.Speed[0]
+
+			if s.IopsRead != 0 || s.IopsReadBurstable != 0 || s.IopsWrite != 0 || s.IopsWriteBurstable != 0 ||
+				s.Read != 0 || s.ReadBurstable != 0 || s.Write != 0 || s.WriteBurstable != 0 {
+				m.Speed = &disk.SpeedModel{
+					IopsRead:           int64Value(s.IopsRead),
+					IopsReadBurstable:  int64Value(s.IopsReadBurstable),
+					IopsWrite:          int64Value(s.IopsWrite),
+					IopsWriteBurstable: int64Value(s.IopsWriteBurstable),
+					Read:               int64Value(s.Read),
+					ReadBurstable:      int64Value(s.ReadBurstable),
+					Write:              int64Value(s.Write),
+					WriteBurstable:     int64Value(s.WriteBurstable),
+				}
+			}
+		}
+
+		disks[d.Interface] = m
+	}
+
+	return disks
+}
+
+// moveSDKNetworkDevices translates the SDKv2 network device list to the network device map, keyed by `netN`, where
+// N is the index of the device in the list. The disabled devices are skipped, as they are not present in the VM.
+func moveSDKNetworkDevices(ctx context.Context, source sdkVMState, diags *diag.Diagnostics) map[string]network.Model {
+	devices := make(map[string]network.Model, len(source.NetworkDevice))
+
+	for i, nd := range source.NetworkDevice {
+		if !nd.Enabled {
+			continue
+		}
+
+		m := network.Model{
+			Bridge:       types.StringValue(nd.Bridge),
+			Disconnected: types.BoolValue(nd.Disconnected),
+			Firewall:     types.BoolValue(nd.Firewall),
+			MACAddress:   stringValue(nd.MACAddress),
+			Model:        types.StringValue(nd.Model),
+			MTU:          int64Value(nd.MTU),
+			Queues:       int64Value(nd.Queues),
+			RateLimit:    types.Float64Null(),
+			Trunks:       types.SetNull(types.Int64Type),
+			VLANID:       int64Value(nd.VLANID),
+		}
+
+		if nd.RateLimit != 0 {
+			m.RateLimit = types.Float64Value(nd.RateLimit)
+		}
+
+		if nd.Trunks != "" {
+			var trunks []int64
+
+			for _, t := range strings.Split(nd.Trunks, ";") {
+				vlan, err := strconv.ParseInt(strings.TrimSpace(t), 10, 64)
+				if err != nil {
+					diags.AddError(
+						"Unable to Move Resource State",
+						fmt.Sprintf("Invalid trunks %q of network device %d: %s", nd.Trunks, i, err.Error()),
+					)
+
+					continue
+				}
+
+				trunks = append(trunks, vlan)
+			}
+
+			set, d := types.SetValueFrom(ctx, types.Int64Type, trunks)
+			diags.Append(d...)
+
+			m.Trunks = set
+		}
+
+		devices[fmt.Sprintf("net%d", i)] = m
+	}
+
+	return devices
+}
+
+// moveSDKInitialization translates the SDKv2 initialization block, the IP configurations are keyed by `netN`, where
+// N is the index of the configuration in the list.
+func moveSDKInitialization(source sdkVMState) cloudinit.Model {
+	ci := source.Initialization[0]
+
+	m := cloudinit.Model{
+		DatastoreID:       types.StringValue(ci.DatastoreID),
+		Interface:         types.StringValue(ci.Interface),
+		Type:              stringValue(ci.Type),
+		UserDataFileID:    stringValue(ci.UserDataFileID),
+		VendorDataFileID:  stringValue(ci.VendorDataFileID),
+		NetworkDataFileID: stringValue(ci.NetworkDataFileID),
+		MetaDataFileID:    stringValue(ci.MetaDataFileID),
+	}
+
+	// the interface is computed by the SDKv2 resource, and may not be in the state yet
+	if ci.Interface == "" {
+		m.Interface = types.StringValue("ide2")
+	}
+
+	if len(ci.DNS) > 0 {
+		m.DNS = &cloudinit.DNSModel{
+			Domain:  stringValue(ci.DNS[0].Domain),
+			Servers: stringValues(ci.DNS[0].Servers),
+		}
+	}
+
+	for i, ipConfig := range ci.IPConfig {
+		c := cloudinit.IPConfigModel{}
+
+		if len(ipConfig.IPv4) > 0 {
+			c.IPv4 = &cloudinit.AddressModel{
+				Address: stringValue(ipConfig.IPv4[0].Address),
+				Gateway: stringValue(ipConfig.IPv4[0].Gateway),
+			}
+		}
+
+		if len(ipConfig.IPv6) > 0 {
+			c.IPv6 = &cloudinit.AddressModel{
+				Address: stringValue(ipConfig.IPv6[0].Address),
+				Gateway: stringValue(ipConfig.IPv6[0].Gateway),
+			}
+		}
+
+		if c.IPv4 == nil && c.IPv6 == nil {
+			continue
+		}
+
+		if m.IPConfig == nil {
+			m.IPConfig = map[string]cloudinit.IPConfigModel{}
+		}
+
+		m.IPConfig[fmt.Sprintf("net%d", i)] = c
+	}
+
+	if len(ci.UserAccount) > 0 {
+		m.UserAccount = &cloudinit.UserAccountModel{
+			Username: stringValue(ci.UserAccount[0].Username),
+			Password: stringValue(ci.UserAccount[0].Password),
+			Keys:     stringValues(ci.UserAccount[0].Keys),
+		}
+	}
+
+	return m
+}
+
+// stringValue returns a null value for empty strings, which the SDKv2 resource uses for the unset attributes.
+func stringValue(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(s)
+}
+
+// int64Value returns a null value for zero, which the SDKv2 resource uses for the unset attributes.
+func int64Value(i int64) types.Int64 {
+	if i == 0 {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(i)
+}
+
+func stringValues(s []string) []types.String {
+	if len(s) == 0 {
+		return nil
+	}
+
+	values := make([]types.String, 0, len(s))
+
+	for _, v := range s {
+		values = append(values, types.StringValue(v))
+	}
+
+	return values
+}
@@ -0,0 +1,266 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package vm
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/agent"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/cloudinit"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/disk"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/efidisk"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/memory"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/network"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/tpmstate"
+)
+
+func moveState(t *testing.T, req resource.MoveStateRequest) *resource.MoveStateResponse {
+	t.Helper()
+
+	ctx := context.Background()
+	r := &Resource{}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	resp := &resource.MoveStateResponse{
+		TargetState: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+
+	movers := r.MoveState(ctx)
+	require.Len(t, movers, 1)
+
+	movers[0].StateMover(ctx, req, resp)
+
+	return resp
+}
+
+func sdkMoveStateRequest(state []byte) resource.MoveStateRequest {
+	return resource.MoveStateRequest{
+		SourceTypeName:        sdkVMResourceType,
+		SourceProviderAddress: "registry.terraform.io/bpg/proxmox",
+		SourceRawState:        &tfprotov6.RawState{JSON: state},
+	}
+}
+
+func TestMoveStateFromSDKVM(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	state, err := os.ReadFile("testdata/sdk_vm_state.json")
+	require.NoError(t, err)
+
+	resp := moveState(t, sdkMoveStateRequest(state))
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var m Model
+
+	require.False(t, resp.TargetState.Get(ctx, &m).HasError())
+
+	assert.Equal(t, int64(4321), m.ID.ValueInt64())
+	assert.Equal(t, "pve", m.NodeName.ValueString())
+	assert.Equal(t, "web-01", m.Name.ValueString())
+	assert.Equal(t, "Managed by Terraform", m.Description.ValueString())
+	assert.False(t, m.Template.ValueBool())
+	assert.True(t, m.StopOnDestroy.ValueBool())
+
+	var tags []string
+
+	require.False(t, m.Tags.ElementsAs(ctx, &tags, false).HasError())
+	assert.ElementsMatch(t, []string{"terraform", "web"}, tags)
+
+	// blocks that are not translated are left for the refresh
+	assert.True(t, m.CPU.IsNull())
+	assert.True(t, m.VGA.IsNull())
+	assert.True(t, m.RNG.IsNull())
+	assert.Nil(t, m.Clone)
+
+	var a agent.Model
+
+	require.False(t, m.Agent.As(ctx, &a, basetypes.ObjectAsOptions{}).HasError())
+	assert.True(t, a.Enabled.ValueBool())
+	assert.False(t, a.Trim.ValueBool())
+	assert.Equal(t, "virtio", a.Type.ValueString())
+
+	var mem memory.Model
+
+	require.False(t, m.Memory.As(ctx, &mem, basetypes.ObjectAsOptions{}).HasError())
+	assert.Equal(t, int64(4096), mem.Dedicated.ValueInt64())
+	assert.Equal(t, int64(2048), mem.Floating.ValueInt64())
+	assert.True(t, mem.Shared.IsNull())
+	assert.True(t, mem.Hugepages.IsNull())
+
+	disks := map[string]disk.Model{}
+
+	require.False(t, m.Disk.ElementsAs(ctx, &disks, false).HasError())
+	require.Len(t, disks, 2)
+
+	assert.Equal(t, "local:import/noble-server-cloudimg-amd64.qcow2", disks["virtio0"].ImportFrom.ValueString())
+	assert.Equal(t, int64(20), disks["virtio0"].Size.ValueInt64())
+	assert.True(t, disks["virtio0"].IOThread.ValueBool())
+	assert.Nil(t, disks["virtio0"].Speed)
+
+	assert.True(t, disks["scsi1"].ImportFrom.IsNull())
+	assert.Equal(t, "data", disks["scsi1"].DatastoreID.ValueString())
+	assert.Equal(t, "qcow2", disks["scsi1"].FileFormat.ValueString())
+	assert.Equal(t, "data-disk", disks["scsi1"].Serial.ValueString())
+	require.NotNil(t, disks["scsi1"].Speed)
+	assert.Equal(t, int64(200), disks["scsi1"].Speed.Read.ValueInt64())
+	assert.Equal(t, int64(100), disks["scsi1"].Speed.Write.ValueInt64())
+	assert.True(t, disks["scsi1"].Speed.IopsRead.IsNull())
+
+	var efi efidisk.Model
+
+	require.False(t, m.EFIDisk.As(ctx, &efi, basetypes.ObjectAsOptions{}).HasError())
+	assert.Equal(t, "local-lvm", efi.DatastoreID.ValueString())
+	assert.Equal(t, "4m", efi.Type.ValueString())
+	assert.True(t, efi.PreEnrolledKeys.ValueBool())
+
+	var tpm tpmstate.Model
+
+	require.False(t, m.TPMState.As(ctx, &tpm, basetypes.ObjectAsOptions{}).HasError())
+	assert.Equal(t, "v2.0", tpm.Version.ValueString())
+
+	devices := map[string]network.Model{}
+
+	require.False(t, m.NetworkDevices.ElementsAs(ctx, &devices, false).HasError())
+	require.Len(t, devices, 2)
+	assert.NotContains(t, devices, "net1", "disabled device must be skipped")
+
+	assert.Equal(t, "vmbr0", devices["net0"].Bridge.ValueString())
+	assert.Equal(t, int64(10), devices["net0"].VLANID.ValueInt64())
+	assert.True(t, devices["net0"].Trunks.IsNull())
+	assert.True(t, devices["net0"].MTU.IsNull())
+
+	assert.Equal(t, "BC:24:11:2E:C5:02", devices["net2"].MACAddress.ValueString())
+	assert.True(t, devices["net2"].Disconnected.ValueBool())
+	assert.InDelta(t, 12.5, devices["net2"].RateLimit.ValueFloat64(), 0.001)
+
+	var trunks []int64
+
+	require.False(t, devices["net2"].Trunks.ElementsAs(ctx, &trunks, false).HasError())
+	assert.ElementsMatch(t, []int64{20, 30, 40}, trunks)
+
+	var ci cloudinit.Model
+
+	require.False(t, m.Initialization.As(ctx, &ci, basetypes.ObjectAsOptions{}).HasError())
+	assert.Equal(t, "ide2", ci.Interface.ValueString())
+	assert.True(t, ci.Type.IsNull())
+	assert.Equal(t, "local:snippets/vendor.yaml", ci.VendorDataFileID.ValueString())
+
+	require.NotNil(t, ci.DNS)
+	assert.Equal(t, "example.com", ci.DNS.Domain.ValueString())
+	assert.Equal(t, []types.String{types.StringValue("1.1.1.1"), types.StringValue("8.8.8.8")}, ci.DNS.Servers)
+
+	require.Len(t, ci.IPConfig, 2)
+	require.NotNil(t, ci.IPConfig["net0"].IPv4)
+	assert.Equal(t, "192.168.1.10/24", ci.IPConfig["net0"].IPv4.Address.ValueString())
+	assert.Nil(t, ci.IPConfig["net0"].IPv6)
+	require.NotNil(t, ci.IPConfig["net2"].IPv6)
+	assert.Equal(t, "auto", ci.IPConfig["net2"].IPv6.Address.ValueString())
+	assert.True(t, ci.IPConfig["net2"].IPv6.Gateway.IsNull())
+
+	require.NotNil(t, ci.UserAccount)
+	assert.Equal(t, "ubuntu", ci.UserAccount.Username.ValueString())
+	assert.Equal(t, "s3cr3t", ci.UserAccount.Password.ValueString())
+	assert.Len(t, ci.UserAccount.Keys, 1)
+}
+
+func TestMoveStateFromSDKVMMinimal(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	resp := moveState(t, sdkMoveStateRequest([]byte(`{"vm_id": 100, "node_name": "pve", "disk": [], "tags": []}`)))
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var m Model
+
+	require.False(t, resp.TargetState.Get(ctx, &m).HasError())
+
+	assert.Equal(t, int64(100), m.ID.ValueInt64())
+	assert.True(t, m.Name.IsNull())
+	assert.True(t, m.Tags.IsNull())
+	assert.True(t, m.Disk.IsNull())
+	assert.True(t, m.NetworkDevices.IsNull())
+	assert.True(t, m.Initialization.IsNull())
+}
+
+func TestMoveStateFromSDKVMErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		req     resource.MoveStateRequest
+		wantErr bool
+	}{
+		"other resource type": {
+			req: resource.MoveStateRequest{
+				SourceTypeName:        "proxmox_virtual_environment_container",
+				SourceProviderAddress: "registry.terraform.io/bpg/proxmox",
+				SourceRawState:        &tfprotov6.RawState{JSON: []byte(`{"vm_id": 100, "node_name": "pve"}`)},
+			},
+		},
+		"other provider": {
+			req: resource.MoveStateRequest{
+				SourceTypeName:        sdkVMResourceType,
+				SourceProviderAddress: "registry.terraform.io/telmate/proxmox",
+				SourceRawState:        &tfprotov6.RawState{JSON: []byte(`{"vm_id": 100, "node_name": "pve"}`)},
+			},
+		},
+		"missing state": {
+			req: resource.MoveStateRequest{
+				SourceTypeName:        sdkVMResourceType,
+				SourceProviderAddress: "registry.terraform.io/bpg/proxmox",
+			},
+			wantErr: true,
+		},
+		"invalid state": {
+			req:     sdkMoveStateRequest([]byte(`{"vm_id": "abc"`)),
+			wantErr: true,
+		},
+		"missing vm id": {
+			req:     sdkMoveStateRequest([]byte(`{"node_name": "pve"}`)),
+			wantErr: true,
+		},
+		"invalid trunks": {
+			req: sdkMoveStateRequest([]byte(
+				`{"vm_id": 100, "node_name": "pve", "network_device": [{"enabled": true, "trunks": "10;x"}]}`,
+			)),
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := moveState(t, tt.req)
+
+			assert.Equal(t, tt.wantErr, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+			if !tt.wantErr {
+				assert.True(t, resp.TargetState.Raw.IsNull(), "target state must not be set")
+			}
+		})
+	}
+}
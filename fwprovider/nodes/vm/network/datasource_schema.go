@@ -0,0 +1,66 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package network
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DataSourceSchema defines the schema for the network device datasource.
+func DataSourceSchema() schema.Attribute {
+	return schema.MapNestedAttribute{
+		Description: "The network devices, keyed by the name of the device.",
+		Optional:    true,
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"bridge": schema.StringAttribute{
+					Description: "The name of the bridge the device is connected to.",
+					Computed:    true,
+				},
+				"disconnected": schema.BoolAttribute{
+					Description: "Whether the device is disconnected.",
+					Computed:    true,
+				},
+				"firewall": schema.BoolAttribute{
+					Description: "Whether the PVE firewall is enabled for the device.",
+					Computed:    true,
+				},
+				"mac_address": schema.StringAttribute{
+					Description: "The MAC address of the device.",
+					Computed:    true,
+				},
+				"model": schema.StringAttribute{
+					Description: "The model of the device.",
+					Computed:    true,
+				},
+				"mtu": schema.Int64Attribute{
+					Description: "The MTU of the device.",
+					Computed:    true,
+				},
+				"queues": schema.Int64Attribute{
+					Description: "The number of packet queues of the device.",
+					Computed:    true,
+				},
+				"rate_limit": schema.Float64Attribute{
+					Description: "The rate limit of the device in megabytes per second.",
+					Computed:    true,
+				},
+				"trunks": schema.SetAttribute{
+					Description: "The VLAN IDs allowed to pass through the device.",
+					ElementType: types.Int64Type,
+					Computed:    true,
+				},
+				"vlan_id": schema.Int64Attribute{
+					Description: "The VLAN ID of the device.",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
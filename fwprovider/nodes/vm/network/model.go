@@ -0,0 +1,132 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package network
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/attribute"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/vms"
+	proxmoxtypes "github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+// Model represents the network device model.
+type Model struct {
+	Bridge       types.String  `tfsdk:"bridge"`
+	Disconnected types.Bool    `tfsdk:"disconnected"`
+	Firewall     types.Bool    `tfsdk:"firewall"`
+	MACAddress   types.String  `tfsdk:"mac_address"`
+	Model        types.String  `tfsdk:"model"`
+	MTU          types.Int64   `tfsdk:"mtu"`
+	Queues       types.Int64   `tfsdk:"queues"`
+	RateLimit    types.Float64 `tfsdk:"rate_limit"`
+	Trunks       types.Set     `tfsdk:"trunks"`
+	VLANID       types.Int64   `tfsdk:"vlan_id"`
+}
+
+func attributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"bridge":       types.StringType,
+		"disconnected": types.BoolType,
+		"firewall":     types.BoolType,
+		"mac_address":  types.StringType,
+		"model":        types.StringType,
+		"mtu":          types.Int64Type,
+		"queues":       types.Int64Type,
+		"rate_limit":   types.Float64Type,
+		"trunks":       types.SetType{ElemType: types.Int64Type},
+		"vlan_id":      types.Int64Type,
+	}
+}
+
+func (m *Model) exportToCustomNetworkDevice(ctx context.Context, diags *diag.Diagnostics) vms.CustomNetworkDevice {
+	device := vms.CustomNetworkDevice{
+		Enabled:  true,
+		Bridge:   m.Bridge.ValueStringPointer(),
+		Firewall: proxmoxtypes.CustomBoolPtr(m.Firewall.ValueBoolPointer()),
+		LinkDown: proxmoxtypes.CustomBoolPtr(m.Disconnected.ValueBoolPointer()),
+		Model:    m.Model.ValueString(),
+		MTU:      intPtr(m.MTU),
+		Queues:   intPtr(m.Queues),
+		Tag:      intPtr(m.VLANID),
+	}
+
+	// the MAC address is generated by PVE if not set
+	if attribute.IsDefined(m.MACAddress) {
+		device.MACAddress = m.MACAddress.ValueStringPointer()
+	}
+
+	if attribute.IsDefined(m.RateLimit) {
+		device.RateLimit = m.RateLimit.ValueFloat64Pointer()
+	}
+
+	if attribute.IsDefined(m.Trunks) {
+		var trunks []int64
+
+		diags.Append(m.Trunks.ElementsAs(ctx, &trunks, false)...)
+
+		for _, t := range trunks {
+			device.Trunks = append(device.Trunks, int(t))
+		}
+	}
+
+	return device
+}
+
+func (m *Model) importFromCustomNetworkDevice(
+	ctx context.Context,
+	d vms.CustomNetworkDevice,
+	diags *diag.Diagnostics,
+) {
+	m.Bridge = types.StringPointerValue(d.Bridge)
+	m.Disconnected = types.BoolValue(d.LinkDown != nil && bool(*d.LinkDown))
+	m.Firewall = types.BoolValue(d.Firewall != nil && bool(*d.Firewall))
+	m.MACAddress = types.StringPointerValue(d.MACAddress)
+	m.Model = types.StringValue(d.Model)
+	m.MTU = int64Value(d.MTU)
+	m.Queues = int64Value(d.Queues)
+	m.RateLimit = types.Float64PointerValue(d.RateLimit)
+	m.VLANID = int64Value(d.Tag)
+
+	if len(d.Trunks) == 0 {
+		m.Trunks = types.SetNull(types.Int64Type)
+
+		return
+	}
+
+	trunks := make([]int64, len(d.Trunks))
+	for i, t := range d.Trunks {
+		trunks[i] = int64(t)
+	}
+
+	set, dd := types.SetValueFrom(ctx, types.Int64Type, trunks)
+	diags.Append(dd...)
+
+	m.Trunks = set
+}
+
+func intPtr(v types.Int64) *int {
+	if !attribute.IsDefined(v) {
+		return nil
+	}
+
+	i := int(v.ValueInt64())
+
+	return &i
+}
+
+func int64Value(i *int) types.Int64 {
+	if i == nil {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(int64(*i))
+}
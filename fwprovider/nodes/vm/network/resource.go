@@ -0,0 +1,153 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/vms"
+	"github.com/bpg/terraform-provider-proxmox/utils"
+)
+
+// maxDevices is the maximum number of network devices supported by PVE.
+const maxDevices = 32
+
+// Value represents the type for network device settings.
+type Value = types.Map
+
+// Devices returns the network devices from the PVE API response, keyed by the name of the device.
+func Devices(config *vms.GetResponseData) map[string]*vms.CustomNetworkDevice {
+	devices := map[string]*vms.CustomNetworkDevice{}
+
+	v := reflect.ValueOf(config).Elem()
+
+	for i := 0; i < maxDevices; i++ {
+		f := v.FieldByName(fmt.Sprintf("NetworkDevice%d", i))
+		if !f.IsValid() || f.IsNil() {
+			continue
+		}
+
+		devices[fmt.Sprintf("net%d", i)] = f.Interface().(*vms.CustomNetworkDevice)
+	}
+
+	return devices
+}
+
+// NewValue returns a new Value with the given network device settings from the PVE API.
+func NewValue(ctx context.Context, config *vms.GetResponseData, diags *diag.Diagnostics) Value {
+	devices := Devices(config)
+
+	elements := make(map[string]Model, len(devices))
+
+	for name, device := range devices {
+		m := Model{}
+		m.importFromCustomNetworkDevice(ctx, *device, diags)
+		elements[name] = m
+	}
+
+	obj, d := types.MapValueFrom(ctx, types.ObjectType{}.WithAttributeTypes(attributeTypes()), elements)
+	diags.Append(d...)
+
+	return obj
+}
+
+// deviceIndex returns the index of the device from its name, e.g. `3` for `net3`.
+func deviceIndex(name string) int {
+	i, err := strconv.Atoi(strings.TrimPrefix(name, "net"))
+	if err != nil {
+		// the name is validated by the schema
+		return -1
+	}
+
+	return i
+}
+
+// addDevices adds the devices to the list of network devices of the request body, at the index of their names.
+func addDevices(ctx context.Context, body *vms.CreateRequestBody, devices map[string]Model, diags *diag.Diagnostics) {
+	if len(devices) == 0 {
+		return
+	}
+
+	if body.NetworkDevices == nil {
+		body.NetworkDevices = make(vms.CustomNetworkDevices, maxDevices)
+	}
+
+	for name, device := range devices {
+		if i := deviceIndex(name); i >= 0 && i < maxDevices {
+			body.NetworkDevices[i] = device.exportToCustomNetworkDevice(ctx, diags)
+		}
+	}
+}
+
+// FillCreateBody fills the CreateRequestBody with the network device settings from the Value.
+//
+// In the 'create' context, v is the plan.
+func FillCreateBody(ctx context.Context, planValue Value, body *vms.CreateRequestBody, diags *diag.Diagnostics) {
+	if planValue.IsNull() || planValue.IsUnknown() {
+		return
+	}
+
+	var plan map[string]Model
+	d := planValue.ElementsAs(ctx, &plan, false)
+	diags.Append(d...)
+
+	if d.HasError() {
+		return
+	}
+
+	addDevices(ctx, body, plan, diags)
+}
+
+// FillUpdateBody fills the UpdateRequestBody with the network device settings from the Value.
+//
+// In the 'update' context, v is the plan and stateValue is the current state.
+func FillUpdateBody(
+	ctx context.Context,
+	planValue, stateValue Value,
+	updateBody *vms.UpdateRequestBody,
+	_ bool,
+	diags *diag.Diagnostics,
+) {
+	if planValue.IsNull() || planValue.IsUnknown() || planValue.Equal(stateValue) {
+		return
+	}
+
+	var plan, state map[string]Model
+	d := planValue.ElementsAs(ctx, &plan, false)
+	diags.Append(d...)
+	d = stateValue.ElementsAs(ctx, &state, false)
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return
+	}
+
+	toCreate, toUpdate, toDelete := utils.MapDiff(plan, state)
+
+	for name, device := range toUpdate {
+		// keep the MAC address generated by PVE, unless a new one is configured
+		if device.MACAddress.IsUnknown() {
+			device.MACAddress = state[name].MACAddress
+			toUpdate[name] = device
+		}
+	}
+
+	// as for CD-ROMs, the update fully overrides the existing device
+	addDevices(ctx, updateBody, toCreate, diags)
+	addDevices(ctx, updateBody, toUpdate, diags)
+
+	for name := range toDelete {
+		updateBody.Delete = append(updateBody.Delete, name)
+	}
+}
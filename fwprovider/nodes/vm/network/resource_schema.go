@@ -0,0 +1,139 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package network
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var macAddressRegex = regexp.MustCompile(`^[0-9A-Fa-f]{2}(:[0-9A-Fa-f]{2}){5}$`)
+
+// ResourceSchema defines the schema for the network device resource.
+func ResourceSchema() schema.Attribute {
+	return schema.MapNestedAttribute{
+		Description: "The network devices",
+		MarkdownDescription: "The network devices. The key is the name of the device, `netN`, " +
+			"where N is the index of the device between `0` and `31`.",
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.Map{
+			mapplanmodifier.UseStateForUnknown(),
+		},
+		Validators: []validator.Map{
+			mapvalidator.KeysAre(
+				stringvalidator.RegexMatches(
+					regexp.MustCompile(`^net([0-9]|[12][0-9]|3[01])$`),
+					"one of `net[0-31]`",
+				),
+			),
+		},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"bridge": schema.StringAttribute{
+					Description:         "The name of the bridge the device is connected to.",
+					MarkdownDescription: "The name of the bridge the device is connected to (defaults to `vmbr0`).",
+					Optional:            true,
+					Computed:            true,
+					Default:             stringdefault.StaticString("vmbr0"),
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				"disconnected": schema.BoolAttribute{
+					Description:         "Whether the device is disconnected.",
+					MarkdownDescription: "Whether the device is disconnected (defaults to `false`).",
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(false),
+				},
+				"firewall": schema.BoolAttribute{
+					Description:         "Whether the PVE firewall is enabled for the device.",
+					MarkdownDescription: "Whether the PVE firewall is enabled for the device (defaults to `false`).",
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(false),
+				},
+				"mac_address": schema.StringAttribute{
+					Description:         "The MAC address of the device.",
+					MarkdownDescription: "The MAC address of the device. If not set, PVE generates a random one.",
+					Optional:            true,
+					Computed:            true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+					Validators: []validator.String{
+						stringvalidator.RegexMatches(macAddressRegex, "must be a valid MAC address"),
+					},
+				},
+				"model": schema.StringAttribute{
+					Description: "The model of the device.",
+					MarkdownDescription: "The model of the device. Choice is between `virtio` | `e1000` | " +
+						"`e1000e` | `rtl8139` | `vmxnet3` (defaults to `virtio`).",
+					Optional: true,
+					Computed: true,
+					Default:  stringdefault.StaticString("virtio"),
+					Validators: []validator.String{
+						stringvalidator.OneOf("virtio", "e1000", "e1000e", "rtl8139", "vmxnet3"),
+					},
+				},
+				"mtu": schema.Int64Attribute{
+					Description: "The MTU of the device.",
+					MarkdownDescription: "The MTU of the device. Only supported by the `virtio` model. " +
+						"Use `1` to inherit the MTU of the bridge.",
+					Optional: true,
+					Validators: []validator.Int64{
+						int64validator.Between(1, 65520),
+					},
+				},
+				"queues": schema.Int64Attribute{
+					Description: "The number of packet queues of the device.",
+					Optional:    true,
+					Validators: []validator.Int64{
+						int64validator.Between(0, 64),
+					},
+				},
+				"rate_limit": schema.Float64Attribute{
+					Description: "The rate limit of the device in megabytes per second.",
+					Optional:    true,
+					Validators: []validator.Float64{
+						float64validator.AtLeast(0),
+					},
+				},
+				"trunks": schema.SetAttribute{
+					Description: "The VLAN IDs allowed to pass through the device.",
+					ElementType: types.Int64Type,
+					Optional:    true,
+					Validators: []validator.Set{
+						setvalidator.SizeAtLeast(1),
+						setvalidator.ValueInt64sAre(int64validator.Between(1, 4094)),
+					},
+				},
+				"vlan_id": schema.Int64Attribute{
+					Description: "The VLAN ID of the device.",
+					Optional:    true,
+					Validators: []validator.Int64{
+						int64validator.Between(1, 4094),
+					},
+				},
+			},
+		},
+	}
+}
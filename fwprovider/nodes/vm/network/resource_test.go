@@ -0,0 +1,105 @@
+//go:build acceptance || all
+
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package network_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/test"
+)
+
+func TestAccResourceVM2NetworkDevice(t *testing.T) {
+	t.Parallel()
+
+	te := test.InitEnvironment(t)
+
+	tests := []struct {
+		name  string
+		steps []resource.TestStep
+	}{
+		{"create VM with a default network device", []resource.TestStep{{
+			Config: te.RenderConfig(`
+			resource "proxmox_virtual_environment_vm2" "test_vm" {
+				node_name = "{{.NodeName}}"
+				name = "test-network"
+				network_device = {
+					"net0" = {}
+				}
+			}`),
+			Check: resource.ComposeTestCheckFunc(
+				test.ResourceAttributes("proxmox_virtual_environment_vm2.test_vm", map[string]string{
+					"network_device.net0.bridge":   "vmbr0",
+					"network_device.net0.model":    "virtio",
+					"network_device.net0.firewall": "false",
+				}),
+				test.ResourceAttributesSet("proxmox_virtual_environment_vm2.test_vm", []string{
+					"network_device.net0.mac_address",
+				}),
+			),
+		}}},
+		{"create VM with network devices and then update them", []resource.TestStep{
+			{
+				Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_vm2" "test_vm" {
+					node_name = "{{.NodeName}}"
+					name = "test-network"
+					network_device = {
+						"net0" = {
+							vlan_id = 10
+						}
+						"net2" = {
+							model    = "e1000"
+							firewall = true
+						}
+					}
+				}`),
+				Check: test.ResourceAttributes("proxmox_virtual_environment_vm2.test_vm", map[string]string{
+					"network_device.net0.vlan_id":  "10",
+					"network_device.net2.model":    "e1000",
+					"network_device.net2.firewall": "true",
+				}),
+			},
+			{
+				Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_vm2" "test_vm" {
+					node_name = "{{.NodeName}}"
+					name = "test-network"
+					network_device = {
+						"net0" = {
+							mtu = 1400
+						}
+					}
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					test.ResourceAttributes("proxmox_virtual_environment_vm2.test_vm", map[string]string{
+						"network_device.%":        "1",
+						"network_device.net0.mtu": "1400",
+					}),
+					test.NoResourceAttributesSet("proxmox_virtual_environment_vm2.test_vm", []string{
+						"network_device.net0.vlan_id",
+					}),
+				),
+			},
+			{
+				RefreshState: true,
+			},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource.ParallelTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: te.AccProviders,
+				Steps:                    tt.steps,
+			})
+		})
+	}
+}
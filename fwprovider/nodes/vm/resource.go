@@ -21,10 +21,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/attribute"
 	"github.com/bpg/terraform-provider-proxmox/fwprovider/config"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/agent"
 	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/cdrom"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/cloudinit"
 	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/cpu"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/disk"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/efidisk"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/memory"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/network"
 	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/rng"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/tpmstate"
 	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/vga"
 	"github.com/bpg/terraform-provider-proxmox/proxmox"
 	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
@@ -157,9 +165,16 @@ func (r *Resource) create(ctx context.Context, plan Model, diags *diag.Diagnosti
 	}
 
 	// fill out create body fields with values from other resource blocks
+	agent.FillCreateBody(ctx, plan.Agent, createBody, diags)
 	cdrom.FillCreateBody(ctx, plan.CDROM, createBody, diags)
+	cloudinit.FillCreateBody(ctx, plan.Initialization, createBody, diags)
 	cpu.FillCreateBody(ctx, plan.CPU, createBody, diags)
+	disk.FillCreateBody(ctx, plan.Disk, createBody, diags)
+	efidisk.FillCreateBody(ctx, plan.EFIDisk, createBody, diags)
+	memory.FillCreateBody(ctx, plan.Memory, createBody, diags)
+	network.FillCreateBody(ctx, plan.NetworkDevices, createBody, diags)
 	rng.FillCreateBody(ctx, plan.RNG, createBody, diags)
+	tpmstate.FillCreateBody(ctx, plan.TPMState, createBody, diags)
 	vga.FillCreateBody(ctx, plan.VGA, createBody, diags)
 
 	if diags.HasError() {
@@ -172,7 +187,12 @@ func (r *Resource) create(ctx context.Context, plan Model, diags *diag.Diagnosti
 	err := vmAPI.CreateVM(ctx, createBody)
 	if err != nil {
 		diags.AddError("Failed to create VM", err.Error())
+		return
 	}
+
+	// imported disks have the size of their image, grow them to the configured size
+	vmAPI = r.client.Node(plan.NodeName.ValueString()).VM(int(plan.ID.ValueInt64()))
+	disk.ResizeImported(ctx, vmAPI, plan.Disk, diags)
 }
 
 func (r *Resource) clone(ctx context.Context, plan Model, diags *diag.Diagnostics) {
@@ -202,13 +222,16 @@ func (r *Resource) clone(ctx context.Context, plan Model, diags *diag.Diagnostic
 
 	// now load the clone's configuration into a temporary model and update what is needed comparing to the plan
 	clone := Model{
-		ID:          plan.ID,
-		CPU:         plan.CPU,
-		Name:        plan.Name,
-		Description: plan.Description,
-		NodeName:    plan.NodeName,
-		RNG:         plan.RNG,
-		VGA:         plan.VGA,
+		ID:             plan.ID,
+		Agent:          plan.Agent,
+		CPU:            plan.CPU,
+		Name:           plan.Name,
+		Description:    plan.Description,
+		Memory:         plan.Memory,
+		NetworkDevices: plan.NetworkDevices,
+		NodeName:       plan.NodeName,
+		RNG:            plan.RNG,
+		VGA:            plan.VGA,
 	}
 
 	read(ctx, r.client, &clone, diags)
@@ -338,11 +361,24 @@ func (r *Resource) update(ctx context.Context, plan, state Model, isClone bool,
 	}
 
 	// fill out update body fields with values from other resource blocks
+	agent.FillUpdateBody(ctx, plan.Agent, state.Agent, updateBody, isClone, diags)
 	cdrom.FillUpdateBody(ctx, plan.CDROM, state.CDROM, updateBody, isClone, diags)
 	cpu.FillUpdateBody(ctx, plan.CPU, state.CPU, updateBody, isClone, diags)
+	memory.FillUpdateBody(ctx, plan.Memory, state.Memory, updateBody, isClone, diags)
+	network.FillUpdateBody(ctx, plan.NetworkDevices, state.NetworkDevices, updateBody, isClone, diags)
 	rng.FillUpdateBody(ctx, plan.RNG, state.RNG, updateBody, isClone, diags)
 	vga.FillUpdateBody(ctx, plan.VGA, state.VGA, updateBody, isClone, diags)
 
+	// disks are moved and resized by separate API calls before the VM configuration is updated
+	cloudinit.Update(ctx, vmAPI, plan.Initialization, state.Initialization, updateBody, diags)
+	disk.Update(ctx, vmAPI, plan.Disk, state.Disk, updateBody, isClone, diags)
+	efidisk.Update(ctx, vmAPI, plan.EFIDisk, state.EFIDisk, updateBody, diags)
+	tpmstate.Update(ctx, vmAPI, plan.TPMState, state.TPMState, updateBody, diags)
+
+	if diags.HasError() {
+		return
+	}
+
 	if !updateBody.IsEmpty() {
 		updateBody.VMID = int(plan.ID.ValueInt64())
 
@@ -352,6 +388,15 @@ func (r *Resource) update(ctx context.Context, plan, state Model, isClone bool,
 			return
 		}
 	}
+
+	disk.ResizeImported(ctx, vmAPI, plan.Disk, diags)
+
+	// the cloud-init drive is regenerated on VM start only, so rebuild it to apply the changes right away
+	if attribute.IsDefined(plan.Initialization) && !plan.Initialization.Equal(state.Initialization) {
+		if err := vmAPI.RebuildCloudInitDisk(ctx); err != nil {
+			diags.AddError("Failed to rebuild cloud-init drive", err.Error())
+		}
+	}
 }
 
 // Delete deletes the VM.
@@ -22,9 +22,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/agent"
 	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/cdrom"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/cloudinit"
 	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/cpu"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/disk"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/efidisk"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/memory"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/network"
 	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/rng"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/tpmstate"
 	"github.com/bpg/terraform-provider-proxmox/fwprovider/nodes/vm/vga"
 	"github.com/bpg/terraform-provider-proxmox/fwprovider/types/stringset"
 )
@@ -38,9 +45,11 @@ func (r *Resource) Schema(
 	resp.Schema = schema.Schema{
 		Description: "This is an experimental implementation of a Proxmox VM resource using Plugin Framework.",
 		MarkdownDescription: "This is an experimental implementation of a Proxmox VM resource using Plugin Framework." +
-			"<br><br>It is a Proof of Concept, highly experimental and **will** change in future. " +
-			"It does not support all features of the Proxmox API for VMs and **MUST NOT** be used in production.",
+			"<br><br>It is still experimental and may change in future. It supports the disks, network devices, " +
+			"memory, EFI disk, TPM state, cloud-init and QEMU guest agent settings of " +
+			"`proxmox_virtual_environment_vm`, but not all features of the Proxmox API for VMs yet.",
 		Attributes: map[string]schema.Attribute{
+			"agent": agent.ResourceSchema(),
 			"clone": schema.SingleNestedAttribute{
 				Description: "The cloning configuration.",
 				Optional:    true,
@@ -66,6 +75,8 @@ func (r *Resource) Schema(
 				Description: "The description of the VM.",
 				Optional:    true,
 			},
+			"disk":     disk.ResourceSchema(),
+			"efi_disk": efidisk.ResourceSchema(),
 			"id": schema.Int64Attribute{
 				Computed: true,
 				Optional: true,
@@ -75,6 +86,8 @@ func (r *Resource) Schema(
 				},
 				Description: "The unique identifier of the VM in the Proxmox cluster.",
 			},
+			"initialization": cloudinit.ResourceSchema(),
+			"memory":         memory.ResourceSchema(),
 			"name": schema.StringAttribute{
 				Description:         "The name of the VM.",
 				MarkdownDescription: "The name of the VM. Doesn't have to be unique.",
@@ -86,6 +99,7 @@ func (r *Resource) Schema(
 					),
 				},
 			},
+			"network_device": network.ResourceSchema(),
 			"node_name": schema.StringAttribute{
 				Description: "The name of the node where the VM is provisioned.",
 				Required:    true,
@@ -112,7 +126,8 @@ func (r *Resource) Schema(
 				Update: true,
 				Delete: true,
 			}),
-			"vga": vga.ResourceSchema(),
+			"tpm_state": tpmstate.ResourceSchema(),
+			"vga":       vga.ResourceSchema(),
 		},
 	}
 }
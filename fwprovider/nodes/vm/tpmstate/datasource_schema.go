@@ -0,0 +1,33 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package tpmstate
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// DataSourceSchema defines the schema for the TPM state datasource.
+func DataSourceSchema() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		CustomType: basetypes.ObjectType{
+			AttrTypes: attributeTypes(),
+		},
+		Description: "The TPM state device.",
+		Computed:    true,
+		Attributes: map[string]schema.Attribute{
+			"datastore_id": schema.StringAttribute{
+				Description: "The identifier of the datastore the TPM state is stored on.",
+				Computed:    true,
+			},
+			"version": schema.StringAttribute{
+				Description: "The TPM version.",
+				Computed:    true,
+			},
+		},
+	}
+}
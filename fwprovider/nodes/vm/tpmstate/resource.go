@@ -0,0 +1,127 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package tpmstate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/attribute"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/vms"
+	proxmoxtypes "github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+// Value represents the type for TPM state settings.
+type Value = types.Object
+
+// NewValue returns a new Value with the given TPM state settings from the PVE API.
+func NewValue(ctx context.Context, config *vms.GetResponseData, diags *diag.Diagnostics) Value {
+	if config.TPMState == nil {
+		return types.ObjectNull(attributeTypes())
+	}
+
+	datastoreID, _, _ := strings.Cut(config.TPMState.FileVolume, ":")
+
+	tpmState := Model{
+		DatastoreID: types.StringValue(datastoreID),
+		Version:     types.StringValue("v2.0"),
+	}
+
+	if config.TPMState.Version != nil {
+		tpmState.Version = types.StringValue(*config.TPMState.Version)
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, attributeTypes(), tpmState)
+	diags.Append(d...)
+
+	return obj
+}
+
+// exportToCustomTPMState creates a new TPM state allocated on the datastore.
+func (m *Model) exportToCustomTPMState() *vms.CustomTPMState {
+	tpmState := &vms.CustomTPMState{
+		// the size is ignored by PVE
+		FileVolume: fmt.Sprintf("%s:1", m.DatastoreID.ValueString()),
+	}
+
+	if attribute.IsDefined(m.Version) {
+		tpmState.Version = m.Version.ValueStringPointer()
+	}
+
+	return tpmState
+}
+
+// FillCreateBody fills the CreateRequestBody with the TPM state settings from the Value.
+//
+// In the 'create' context, v is the plan.
+func FillCreateBody(ctx context.Context, planValue Value, body *vms.CreateRequestBody, diags *diag.Diagnostics) {
+	var plan Model
+
+	if planValue.IsNull() || planValue.IsUnknown() {
+		return
+	}
+
+	d := planValue.As(ctx, &plan, basetypes.ObjectAsOptions{})
+	diags.Append(d...)
+
+	if d.HasError() {
+		return
+	}
+
+	body.TPMState = plan.exportToCustomTPMState()
+}
+
+// Update moves the TPM state to another datastore, or fills the UpdateRequestBody with a new TPM state if the VM
+// does not have one yet.
+//
+// In the 'update' context, v is the plan and stateValue is the current state.
+func Update(
+	ctx context.Context,
+	vmAPI *vms.Client,
+	planValue, stateValue Value,
+	updateBody *vms.UpdateRequestBody,
+	diags *diag.Diagnostics,
+) {
+	var plan, state Model
+
+	if planValue.IsNull() || planValue.IsUnknown() || planValue.Equal(stateValue) {
+		return
+	}
+
+	d := planValue.As(ctx, &plan, basetypes.ObjectAsOptions{})
+	diags.Append(d...)
+
+	if d.HasError() {
+		return
+	}
+
+	if stateValue.IsNull() || stateValue.IsUnknown() {
+		updateBody.TPMState = plan.exportToCustomTPMState()
+		return
+	}
+
+	d = stateValue.As(ctx, &state, basetypes.ObjectAsOptions{})
+	diags.Append(d...)
+
+	if d.HasError() || plan.DatastoreID.Equal(state.DatastoreID) {
+		return
+	}
+
+	err := vmAPI.MoveVMDisk(ctx, &vms.MoveDiskRequestBody{
+		DeleteOriginalDisk: proxmoxtypes.CustomBool(true).Pointer(),
+		Disk:               "tpmstate0",
+		TargetStorage:      plan.DatastoreID.ValueString(),
+	})
+	if err != nil {
+		diags.AddError("Failed to move TPM state", err.Error())
+	}
+}
@@ -0,0 +1,330 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package vzdump
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/attribute"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/config"
+	"github.com/bpg/terraform-provider-proxmox/proxmox"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes"
+	proxmoxtypes "github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+const (
+	defaultCreateTimeout = 60 * time.Minute
+	defaultDeleteTimeout = 10 * time.Minute
+)
+
+var (
+	_ resource.Resource              = &backupResource{}
+	_ resource.ResourceWithConfigure = &backupResource{}
+)
+
+type backupModel struct {
+	ID            types.String   `tfsdk:"id"`
+	NodeName      types.String   `tfsdk:"node_name"`
+	VMID          types.Int64    `tfsdk:"vm_id"`
+	Storage       types.String   `tfsdk:"storage"`
+	Mode          types.String   `tfsdk:"mode"`
+	Compress      types.String   `tfsdk:"compress"`
+	NotesTemplate types.String   `tfsdk:"notes_template"`
+	Protected     types.Bool     `tfsdk:"protected"`
+	VolumeID      types.String   `tfsdk:"volume_id"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+type backupResource struct {
+	client proxmox.Client
+}
+
+// NewBackupResource creates a new resource for creating on-demand backups of VMs and containers.
+func NewBackupResource() resource.Resource {
+	return &backupResource{}
+}
+
+func (r *backupResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_backup"
+}
+
+// Schema defines the schema for the resource.
+func (r *backupResource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Creates an on-demand backup of a VM or a container.",
+		MarkdownDescription: "Creates an on-demand backup of a VM or a container using `vzdump`.\n\n" +
+			"The backup is taken once, when the resource is created. Destroying the resource removes the " +
+			"backup archive from the storage.",
+		Attributes: map[string]schema.Attribute{
+			"id": attribute.ResourceID(),
+			"node_name": schema.StringAttribute{
+				Description: "The name of the node the guest is located on.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vm_id": schema.Int64Attribute{
+				Description: "The ID of the VM or container to back up.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(100),
+				},
+			},
+			"storage": schema.StringAttribute{
+				Description: "The identifier of the storage to store the backup on.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mode": schema.StringAttribute{
+				Description: "The backup mode.",
+				MarkdownDescription: "The backup mode. Choice is between `snapshot` | `suspend` | `stop`. " +
+					"If not set, PVE default is `snapshot`.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("snapshot", "suspend", "stop"),
+				},
+			},
+			"compress": schema.StringAttribute{
+				Description: "The compression algorithm.",
+				MarkdownDescription: "The compression algorithm. Choice is between `0` | `1` | `gzip` | `lzo` | " +
+					"`zstd`. If not set, PVE default is `0` (no compression).",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("0", "1", "gzip", "lzo", "zstd"),
+				},
+			},
+			"notes_template": schema.StringAttribute{
+				Description: "The template for the notes attached to the backup.",
+				MarkdownDescription: "The template for the notes attached to the backup. Can contain " +
+					"variables like `{{guestname}}`, `{{node}}` and `{{vmid}}`.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"protected": schema.BoolAttribute{
+				Description: "Whether to mark the backup as protected.",
+				MarkdownDescription: "Whether to mark the backup as protected (defaults to `false`). " +
+					"A protected backup cannot be removed, so destroying the resource fails.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"volume_id": schema.StringAttribute{
+				Description: "The volume ID of the backup archive.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *backupResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(config.Resource)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected config.Resource, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = cfg.Client
+}
+
+// read checks that the backup archive still exists. Returns false if it does not.
+func (r *backupResource) read(ctx context.Context, model *backupModel, diags *diag.Diagnostics) bool {
+	files, err := r.client.Node(model.NodeName.ValueString()).
+		Storage(model.Storage.ValueString()).
+		ListDatastoreFiles(ctx)
+	if err != nil {
+		if errors.Is(err, api.ErrResourceDoesNotExist) {
+			return false
+		}
+
+		diags.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return false
+	}
+
+	for _, file := range files {
+		if file.VolumeID == model.VolumeID.ValueString() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *backupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan backupModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, d := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(d...)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	body := &nodes.VzdumpRequestBody{
+		VMID:          int(plan.VMID.ValueInt64()),
+		Storage:       plan.Storage.ValueString(),
+		Mode:          plan.Mode.ValueStringPointer(),
+		Compress:      plan.Compress.ValueStringPointer(),
+		NotesTemplate: plan.NotesTemplate.ValueStringPointer(),
+		Protected:     proxmoxtypes.CustomBool(plan.Protected.ValueBool()).Pointer(),
+	}
+
+	volumeID, err := r.client.Node(plan.NodeName.ValueString()).Vzdump(ctx, body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while creating the backup.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	plan.ID = types.StringValue(volumeID)
+	plan.VolumeID = types.StringValue(volumeID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *backupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state backupModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.read(ctx, &state, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.State.RemoveResource(ctx)
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called, all attributes require replacement.
+func (r *backupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan backupModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *backupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state backupModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, d := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(d...)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err := r.client.Node(state.NodeName.ValueString()).
+		Storage(state.Storage.ValueString()).
+		DeleteDatastoreFile(ctx, state.VolumeID.ValueString())
+	if err != nil {
+		if errors.Is(err, api.ErrResourceDoesNotExist) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while deleting the backup.\n\n"+
+				"Error: "+err.Error(),
+		)
+	}
+}
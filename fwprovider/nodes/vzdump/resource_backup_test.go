@@ -0,0 +1,78 @@
+//go:build acceptance || all
+
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package vzdump_test
+
+import (
+	"math/rand"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/test"
+)
+
+func TestAccResourceBackup(t *testing.T) {
+	te := test.InitEnvironment(t)
+
+	te.AddTemplateVars(map[string]any{
+		"TestVMID":         100000 + rand.Intn(99999),
+		"TestVMIDRestored": 100000 + rand.Intn(99999),
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: te.AccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_vm" "test_vm" {
+					node_name = "{{.NodeName}}"
+					vm_id     = {{.TestVMID}}
+					name      = "acc-backup-source"
+					started   = false
+				}
+
+				resource "proxmox_virtual_environment_backup" "test_backup" {
+					node_name = "{{.NodeName}}"
+					vm_id     = proxmox_virtual_environment_vm.test_vm.vm_id
+					storage   = "local"
+					mode      = "stop"
+					compress  = "zstd"
+				}
+
+				resource "proxmox_virtual_environment_vm" "test_vm_restored" {
+					node_name = "{{.NodeName}}"
+					vm_id     = {{.TestVMIDRestored}}
+					started   = false
+
+					restore {
+						archive = proxmox_virtual_environment_backup.test_backup.volume_id
+						unique  = true
+					}
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					test.ResourceAttributes("proxmox_virtual_environment_backup.test_backup", map[string]string{
+						"storage":   "local",
+						"protected": "false",
+					}),
+					resource.TestMatchResourceAttr(
+						"proxmox_virtual_environment_backup.test_backup",
+						"volume_id",
+						regexp.MustCompile(`^local:backup/vzdump-qemu-\d+-.+\.vma\.zst$`),
+					),
+					test.ResourceAttributes("proxmox_virtual_environment_vm.test_vm_restored", map[string]string{
+						"name":             "acc-backup-source",
+						"restore.0.unique": "true",
+						"restore.0.force":  "false",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,183 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package fwprovider_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/config"
+)
+
+// providerConfig returns the schema of the provider, and its configuration with the given attributes, the other ones
+// are null.
+func providerConfig(t *testing.T, attrs map[string]tftypes.Value) (schema.Schema, tftypes.Value) {
+	t.Helper()
+
+	ctx := t.Context()
+
+	schemaResp := &provider.SchemaResponse{}
+	fwprovider.New("test")().Schema(ctx, provider.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError(), schemaResp.Diagnostics)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	values := map[string]tftypes.Value{}
+	for name, typ := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(typ, nil)
+	}
+
+	for name, v := range attrs {
+		values[name] = v
+	}
+
+	return schemaResp.Schema, tftypes.NewValue(objectType, values)
+}
+
+// configureProvider configures the provider with the given attributes, the other ones are null.
+func configureProvider(t *testing.T, attrs map[string]tftypes.Value) *provider.ConfigureResponse {
+	t.Helper()
+
+	s, config := providerConfig(t, attrs)
+
+	resp := &provider.ConfigureResponse{}
+	fwprovider.New("test")().Configure(t.Context(), provider.ConfigureRequest{
+		Config: tfsdk.Config{Schema: s, Raw: config},
+	}, resp)
+
+	return resp
+}
+
+// validateProvider validates the provider configuration with the given attributes, the other ones are null,
+// and returns the error summaries.
+func validateProvider(t *testing.T, attrs map[string]tftypes.Value) []string {
+	t.Helper()
+
+	ctx := t.Context()
+	s, config := providerConfig(t, attrs)
+
+	dv, err := tfprotov6.NewDynamicValue(s.Type().TerraformType(ctx), config)
+	require.NoError(t, err)
+
+	resp, err := providerserver.NewProtocol6(fwprovider.New("test")())().ValidateProviderConfig(ctx,
+		&tfprotov6.ValidateProviderConfigRequest{Config: &dv})
+	require.NoError(t, err)
+
+	var errs []string
+
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			errs = append(errs, d.Summary)
+		}
+	}
+
+	return errs
+}
+
+// TestProviderRetryStatusCodes tests that an empty `retry_status_codes` list disables the retries, while an omitted
+// one keeps the default status codes.
+func TestProviderRetryStatusCodes(t *testing.T) {
+	t.Parallel()
+
+	statusCodes := func(codes ...int) tftypes.Value {
+		values := make([]tftypes.Value, len(codes))
+		for i, c := range codes {
+			values[i] = tftypes.NewValue(tftypes.Number, c)
+		}
+
+		return tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, values)
+	}
+
+	tests := []struct {
+		name        string
+		statusCodes tftypes.Value
+		wantCalls   int32
+	}{
+		{"default", tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, nil), 3},
+		{"empty", statusCodes(), 1},
+		{"other", statusCodes(502), 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls atomic.Int32
+
+			s := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				calls.Add(1)
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}))
+			t.Cleanup(s.Close)
+
+			resp := configureProvider(t, map[string]tftypes.Value{
+				"endpoint":           tftypes.NewValue(tftypes.String, s.URL),
+				"insecure":           tftypes.NewValue(tftypes.Bool, true),
+				"api_token":          tftypes.NewValue(tftypes.String, "root@pam!test=00000000-0000-0000-0000-000000000000"),
+				"retry_max_attempts": tftypes.NewValue(tftypes.Number, 3),
+				"retry_backoff":      tftypes.NewValue(tftypes.String, "1ms"),
+				"retry_status_codes": tt.statusCodes,
+			})
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+			err := resp.ResourceData.(config.Resource).Client.API().DoRequest(t.Context(), http.MethodGet, "version",
+				nil, nil)
+			require.Error(t, err)
+			assert.Equal(t, tt.wantCalls, calls.Load())
+		})
+	}
+}
+
+// TestProviderTLSConflicts tests that the `ssl_fingerprint` cannot be used with the `ca_certificate`, or with the
+// `endpoints` to fail over to.
+func TestProviderTLSConflicts(t *testing.T) {
+	t.Parallel()
+
+	caCertificate := tftypes.NewValue(tftypes.String, "-----BEGIN CERTIFICATE-----")
+	fingerprint := tftypes.NewValue(tftypes.String, strings.Repeat("AB:", 31)+"AB")
+	endpoints := tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "https://pve2.example.com:8006/"),
+	})
+
+	tests := []struct {
+		name    string
+		attrs   map[string]tftypes.Value
+		wantErr bool
+	}{
+		{"ca certificate", map[string]tftypes.Value{"ca_certificate": caCertificate}, false},
+		{"fingerprint", map[string]tftypes.Value{"ssl_fingerprint": fingerprint}, false},
+		{"both", map[string]tftypes.Value{"ca_certificate": caCertificate, "ssl_fingerprint": fingerprint}, true},
+		{"endpoints", map[string]tftypes.Value{"endpoints": endpoints}, false},
+		{"fingerprint and endpoints", map[string]tftypes.Value{"endpoints": endpoints, "ssl_fingerprint": fingerprint}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			errs := validateProvider(t, tt.attrs)
+			if tt.wantErr {
+				assert.Contains(t, errs, "Invalid Attribute Combination")
+			} else {
+				assert.Empty(t, errs)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package storage
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/types/stringset"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/storage"
+	proxmoxtypes "github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+// storageModel is implemented by the models of all storage types.
+type storageModel interface {
+	// base returns the attributes shared by all storage types.
+	base() *storageModelBase
+	// fixedFields returns the type specific fields that can only be set on creation.
+	fixedFields() storage.DatastoreFixedFields
+	// mutableFields returns the type specific fields that can be changed after creation.
+	mutableFields(ctx context.Context, diags *diag.Diagnostics) storage.DatastoreMutableFields
+	// writeOnlyFields sets the write-only fields of the configuration, they are never stored in the state.
+	writeOnlyFields(fields *storage.DatastoreMutableFields)
+	// importFromAPI sets the type specific attributes from the API response.
+	importFromAPI(ctx context.Context, data *storage.DatastoreGetResponseData, diags *diag.Diagnostics)
+}
+
+// storageModelBase contains the attributes shared by all storage types.
+type storageModelBase struct {
+	ID      types.String    `tfsdk:"id"`
+	Nodes   stringset.Value `tfsdk:"nodes"`
+	Content stringset.Value `tfsdk:"content"`
+	Disable types.Bool      `tfsdk:"disable"`
+}
+
+func (m *storageModelBase) base() *storageModelBase {
+	return m
+}
+
+func (m *storageModelBase) writeOnlyFields(_ *storage.DatastoreMutableFields) {}
+
+func (m *storageModelBase) importBaseFromAPI(data *storage.DatastoreGetResponseData, diags *diag.Diagnostics) {
+	m.ID = types.StringPointerValue(data.Storage)
+	m.Nodes = stringset.NewValueString(data.Nodes, diags, stringset.WithSeparator(","))
+	m.Content = stringset.NewValueList(data.Content, diags)
+	m.Disable = types.BoolValue(data.Disable != nil && bool(*data.Disable))
+}
+
+type pruneBackupsModel struct {
+	KeepAll     types.Bool  `tfsdk:"keep_all"`
+	KeepLast    types.Int64 `tfsdk:"keep_last"`
+	KeepHourly  types.Int64 `tfsdk:"keep_hourly"`
+	KeepDaily   types.Int64 `tfsdk:"keep_daily"`
+	KeepWeekly  types.Int64 `tfsdk:"keep_weekly"`
+	KeepMonthly types.Int64 `tfsdk:"keep_monthly"`
+	KeepYearly  types.Int64 `tfsdk:"keep_yearly"`
+}
+
+func (m *pruneBackupsModel) toAPI() *proxmoxtypes.CustomPruneBackups {
+	if m == nil {
+		return nil
+	}
+
+	return &proxmoxtypes.CustomPruneBackups{
+		KeepAll:     proxmoxtypes.CustomBoolPtr(m.KeepAll.ValueBoolPointer()),
+		KeepLast:    m.KeepLast.ValueInt64Pointer(),
+		KeepHourly:  m.KeepHourly.ValueInt64Pointer(),
+		KeepDaily:   m.KeepDaily.ValueInt64Pointer(),
+		KeepWeekly:  m.KeepWeekly.ValueInt64Pointer(),
+		KeepMonthly: m.KeepMonthly.ValueInt64Pointer(),
+		KeepYearly:  m.KeepYearly.ValueInt64Pointer(),
+	}
+}
+
+func pruneBackupsFromAPI(data *proxmoxtypes.CustomPruneBackups) *pruneBackupsModel {
+	if data == nil {
+		return nil
+	}
+
+	return &pruneBackupsModel{
+		KeepAll:     types.BoolPointerValue(data.KeepAll.PointerBool()),
+		KeepLast:    types.Int64PointerValue(data.KeepLast),
+		KeepHourly:  types.Int64PointerValue(data.KeepHourly),
+		KeepDaily:   types.Int64PointerValue(data.KeepDaily),
+		KeepWeekly:  types.Int64PointerValue(data.KeepWeekly),
+		KeepMonthly: types.Int64PointerValue(data.KeepMonthly),
+		KeepYearly:  types.Int64PointerValue(data.KeepYearly),
+	}
+}
+
+// deletedFields returns the API fields that are set in the current state but not in the plan.
+func deletedFields(plan, state *storage.DatastoreMutableFields) []string {
+	var toDelete []string
+
+	for _, f := range []struct {
+		name      string
+		inPlan    bool
+		inCurrent bool
+	}{
+		{"blocksize", plan.BlockSize != nil, state.BlockSize != nil},
+		{"domain", plan.Domain != nil, state.Domain != nil},
+		{"fingerprint", plan.Fingerprint != nil, state.Fingerprint != nil},
+		{"namespace", plan.Namespace != nil, state.Namespace != nil},
+		{"nodes", plan.Nodes != nil, state.Nodes != nil},
+		{"options", plan.Options != nil, state.Options != nil},
+		{"port", plan.Port != nil, state.Port != nil},
+		{"preallocation", plan.Preallocation != nil, state.Preallocation != nil},
+		{"prune-backups", plan.PruneBackups != nil, state.PruneBackups != nil},
+		{"saferemove", plan.SafeRemove != nil, state.SafeRemove != nil},
+		{"smbversion", plan.SMBVersion != nil, state.SMBVersion != nil},
+		{"sparse", plan.Sparse != nil, state.Sparse != nil},
+		{"subdir", plan.Subdir != nil, state.Subdir != nil},
+		{"username", plan.Username != nil, state.Username != nil},
+	} {
+		if !f.inPlan && f.inCurrent {
+			toDelete = append(toDelete, f.name)
+		}
+	}
+
+	return toDelete
+}
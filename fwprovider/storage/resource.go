@@ -0,0 +1,439 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/config"
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/types/stringset"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/storage"
+	proxmoxtypes "github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+var storageIDRegex = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9\-_.]*[a-zA-Z0-9]$`)
+
+var (
+	_ resource.Resource                = &storageResource{}
+	_ resource.ResourceWithConfigure   = &storageResource{}
+	_ resource.ResourceWithImportState = &storageResource{}
+)
+
+// storageResource implements the resources of all storage types, the type specific
+// behaviour is provided by the storageModel implementations.
+type storageResource struct {
+	client *storage.Client
+
+	// storageType is the storage type used by the PVE API, e.g. `dir` or `nfs`.
+	storageType string
+	// typeNameSuffix is appended to the provider type name to form the resource type name.
+	typeNameSuffix string
+	// description is the Markdown description of the resource.
+	description string
+	// contentTypes are the content types supported by the storage type.
+	contentTypes []string
+	// attributes are the type specific schema attributes.
+	attributes map[string]schema.Attribute
+	// newModel returns an empty model of the storage type.
+	newModel func() storageModel
+}
+
+func (r *storageResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + r.typeNameSuffix
+}
+
+// Schema defines the schema for the resource.
+func (r *storageResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	contentDescription := "The content types that can be stored on this storage. Choice is between `" +
+		strings.Join(r.contentTypes, "` | `") + "`. If not set, PVE default for the storage type is used."
+	if len(r.contentTypes) == 1 {
+		contentDescription = "The content types that can be stored on this storage. Only `" +
+			r.contentTypes[0] + "` is supported."
+	}
+
+	contentAttribute := stringset.ResourceAttribute(
+		"The content types that can be stored on this storage.",
+		contentDescription,
+	)
+	contentAttribute.Validators = append(contentAttribute.Validators,
+		setvalidator.ValueStringsAre(stringvalidator.OneOf(r.contentTypes...)),
+	)
+
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The unique identifier of the storage.",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(storageIDRegex, "must start with a letter, end with a letter or "+
+					"a digit, and contain only letters, digits, '-', '_' and '.'"),
+			},
+		},
+		"nodes": stringset.ResourceAttribute(
+			"The nodes the storage is available on.",
+			"The nodes the storage is available on. If not set, the storage is available on all nodes.",
+		),
+		"content": contentAttribute,
+		"disable": schema.BoolAttribute{
+			Description:         "Whether the storage is disabled.",
+			MarkdownDescription: "Whether the storage is disabled (defaults to `false`).",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+	}
+
+	maps.Copy(attributes, r.attributes)
+
+	resp.Schema = schema.Schema{
+		Description:         r.description,
+		MarkdownDescription: r.description,
+		Attributes:          attributes,
+	}
+}
+
+func (r *storageResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(config.Resource)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected config.Resource, got: %T", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = cfg.Client.Storage()
+}
+
+// mutableFields returns all fields of the model that can be changed after creation.
+func (r *storageResource) mutableFields(
+	ctx context.Context,
+	model storageModel,
+	diags *diag.Diagnostics,
+) storage.DatastoreMutableFields {
+	fields := model.mutableFields(ctx, diags)
+	fields.Nodes = model.base().Nodes.ValueStringPointer(ctx, diags, stringset.WithSeparator(","))
+	fields.Disable = proxmoxtypes.CustomBool(model.base().Disable.ValueBool()).Pointer()
+
+	return fields
+}
+
+// read refreshes the model from the API. Returns false if the storage does not exist.
+func (r *storageResource) read(ctx context.Context, model storageModel, diags *diag.Diagnostics) bool {
+	id := model.base().ID.ValueString()
+
+	data, err := r.client.GetDatastore(ctx, id)
+	if err != nil {
+		if errors.Is(err, api.ErrResourceDoesNotExist) {
+			return false
+		}
+
+		diags.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return false
+	}
+
+	if data == nil {
+		return false
+	}
+
+	if data.Type == nil || *data.Type != r.storageType {
+		diags.AddError(
+			"Unexpected Storage Type",
+			fmt.Sprintf("Storage %q is not of type %q.", id, r.storageType),
+		)
+
+		return false
+	}
+
+	model.base().importBaseFromAPI(data, diags)
+	model.importFromAPI(ctx, data, diags)
+
+	return true
+}
+
+func (r *storageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := r.newModel()
+	cfg := r.newModel()
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, cfg)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := &storage.DatastoreCreateRequestBody{
+		ID:                     plan.base().ID.ValueString(),
+		Type:                   r.storageType,
+		Content:                plan.base().Content.ValueStringPointer(ctx, &resp.Diagnostics, stringset.WithSeparator(",")),
+		DatastoreFixedFields:   plan.fixedFields(),
+		DatastoreMutableFields: r.mutableFields(ctx, plan, &resp.Diagnostics),
+	}
+
+	cfg.writeOnlyFields(&body.DatastoreMutableFields)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CreateDatastore(ctx, body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while creating the storage.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if !r.read(ctx, plan, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError(
+				"Unable to Create Resource",
+				fmt.Sprintf("Storage %q was not found after creation.", body.ID),
+			)
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *storageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	state := r.newModel()
+
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.read(ctx, state, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.State.RemoveResource(ctx)
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *storageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := r.newModel()
+	state := r.newModel()
+	cfg := r.newModel()
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, cfg)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planFields := r.mutableFields(ctx, plan, &resp.Diagnostics)
+	stateFields := r.mutableFields(ctx, state, &resp.Diagnostics)
+
+	body := &storage.DatastoreUpdateRequestBody{
+		Content:                plan.base().Content.ValueStringPointer(ctx, &resp.Diagnostics, stringset.WithSeparator(",")),
+		Delete:                 deletedFields(&planFields, &stateFields),
+		DatastoreMutableFields: planFields,
+	}
+
+	cfg.writeOnlyFields(&body.DatastoreMutableFields)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := plan.base().ID.ValueString()
+
+	err := r.client.UpdateDatastore(ctx, id, body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while updating the storage.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	if !r.read(ctx, plan, &resp.Diagnostics) {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError(
+				"Unable to Update Resource",
+				fmt.Sprintf("Storage %q no longer exists.", id),
+			)
+		}
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *storageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	state := r.newModel()
+
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDatastore(ctx, state.base().ID.ValueString())
+	if err != nil {
+		if errors.Is(err, api.ErrResourceDoesNotExist) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while deleting the storage.\n\n"+
+				"Error: "+err.Error(),
+		)
+	}
+}
+
+func (r *storageResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// fixedStringAttribute returns a required string attribute that forces a replacement when changed.
+func fixedStringAttribute(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: description,
+		Required:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+}
+
+// pruneBackupsAttribute returns the `prune_backups` attribute for storages that can hold backups.
+func pruneBackupsAttribute() schema.SingleNestedAttribute {
+	keepAttribute := func(period string) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			Description: fmt.Sprintf("Keep backups for the last N %s.", period),
+			Optional:    true,
+			Validators:  []validator.Int64{int64validator.AtLeast(1)},
+		}
+	}
+
+	return schema.SingleNestedAttribute{
+		Description: "The retention options of the backups stored on this storage.",
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"keep_all": schema.BoolAttribute{
+				Description: "Keep all backups. Conflicts with the other options when `true`.",
+				Optional:    true,
+			},
+			"keep_last":    keepAttribute("backups"),
+			"keep_hourly":  keepAttribute("hours"),
+			"keep_daily":   keepAttribute("days"),
+			"keep_weekly":  keepAttribute("weeks"),
+			"keep_monthly": keepAttribute("months"),
+			"keep_yearly":  keepAttribute("years"),
+		},
+	}
+}
+
+// stringValue returns a null value for empty API strings.
+func stringValue(s *string) types.String {
+	if s == nil || *s == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(*s)
+}
+
+//nolint:gochecknoglobals
+var (
+	// fileContentTypes are the content types supported by file based storages.
+	fileContentTypes = []string{"backup", "images", "import", "iso", "rootdir", "snippets", "vztmpl"}
+	// blockContentTypes are the content types supported by block based storages.
+	blockContentTypes = []string{"images", "rootdir"}
+)
+
+// sharedAttribute returns the `shared` attribute for storages that are local by default.
+func sharedAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: "Whether the storage is shared between all nodes.",
+		MarkdownDescription: "Whether the storage is shared between all nodes (defaults to `false`). " +
+			"PVE does not replicate the data, it only marks the same storage as available on all nodes.",
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+	}
+}
+
+// preallocationAttribute returns the `preallocation` attribute for file based storages.
+func preallocationAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "The preallocation mode for raw and qcow2 images. Choice is between `off` | `metadata` | " +
+			"`falloc` | `full`. If not set, PVE default is `metadata`.",
+		Optional: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("off", "metadata", "falloc", "full"),
+		},
+	}
+}
@@ -0,0 +1,119 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package storage
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/attribute"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/storage"
+)
+
+type cifsModel struct {
+	storageModelBase
+
+	Server          types.String       `tfsdk:"server"`
+	Share           types.String       `tfsdk:"share"`
+	Username        types.String       `tfsdk:"username"`
+	Password        types.String       `tfsdk:"password"`
+	PasswordVersion types.Int64        `tfsdk:"password_wo_version"`
+	Domain          types.String       `tfsdk:"domain"`
+	Subdirectory    types.String       `tfsdk:"subdirectory"`
+	SMBVersion      types.String       `tfsdk:"smb_version"`
+	Options         types.String       `tfsdk:"options"`
+	Preallocation   types.String       `tfsdk:"preallocation"`
+	PruneBackups    *pruneBackupsModel `tfsdk:"prune_backups"`
+}
+
+func (m *cifsModel) fixedFields() storage.DatastoreFixedFields {
+	return storage.DatastoreFixedFields{
+		Server: m.Server.ValueStringPointer(),
+		Share:  m.Share.ValueStringPointer(),
+	}
+}
+
+func (m *cifsModel) mutableFields(_ context.Context, _ *diag.Diagnostics) storage.DatastoreMutableFields {
+	return storage.DatastoreMutableFields{
+		Username:      m.Username.ValueStringPointer(),
+		Domain:        m.Domain.ValueStringPointer(),
+		Subdir:        m.Subdirectory.ValueStringPointer(),
+		SMBVersion:    m.SMBVersion.ValueStringPointer(),
+		Options:       m.Options.ValueStringPointer(),
+		Preallocation: m.Preallocation.ValueStringPointer(),
+		PruneBackups:  m.PruneBackups.toAPI(),
+	}
+}
+
+func (m *cifsModel) writeOnlyFields(fields *storage.DatastoreMutableFields) {
+	fields.Password = m.Password.ValueStringPointer()
+}
+
+func (m *cifsModel) importFromAPI(_ context.Context, data *storage.DatastoreGetResponseData, _ *diag.Diagnostics) {
+	m.Server = types.StringPointerValue(data.Server)
+	m.Share = types.StringPointerValue(data.Share)
+	m.Username = stringValue(data.Username)
+	m.Domain = stringValue(data.Domain)
+	m.Subdirectory = stringValue(data.Subdir)
+	m.SMBVersion = stringValue(data.SMBVersion)
+	m.Options = stringValue(data.Options)
+	m.Preallocation = stringValue(data.Preallocation)
+	m.PruneBackups = pruneBackupsFromAPI(data.PruneBackups)
+}
+
+// NewCIFSStorageResource creates a new resource for managing CIFS/SMB storages.
+func NewCIFSStorageResource() resource.Resource {
+	return &storageResource{
+		storageType:    "cifs",
+		typeNameSuffix: "_storage_cifs",
+		description:    "Manages a CIFS/SMB storage of a Proxmox VE cluster. CIFS storages are always shared.",
+		contentTypes:   fileContentTypes,
+		attributes: map[string]schema.Attribute{
+			"server": fixedStringAttribute("The address of the SMB server."),
+			"share":  fixedStringAttribute("The name of the SMB share."),
+			"username": schema.StringAttribute{
+				Description: "The user name used to access the share.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"password":            attribute.WriteOnlyString("password", "The password used to access the share.", false),
+			"password_wo_version": attribute.WriteOnlyVersion("password"),
+			"domain": schema.StringAttribute{
+				Description: "The SMB domain of the user.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"subdirectory": schema.StringAttribute{
+				Description: "The subdirectory of the share to mount.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"smb_version": schema.StringAttribute{
+				Description: "The SMB protocol version. Choice is between `default` | `2.0` | `2.1` | " +
+					"`3` | `3.0` | `3.11`. If not set, PVE default is `default` (negotiated).",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("default", "2.0", "2.1", "3", "3.0", "3.11"),
+				},
+			},
+			"options": schema.StringAttribute{
+				Description: "The CIFS mount options.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"preallocation": preallocationAttribute(),
+			"prune_backups": pruneBackupsAttribute(),
+		},
+		newModel: func() storageModel { return &cifsModel{} },
+	}
+}
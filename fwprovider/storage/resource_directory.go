@@ -0,0 +1,70 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package storage
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/storage"
+	proxmoxtypes "github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+type directoryModel struct {
+	storageModelBase
+
+	Path          types.String       `tfsdk:"path"`
+	Shared        types.Bool         `tfsdk:"shared"`
+	Preallocation types.String       `tfsdk:"preallocation"`
+	PruneBackups  *pruneBackupsModel `tfsdk:"prune_backups"`
+}
+
+func (m *directoryModel) fixedFields() storage.DatastoreFixedFields {
+	return storage.DatastoreFixedFields{
+		Path: m.Path.ValueStringPointer(),
+	}
+}
+
+func (m *directoryModel) mutableFields(_ context.Context, _ *diag.Diagnostics) storage.DatastoreMutableFields {
+	return storage.DatastoreMutableFields{
+		Shared:        proxmoxtypes.CustomBool(m.Shared.ValueBool()).Pointer(),
+		Preallocation: m.Preallocation.ValueStringPointer(),
+		PruneBackups:  m.PruneBackups.toAPI(),
+	}
+}
+
+func (m *directoryModel) importFromAPI(
+	_ context.Context,
+	data *storage.DatastoreGetResponseData,
+	_ *diag.Diagnostics,
+) {
+	m.Path = types.StringPointerValue(data.Path)
+	m.Shared = types.BoolValue(data.Shared != nil && bool(*data.Shared))
+	m.Preallocation = stringValue(data.Preallocation)
+	m.PruneBackups = pruneBackupsFromAPI(data.PruneBackups)
+}
+
+// NewDirectoryStorageResource creates a new resource for managing directory storages.
+func NewDirectoryStorageResource() resource.Resource {
+	return &storageResource{
+		storageType:    "dir",
+		typeNameSuffix: "_storage_directory",
+		description:    "Manages a directory storage of a Proxmox VE cluster.",
+		contentTypes:   fileContentTypes,
+		attributes: map[string]schema.Attribute{
+			"path":          fixedStringAttribute("The path to the directory on the node."),
+			"shared":        sharedAttribute(),
+			"preallocation": preallocationAttribute(),
+			"prune_backups": pruneBackupsAttribute(),
+		},
+		newModel: func() storageModel { return &directoryModel{} },
+	}
+}
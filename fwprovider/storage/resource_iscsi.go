@@ -0,0 +1,56 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package storage
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/storage"
+)
+
+type iscsiModel struct {
+	storageModelBase
+
+	Portal types.String `tfsdk:"portal"`
+	Target types.String `tfsdk:"target"`
+}
+
+func (m *iscsiModel) fixedFields() storage.DatastoreFixedFields {
+	return storage.DatastoreFixedFields{
+		Portal: m.Portal.ValueStringPointer(),
+		Target: m.Target.ValueStringPointer(),
+	}
+}
+
+func (m *iscsiModel) mutableFields(_ context.Context, _ *diag.Diagnostics) storage.DatastoreMutableFields {
+	return storage.DatastoreMutableFields{}
+}
+
+func (m *iscsiModel) importFromAPI(_ context.Context, data *storage.DatastoreGetResponseData, _ *diag.Diagnostics) {
+	m.Portal = types.StringPointerValue(data.Portal)
+	m.Target = types.StringPointerValue(data.Target)
+}
+
+// NewISCSIStorageResource creates a new resource for managing iSCSI storages.
+func NewISCSIStorageResource() resource.Resource {
+	return &storageResource{
+		storageType:    "iscsi",
+		typeNameSuffix: "_storage_iscsi",
+		description:    "Manages an iSCSI storage of a Proxmox VE cluster. iSCSI storages are always shared.",
+		contentTypes:   []string{"images", "none"},
+		attributes: map[string]schema.Attribute{
+			"portal": fixedStringAttribute("The address of the iSCSI portal."),
+			"target": fixedStringAttribute("The iSCSI target."),
+		},
+		newModel: func() storageModel { return &iscsiModel{} },
+	}
+}
@@ -0,0 +1,69 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package storage
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/storage"
+	proxmoxtypes "github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+type lvmModel struct {
+	storageModelBase
+
+	VolumeGroup        types.String `tfsdk:"volume_group"`
+	Shared             types.Bool   `tfsdk:"shared"`
+	WipeRemovedVolumes types.Bool   `tfsdk:"wipe_removed_volumes"`
+}
+
+func (m *lvmModel) fixedFields() storage.DatastoreFixedFields {
+	return storage.DatastoreFixedFields{
+		VolumeGroup: m.VolumeGroup.ValueStringPointer(),
+	}
+}
+
+func (m *lvmModel) mutableFields(_ context.Context, _ *diag.Diagnostics) storage.DatastoreMutableFields {
+	return storage.DatastoreMutableFields{
+		Shared:     proxmoxtypes.CustomBool(m.Shared.ValueBool()).Pointer(),
+		SafeRemove: proxmoxtypes.CustomBool(m.WipeRemovedVolumes.ValueBool()).Pointer(),
+	}
+}
+
+func (m *lvmModel) importFromAPI(_ context.Context, data *storage.DatastoreGetResponseData, _ *diag.Diagnostics) {
+	m.VolumeGroup = types.StringPointerValue(data.VolumeGroup)
+	m.Shared = types.BoolValue(data.Shared != nil && bool(*data.Shared))
+	m.WipeRemovedVolumes = types.BoolValue(data.SafeRemove != nil && bool(*data.SafeRemove))
+}
+
+// NewLVMStorageResource creates a new resource for managing LVM storages.
+func NewLVMStorageResource() resource.Resource {
+	return &storageResource{
+		storageType:    "lvm",
+		typeNameSuffix: "_storage_lvm",
+		description:    "Manages an LVM storage of a Proxmox VE cluster.",
+		contentTypes:   blockContentTypes,
+		attributes: map[string]schema.Attribute{
+			"volume_group": fixedStringAttribute("The name of the LVM volume group."),
+			"shared":       sharedAttribute(),
+			"wipe_removed_volumes": schema.BoolAttribute{
+				Description:         "Whether to zero-out data when removing logical volumes.",
+				MarkdownDescription: "Whether to zero-out data when removing logical volumes (defaults to `false`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+		newModel: func() storageModel { return &lvmModel{} },
+	}
+}
@@ -0,0 +1,56 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package storage
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/storage"
+)
+
+type lvmThinModel struct {
+	storageModelBase
+
+	VolumeGroup types.String `tfsdk:"volume_group"`
+	ThinPool    types.String `tfsdk:"thin_pool"`
+}
+
+func (m *lvmThinModel) fixedFields() storage.DatastoreFixedFields {
+	return storage.DatastoreFixedFields{
+		VolumeGroup: m.VolumeGroup.ValueStringPointer(),
+		ThinPool:    m.ThinPool.ValueStringPointer(),
+	}
+}
+
+func (m *lvmThinModel) mutableFields(_ context.Context, _ *diag.Diagnostics) storage.DatastoreMutableFields {
+	return storage.DatastoreMutableFields{}
+}
+
+func (m *lvmThinModel) importFromAPI(_ context.Context, data *storage.DatastoreGetResponseData, _ *diag.Diagnostics) {
+	m.VolumeGroup = types.StringPointerValue(data.VolumeGroup)
+	m.ThinPool = types.StringPointerValue(data.ThinPool)
+}
+
+// NewLVMThinStorageResource creates a new resource for managing LVM-thin storages.
+func NewLVMThinStorageResource() resource.Resource {
+	return &storageResource{
+		storageType:    "lvmthin",
+		typeNameSuffix: "_storage_lvmthin",
+		description:    "Manages an LVM-thin storage of a Proxmox VE cluster.",
+		contentTypes:   blockContentTypes,
+		attributes: map[string]schema.Attribute{
+			"volume_group": fixedStringAttribute("The name of the LVM volume group."),
+			"thin_pool":    fixedStringAttribute("The name of the LVM thin pool."),
+		},
+		newModel: func() storageModel { return &lvmThinModel{} },
+	}
+}
@@ -0,0 +1,75 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package storage
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/storage"
+)
+
+type nfsModel struct {
+	storageModelBase
+
+	Server        types.String       `tfsdk:"server"`
+	Export        types.String       `tfsdk:"export"`
+	Options       types.String       `tfsdk:"options"`
+	Preallocation types.String       `tfsdk:"preallocation"`
+	PruneBackups  *pruneBackupsModel `tfsdk:"prune_backups"`
+}
+
+func (m *nfsModel) fixedFields() storage.DatastoreFixedFields {
+	return storage.DatastoreFixedFields{
+		Server: m.Server.ValueStringPointer(),
+		Export: m.Export.ValueStringPointer(),
+	}
+}
+
+func (m *nfsModel) mutableFields(_ context.Context, _ *diag.Diagnostics) storage.DatastoreMutableFields {
+	return storage.DatastoreMutableFields{
+		Options:       m.Options.ValueStringPointer(),
+		Preallocation: m.Preallocation.ValueStringPointer(),
+		PruneBackups:  m.PruneBackups.toAPI(),
+	}
+}
+
+func (m *nfsModel) importFromAPI(_ context.Context, data *storage.DatastoreGetResponseData, _ *diag.Diagnostics) {
+	m.Server = types.StringPointerValue(data.Server)
+	m.Export = types.StringPointerValue(data.Export)
+	m.Options = stringValue(data.Options)
+	m.Preallocation = stringValue(data.Preallocation)
+	m.PruneBackups = pruneBackupsFromAPI(data.PruneBackups)
+}
+
+// NewNFSStorageResource creates a new resource for managing NFS storages.
+func NewNFSStorageResource() resource.Resource {
+	return &storageResource{
+		storageType:    "nfs",
+		typeNameSuffix: "_storage_nfs",
+		description:    "Manages an NFS storage of a Proxmox VE cluster. NFS storages are always shared.",
+		contentTypes:   fileContentTypes,
+		attributes: map[string]schema.Attribute{
+			"server": fixedStringAttribute("The address of the NFS server."),
+			"export": fixedStringAttribute("The path of the NFS export."),
+			"options": schema.StringAttribute{
+				Description: "The NFS mount options, e.g. `vers=4.2`.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"preallocation": preallocationAttribute(),
+			"prune_backups": pruneBackupsAttribute(),
+		},
+		newModel: func() storageModel { return &nfsModel{} },
+	}
+}
@@ -0,0 +1,118 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package storage
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/attribute"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/storage"
+)
+
+var fingerprintRegex = regexp.MustCompile(`^([A-Fa-f0-9]{2}:){31}[A-Fa-f0-9]{2}$`)
+
+type pbsModel struct {
+	storageModelBase
+
+	Server               types.String       `tfsdk:"server"`
+	Datastore            types.String       `tfsdk:"datastore"`
+	Namespace            types.String       `tfsdk:"namespace"`
+	Port                 types.Int64        `tfsdk:"port"`
+	Username             types.String       `tfsdk:"username"`
+	Password             types.String       `tfsdk:"password"`
+	PasswordVersion      types.Int64        `tfsdk:"password_wo_version"`
+	Fingerprint          types.String       `tfsdk:"fingerprint"`
+	EncryptionKey        types.String       `tfsdk:"encryption_key"`
+	EncryptionKeyVersion types.Int64        `tfsdk:"encryption_key_wo_version"`
+	PruneBackups         *pruneBackupsModel `tfsdk:"prune_backups"`
+}
+
+func (m *pbsModel) fixedFields() storage.DatastoreFixedFields {
+	return storage.DatastoreFixedFields{
+		Server:    m.Server.ValueStringPointer(),
+		Datastore: m.Datastore.ValueStringPointer(),
+	}
+}
+
+func (m *pbsModel) mutableFields(_ context.Context, _ *diag.Diagnostics) storage.DatastoreMutableFields {
+	return storage.DatastoreMutableFields{
+		Namespace:    m.Namespace.ValueStringPointer(),
+		Port:         m.Port.ValueInt64Pointer(),
+		Username:     m.Username.ValueStringPointer(),
+		Fingerprint:  m.Fingerprint.ValueStringPointer(),
+		PruneBackups: m.PruneBackups.toAPI(),
+	}
+}
+
+func (m *pbsModel) writeOnlyFields(fields *storage.DatastoreMutableFields) {
+	fields.Password = m.Password.ValueStringPointer()
+	fields.EncryptionKey = m.EncryptionKey.ValueStringPointer()
+}
+
+func (m *pbsModel) importFromAPI(_ context.Context, data *storage.DatastoreGetResponseData, _ *diag.Diagnostics) {
+	m.Server = types.StringPointerValue(data.Server)
+	m.Datastore = types.StringPointerValue(data.Datastore)
+	m.Namespace = stringValue(data.Namespace)
+	m.Port = types.Int64PointerValue(data.Port)
+	m.Username = stringValue(data.Username)
+	m.Fingerprint = stringValue(data.Fingerprint)
+	m.PruneBackups = pruneBackupsFromAPI(data.PruneBackups)
+}
+
+// NewPBSStorageResource creates a new resource for managing Proxmox Backup Server storages.
+func NewPBSStorageResource() resource.Resource {
+	return &storageResource{
+		storageType:    "pbs",
+		typeNameSuffix: "_storage_pbs",
+		description:    "Manages a Proxmox Backup Server storage of a Proxmox VE cluster.",
+		contentTypes:   []string{"backup"},
+		attributes: map[string]schema.Attribute{
+			"server":    fixedStringAttribute("The address of the Proxmox Backup Server."),
+			"datastore": fixedStringAttribute("The name of the datastore on the Proxmox Backup Server."),
+			"namespace": schema.StringAttribute{
+				Description: "The namespace of the datastore to store the backups in.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"port": schema.Int64Attribute{
+				Description: "The port of the Proxmox Backup Server. If not set, PVE default is `8007`.",
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.Between(1, 65535)},
+			},
+			"username": schema.StringAttribute{
+				Description: "The user name used to access the server, e.g. `backup@pbs`.",
+				Required:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"password": attribute.WriteOnlyString("password",
+				"The password or the API token secret used to access the server.", false),
+			"password_wo_version": attribute.WriteOnlyVersion("password"),
+			"fingerprint": schema.StringAttribute{
+				Description: "The SHA-256 fingerprint of the server certificate. " +
+					"Required if the certificate is not trusted by the nodes.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(fingerprintRegex, "must be a SHA-256 fingerprint"),
+				},
+			},
+			"encryption_key": attribute.WriteOnlyString("encryption_key",
+				"The client-side encryption key, in JSON format.", false),
+			"encryption_key_wo_version": attribute.WriteOnlyVersion("encryption_key"),
+			"prune_backups":             pruneBackupsAttribute(),
+		},
+		newModel: func() storageModel { return &pbsModel{} },
+	}
+}
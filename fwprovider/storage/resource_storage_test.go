@@ -0,0 +1,81 @@
+//go:build acceptance || all
+
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package storage_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/bpg/terraform-provider-proxmox/fwprovider/test"
+)
+
+func TestAccResourceStorageDirectory(t *testing.T) {
+	te := test.InitEnvironment(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: te.AccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_storage_directory" "acc_storage" {
+					id      = "acc-dir"
+					path    = "/tmp/acc-dir"
+					content = ["backup", "iso"]
+					nodes   = ["{{.NodeName}}"]
+
+					prune_backups = {
+						keep_last = 2
+					}
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					test.ResourceAttributes("proxmox_virtual_environment_storage_directory.acc_storage", map[string]string{
+						"id":                      "acc-dir",
+						"path":                    "/tmp/acc-dir",
+						"content.#":               "2",
+						"nodes.#":                 "1",
+						"disable":                 "false",
+						"shared":                  "false",
+						"prune_backups.keep_last": "2",
+					}),
+					test.NoResourceAttributesSet("proxmox_virtual_environment_storage_directory.acc_storage", []string{
+						"preallocation",
+					}),
+				),
+			},
+			{
+				Config: te.RenderConfig(`
+				resource "proxmox_virtual_environment_storage_directory" "acc_storage" {
+					id            = "acc-dir"
+					path          = "/tmp/acc-dir"
+					content       = ["snippets"]
+					preallocation = "off"
+					disable       = true
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					test.ResourceAttributes("proxmox_virtual_environment_storage_directory.acc_storage", map[string]string{
+						"content.#":     "1",
+						"content.0":     "snippets",
+						"nodes.#":       "0",
+						"preallocation": "off",
+						"disable":       "true",
+					}),
+					test.NoResourceAttributesSet("proxmox_virtual_environment_storage_directory.acc_storage", []string{
+						"prune_backups",
+					}),
+				),
+			},
+			{
+				ResourceName:      "proxmox_virtual_environment_storage_directory.acc_storage",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
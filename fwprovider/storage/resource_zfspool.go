@@ -0,0 +1,80 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package storage
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/storage"
+	proxmoxtypes "github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+var blockSizeRegex = regexp.MustCompile(`^\d+[KM]?$`)
+
+type zfsPoolModel struct {
+	storageModelBase
+
+	ZFSPool       types.String `tfsdk:"zfs_pool"`
+	ThinProvision types.Bool   `tfsdk:"thin_provision"`
+	BlockSize     types.String `tfsdk:"blocksize"`
+}
+
+func (m *zfsPoolModel) fixedFields() storage.DatastoreFixedFields {
+	return storage.DatastoreFixedFields{
+		ZFSPool: m.ZFSPool.ValueStringPointer(),
+	}
+}
+
+func (m *zfsPoolModel) mutableFields(_ context.Context, _ *diag.Diagnostics) storage.DatastoreMutableFields {
+	return storage.DatastoreMutableFields{
+		Sparse:    proxmoxtypes.CustomBool(m.ThinProvision.ValueBool()).Pointer(),
+		BlockSize: m.BlockSize.ValueStringPointer(),
+	}
+}
+
+func (m *zfsPoolModel) importFromAPI(_ context.Context, data *storage.DatastoreGetResponseData, _ *diag.Diagnostics) {
+	m.ZFSPool = types.StringPointerValue(data.ZFSPool)
+	m.ThinProvision = types.BoolValue(data.Sparse != nil && bool(*data.Sparse))
+	m.BlockSize = stringValue(data.BlockSize)
+}
+
+// NewZFSPoolStorageResource creates a new resource for managing ZFS pool storages.
+func NewZFSPoolStorageResource() resource.Resource {
+	return &storageResource{
+		storageType:    "zfspool",
+		typeNameSuffix: "_storage_zfspool",
+		description:    "Manages a ZFS pool storage of a Proxmox VE cluster.",
+		contentTypes:   blockContentTypes,
+		attributes: map[string]schema.Attribute{
+			"zfs_pool": fixedStringAttribute("The name of the ZFS pool or dataset."),
+			"thin_provision": schema.BoolAttribute{
+				Description:         "Whether to use sparse volumes.",
+				MarkdownDescription: "Whether to use sparse volumes (defaults to `false`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"blocksize": schema.StringAttribute{
+				Description: "The block size of new volumes, e.g. `16K`. If not set, PVE default is `8K`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(blockSizeRegex, "must be a size, e.g. '16K'"),
+				},
+			},
+		},
+		newModel: func() storageModel { return &zfsPoolModel{} },
+	}
+}
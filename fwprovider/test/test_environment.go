@@ -26,6 +26,7 @@ import (
 	"github.com/bpg/terraform-provider-proxmox/proxmox/access"
 	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
 	"github.com/bpg/terraform-provider-proxmox/proxmox/cluster"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/fakepve"
 	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes"
 	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/storage"
 	sdkV2provider "github.com/bpg/terraform-provider-proxmox/proxmoxtf/provider"
@@ -44,6 +45,9 @@ type Environment struct {
 	c                     api.Client
 	CloudImagesServer     string
 	ContainerImagesServer string
+
+	// FakeAPI is the fake Proxmox VE API the environment is targeting, or nil if it is targeting a real cluster.
+	FakeAPI *fakepve.Server
 }
 
 // RenderConfigOption is a configuration option for rendering the provider configuration.
@@ -53,6 +57,13 @@ type RenderConfigOption interface {
 
 type renderConfig struct {
 	providerConfig string
+	fakeAPI        *fakepve.Server
+}
+
+// returns the provider configuration targeting the fake API, there is no SSH access to it.
+func (r *renderConfig) fake(credentials string) string {
+	return fmt.Sprintf("provider \"proxmox\" {\n\tendpoint = \"%s\"\n\tinsecure = true\n%s\n}",
+		r.fakeAPI.Endpoint(), credentials)
 }
 
 // returns the ssh configuration section of the provider config.
@@ -98,6 +109,13 @@ func WithRootUser() RenderConfigOption {
 type rootUserConfigOption struct{}
 
 func (o *rootUserConfigOption) apply(rc *renderConfig) error {
+	if rc.fakeAPI != nil {
+		rc.providerConfig = rc.fake(fmt.Sprintf("\tusername = \"%s\"\n\tpassword = \"%s\"\n\tapi_token = \"\"",
+			fakepve.DefaultUsername, fakepve.DefaultPassword))
+
+		return nil
+	}
+
 	if utils.GetAnyStringEnv("PROXMOX_VE_USERNAME") == "" || utils.GetAnyStringEnv("PROXMOX_VE_PASSWORD") == "" {
 		return fmt.Errorf("PROXMOX_VE_USERNAME and PROXMOX_VE_PASSWORD must be set")
 	}
@@ -120,6 +138,13 @@ func WithAPIToken() RenderConfigOption {
 type apiTokenConfigOption struct{}
 
 func (o *apiTokenConfigOption) apply(rc *renderConfig) error {
+	if rc.fakeAPI != nil {
+		rc.providerConfig = rc.fake(fmt.Sprintf("\tapi_token = \"%s\"\n\tusername = \"\"\n\tpassword = \"\"",
+			rc.fakeAPI.APIToken()))
+
+		return nil
+	}
+
 	if utils.GetAnyStringEnv("PROXMOX_VE_API_TOKEN") == "" {
 		return fmt.Errorf("PROXMOX_VE_API_TOKEN must be set")
 	}
@@ -133,9 +158,21 @@ func (o *apiTokenConfigOption) apply(rc *renderConfig) error {
 }
 
 // InitEnvironment initializes a new test environment for acceptance tests.
+// If the PROXMOX_VE_ACC_FAKE_API environment variable is set, the environment targets a fake
+// Proxmox VE API instead of the cluster configured by the PROXMOX_VE_* environment variables.
 func InitEnvironment(t *testing.T) *Environment {
 	t.Helper()
 
+	if utils.GetAnyBoolEnv("PROXMOX_VE_ACC_FAKE_API") {
+		return InitFakeEnvironment(t)
+	}
+
+	return newEnvironment(t)
+}
+
+func newEnvironment(t *testing.T) *Environment {
+	t.Helper()
+
 	nodeName := utils.GetAnyStringEnv("PROXMOX_VE_ACC_NODE_NAME")
 	if nodeName == "" {
 		nodeName = "pve"
@@ -170,6 +207,23 @@ func InitEnvironment(t *testing.T) *Environment {
 	}
 }
 
+// InitFakeEnvironment initializes a new test environment targeting an in-process fake Proxmox VE API,
+// which is shut down when the test completes. The fake API has no SSH access, and does not download
+// or run anything, so it is only suitable for the tests of the resource lifecycle.
+func InitFakeEnvironment(t *testing.T) *Environment {
+	t.Helper()
+
+	fakeAPI := fakepve.NewServer()
+	t.Cleanup(fakeAPI.Close)
+
+	e := newEnvironment(t)
+	e.FakeAPI = fakeAPI
+	e.NodeName = fakeAPI.NodeName()
+	e.templateVars["NodeName"] = e.NodeName
+
+	return e
+}
+
 // AddTemplateVars adds the given variables to the template variables of the current test environment.
 // Please note that NodeName and ProviderConfig are reserved keys, they are set by the test environment
 // and cannot be overridden.
@@ -185,7 +239,7 @@ func (e *Environment) RenderConfig(cfg string, opt ...RenderConfigOption) string
 		opt = append(opt, WithAPIToken())
 	}
 
-	rc := &renderConfig{}
+	rc := &renderConfig{fakeAPI: e.FakeAPI}
 	for _, o := range opt {
 		err := o.apply(rc)
 		require.NoError(e.t, err, "configuration error")
@@ -206,23 +260,38 @@ func (e *Environment) RenderConfig(cfg string, opt ...RenderConfigOption) string
 // 1. API token
 // 2. Ticket
 // 3. User credentials.
+//
+// If the environment is targeting the fake API, the client is using the API token of its root user.
+// The `PROXMOX_VE_RATE_LIMIT`, `PROXMOX_VE_NODE_TASK_CONCURRENCY` and `PROXMOX_VE_API_PROXY` environment
+// variables are honored.
 func (e *Environment) Client() api.Client {
 	if e.c == nil {
 		e.once.Do(
 			func() {
 				endpoint := utils.GetAnyStringEnv("PROXMOX_VE_ENDPOINT")
+				apiToken := utils.GetAnyStringEnv("PROXMOX_VE_API_TOKEN")
 				authTicket := utils.GetAnyStringEnv("PROXMOX_VE_AUTH_TICKET")
 				csrfPreventionToken := utils.GetAnyStringEnv("PROXMOX_VE_CSRF_PREVENTION_TOKEN")
-				apiToken := utils.GetAnyStringEnv("PROXMOX_VE_API_TOKEN")
 				username := utils.GetAnyStringEnv("PROXMOX_VE_USERNAME")
 				password := utils.GetAnyStringEnv("PROXMOX_VE_PASSWORD")
+				apiProxy := utils.GetAnyStringEnv("PROXMOX_VE_API_PROXY")
+
+				if e.FakeAPI != nil {
+					endpoint, apiToken = e.FakeAPI.Endpoint(), e.FakeAPI.APIToken()
+					authTicket, csrfPreventionToken, username, password, apiProxy = "", "", "", "", ""
+				}
 
 				creds, err := api.NewCredentials(username, password, "", apiToken, authTicket, csrfPreventionToken)
 				if err != nil {
 					panic(err)
 				}
 
-				conn, err := api.NewConnection(endpoint, true, "")
+				// the same limits as the provider, so the concurrent tests, e.g. `TestBatchCreate`, can be throttled
+				conn, err := api.NewConnection(endpoint, true, "",
+					api.WithRateLimit(float64(utils.GetAnyIntEnv("PROXMOX_VE_RATE_LIMIT"))),
+					api.WithNodeTaskConcurrency(utils.GetAnyIntEnv("PROXMOX_VE_NODE_TASK_CONCURRENCY")),
+					api.WithProxy(apiProxy),
+				)
 				if err != nil {
 					panic(err)
 				}
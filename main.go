@@ -48,6 +48,7 @@ import (
 //go:generate cp ./build/docs-gen/data-sources/virtual_environment_hardware_mappings.md ./docs/data-sources/
 //go:generate cp ./build/docs-gen/data-sources/virtual_environment_haresource.md ./docs/data-sources/
 //go:generate cp ./build/docs-gen/data-sources/virtual_environment_haresources.md ./docs/data-sources/
+//go:generate cp ./build/docs-gen/data-sources/virtual_environment_snapshots.md ./docs/data-sources/
 //go:generate cp ./build/docs-gen/data-sources/virtual_environment_version.md ./docs/data-sources/
 //go:generate cp ./build/docs-gen/data-sources/virtual_environment_vm2.md ./docs/data-sources/
 //go:generate cp ./build/docs-gen/data-sources/virtual_environment_metrics_server.md ./docs/data-sources/
@@ -56,6 +57,8 @@ import (
 //go:generate cp ./build/docs-gen/resources/virtual_environment_acme_dns_plugin.md ./docs/resources/
 //go:generate cp ./build/docs-gen/resources/virtual_environment_apt_repository.md ./docs/resources/
 //go:generate cp ./build/docs-gen/resources/virtual_environment_apt_standard_repository.md ./docs/resources/
+//go:generate cp ./build/docs-gen/resources/virtual_environment_backup.md ./docs/resources/
+//go:generate cp ./build/docs-gen/resources/virtual_environment_backup_job.md ./docs/resources/
 //go:generate cp ./build/docs-gen/resources/virtual_environment_cluster_options.md ./docs/resources/
 //go:generate cp ./build/docs-gen/resources/virtual_environment_download_file.md ./docs/resources/
 //go:generate cp ./build/docs-gen/resources/virtual_environment_hagroup.md ./docs/resources/
@@ -63,10 +66,40 @@ import (
 //go:generate cp ./build/docs-gen/resources/virtual_environment_hardware_mapping_pci.md ./docs/resources/
 //go:generate cp ./build/docs-gen/resources/virtual_environment_hardware_mapping_usb.md ./docs/resources/
 //go:generate cp ./build/docs-gen/resources/virtual_environment_haresource.md ./docs/resources/
+//go:generate cp ./build/docs-gen/resources/virtual_environment_network_linux_bond.md ./docs/resources/
 //go:generate cp ./build/docs-gen/resources/virtual_environment_network_linux_bridge.md ./docs/resources/
 //go:generate cp ./build/docs-gen/resources/virtual_environment_network_linux_vlan.md ./docs/resources/
+//go:generate cp ./build/docs-gen/resources/virtual_environment_network_ovs_bond.md ./docs/resources/
+//go:generate cp ./build/docs-gen/resources/virtual_environment_network_ovs_bridge.md ./docs/resources/
+//go:generate cp ./build/docs-gen/resources/virtual_environment_network_ovs_int_port.md ./docs/resources/
+//go:generate cp ./build/docs-gen/resources/virtual_environment_network_ovs_port.md ./docs/resources/
+//go:generate cp ./build/docs-gen/resources/virtual_environment_notification_endpoint_gotify.md ./docs/resources/
+//go:generate cp ./build/docs-gen/resources/virtual_environment_notification_endpoint_sendmail.md ./docs/resources/
+//go:generate cp ./build/docs-gen/resources/virtual_environment_notification_endpoint_smtp.md ./docs/resources/
+//go:generate cp ./build/docs-gen/resources/virtual_environment_notification_endpoint_webhook.md ./docs/resources/
+//go:generate cp ./build/docs-gen/resources/virtual_environment_notification_matcher.md ./docs/resources/
+//go:generate cp ./build/docs-gen/resources/virtual_environment_notification_target_test.md ./docs/resources/
+//go:generate cp ./build/docs-gen/resources/virtual_environment_realm_ad.md ./docs/resources/
+//go:generate cp ./build/docs-gen/resources/virtual_environment_realm_ldap.md ./docs/resources/
+//go:generate cp ./build/docs-gen/resources/virtual_environment_realm_openid.md ./docs/resources/
+//go:generate cp ./build/docs-gen/resources/virtual_environment_realm_sync.md ./docs/resources/
+//go:generate cp ./build/docs-gen/resources/virtual_environment_replication.md ./docs/resources/
+//go:generate cp ./build/docs-gen/resources/virtual_environment_sdn_applier.md ./docs/resources/
+//go:generate cp ./build/docs-gen/resources/virtual_environment_sdn_subnet.md ./docs/resources/
+//go:generate cp ./build/docs-gen/resources/virtual_environment_sdn_vnet.md ./docs/resources/
+//go:generate cp ./build/docs-gen/resources/virtual_environment_sdn_zone.md ./docs/resources/
+//go:generate cp ./build/docs-gen/resources/virtual_environment_snapshot.md ./docs/resources/
+//go:generate cp ./build/docs-gen/resources/virtual_environment_storage_cifs.md ./docs/resources/
+//go:generate cp ./build/docs-gen/resources/virtual_environment_storage_directory.md ./docs/resources/
+//go:generate cp ./build/docs-gen/resources/virtual_environment_storage_iscsi.md ./docs/resources/
+//go:generate cp ./build/docs-gen/resources/virtual_environment_storage_lvm.md ./docs/resources/
+//go:generate cp ./build/docs-gen/resources/virtual_environment_storage_lvmthin.md ./docs/resources/
+//go:generate cp ./build/docs-gen/resources/virtual_environment_storage_nfs.md ./docs/resources/
+//go:generate cp ./build/docs-gen/resources/virtual_environment_storage_pbs.md ./docs/resources/
+//go:generate cp ./build/docs-gen/resources/virtual_environment_storage_zfspool.md ./docs/resources/
 //go:generate cp ./build/docs-gen/resources/virtual_environment_user_token.md ./docs/resources/
 //go:generate cp ./build/docs-gen/resources/virtual_environment_vm2.md ./docs/resources/
+//go:generate cp ./build/docs-gen/resources/virtual_environment_vm_agent_command.md ./docs/resources/
 //go:generate cp ./build/docs-gen/resources/virtual_environment_metrics_server.md ./docs/resources/
 
 // these will be set by the goreleaser configuration
@@ -0,0 +1,99 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package access
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/tasks"
+)
+
+func (c *Client) realmsPath() string {
+	return c.ExpandPath("domains")
+}
+
+func (c *Client) realmPath(id string) string {
+	return fmt.Sprintf("%s/%s", c.realmsPath(), url.PathEscape(id))
+}
+
+// Tasks returns a client for managing access tasks, such as realm synchronizations.
+func (c *Client) Tasks() *tasks.Client {
+	return &tasks.Client{
+		Client: c.Client,
+	}
+}
+
+// CreateRealm creates an authentication realm.
+func (c *Client) CreateRealm(ctx context.Context, d *RealmCreateRequestBody) error {
+	err := c.DoRequest(ctx, http.MethodPost, c.realmsPath(), d, nil)
+	if err != nil {
+		return fmt.Errorf("error creating realm: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteRealm deletes an authentication realm.
+func (c *Client) DeleteRealm(ctx context.Context, id string) error {
+	err := c.DoRequest(ctx, http.MethodDelete, c.realmPath(id), nil, nil)
+	if err != nil {
+		return fmt.Errorf("error deleting realm: %w", err)
+	}
+
+	return nil
+}
+
+// GetRealm retrieves an authentication realm.
+func (c *Client) GetRealm(ctx context.Context, id string) (*RealmGetResponseData, error) {
+	resBody := &RealmGetResponseBody{}
+
+	err := c.DoRequest(ctx, http.MethodGet, c.realmPath(id), nil, resBody)
+	if err != nil {
+		return nil, fmt.Errorf("error getting realm: %w", err)
+	}
+
+	if resBody.Data == nil {
+		return nil, api.ErrNoDataObjectInResponse
+	}
+
+	return resBody.Data, nil
+}
+
+// UpdateRealm updates an authentication realm.
+func (c *Client) UpdateRealm(ctx context.Context, id string, d *RealmUpdateRequestBody) error {
+	err := c.DoRequest(ctx, http.MethodPut, c.realmPath(id), d, nil)
+	if err != nil {
+		return fmt.Errorf("error updating realm: %w", err)
+	}
+
+	return nil
+}
+
+// SyncRealm synchronizes the users and groups of an LDAP or AD realm and waits for the task to finish.
+func (c *Client) SyncRealm(ctx context.Context, id string, d *RealmSyncRequestBody) error {
+	resBody := &RealmSyncResponseBody{}
+
+	err := c.DoRequest(ctx, http.MethodPost, fmt.Sprintf("%s/sync", c.realmPath(id)), d, resBody)
+	if err != nil {
+		return fmt.Errorf("error synchronizing realm: %w", err)
+	}
+
+	if resBody.Data == nil {
+		return api.ErrNoDataObjectInResponse
+	}
+
+	err = c.Tasks().WaitForTask(ctx, *resBody.Data)
+	if err != nil {
+		return fmt.Errorf("error waiting for realm synchronization: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,265 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package access
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+// RealmTFA contains the two-factor authentication settings enforced for the users of a realm.
+type RealmTFA struct {
+	Type   string  `json:"type"`
+	Step   *int64  `json:"step,omitempty"`
+	Digits *int64  `json:"digits,omitempty"`
+	ID     *string `json:"id,omitempty"`
+	Key    *string `json:"key,omitempty"`
+	URL    *string `json:"url,omitempty"`
+}
+
+// String returns the property string representation of the TFA settings.
+func (r *RealmTFA) String() string {
+	values := []string{"type=" + r.Type}
+
+	if r.Step != nil {
+		values = append(values, fmt.Sprintf("step=%d", *r.Step))
+	}
+
+	if r.Digits != nil {
+		values = append(values, fmt.Sprintf("digits=%d", *r.Digits))
+	}
+
+	for _, kv := range []struct {
+		key   string
+		value *string
+	}{
+		{"id", r.ID},
+		{"key", r.Key},
+		{"url", r.URL},
+	} {
+		if kv.value != nil {
+			values = append(values, fmt.Sprintf("%s=%s", kv.key, *kv.value))
+		}
+	}
+
+	return strings.Join(values, ",")
+}
+
+// EncodeValues converts a RealmTFA struct to a URL value.
+func (r *RealmTFA) EncodeValues(key string, v *url.Values) error {
+	v.Add(key, r.String())
+
+	return nil
+}
+
+// UnmarshalJSON converts the TFA settings from their property string representation.
+func (r *RealmTFA) UnmarshalJSON(b []byte) error {
+	var s string
+
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("failed to unmarshal RealmTFA: %w", err)
+	}
+
+	for _, p := range strings.Split(s, ",") {
+		k, v, found := strings.Cut(strings.TrimSpace(p), "=")
+		if !found {
+			continue
+		}
+
+		switch k {
+		case "type":
+			r.Type = v
+		case "step", "digits":
+			i, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return fmt.Errorf("failed to parse %s: %w", k, err)
+			}
+
+			if k == "step" {
+				r.Step = &i
+			} else {
+				r.Digits = &i
+			}
+		case "id":
+			r.ID = &v
+		case "key":
+			r.Key = &v
+		case "url":
+			r.URL = &v
+		}
+	}
+
+	return nil
+}
+
+// RealmSyncOptions contains the options of a realm synchronization.
+type RealmSyncOptions struct {
+	EnableNew      *types.CustomBool `json:"enable-new,omitempty"      url:"enable-new,omitempty,int"`
+	RemoveVanished *string           `json:"remove-vanished,omitempty" url:"remove-vanished,omitempty"`
+	Scope          *string           `json:"scope,omitempty"           url:"scope,omitempty"`
+}
+
+// RealmSyncDefaultsOptions contains the default options used when synchronizing a realm.
+type RealmSyncDefaultsOptions RealmSyncOptions
+
+// String returns the property string representation of the sync options.
+func (r *RealmSyncDefaultsOptions) String() string {
+	var values []string
+
+	if r.EnableNew != nil {
+		values = append(values, fmt.Sprintf("enable-new=%d", boolToInt(bool(*r.EnableNew))))
+	}
+
+	if r.RemoveVanished != nil {
+		values = append(values, "remove-vanished="+*r.RemoveVanished)
+	}
+
+	if r.Scope != nil {
+		values = append(values, "scope="+*r.Scope)
+	}
+
+	return strings.Join(values, ",")
+}
+
+// EncodeValues converts a RealmSyncDefaultsOptions struct to a URL value.
+func (r *RealmSyncDefaultsOptions) EncodeValues(key string, v *url.Values) error {
+	if s := r.String(); s != "" {
+		v.Add(key, s)
+	}
+
+	return nil
+}
+
+// UnmarshalJSON converts the sync options from their property string representation.
+func (r *RealmSyncDefaultsOptions) UnmarshalJSON(b []byte) error {
+	var s string
+
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("failed to unmarshal RealmSyncDefaultsOptions: %w", err)
+	}
+
+	for _, p := range strings.Split(s, ",") {
+		k, v, found := strings.Cut(strings.TrimSpace(p), "=")
+		if !found {
+			continue
+		}
+
+		switch k {
+		case "enable-new":
+			enableNew := types.CustomBool(v == "1" || v == "true")
+			r.EnableNew = &enableNew
+		case "remove-vanished":
+			r.RemoveVanished = &v
+		case "scope":
+			r.Scope = &v
+		}
+	}
+
+	return nil
+}
+
+// RealmFixedFields contains the realm fields that can only be set on creation.
+type RealmFixedFields struct {
+	UsernameClaim *string `json:"username-claim,omitempty" url:"username-claim,omitempty"`
+}
+
+// RealmMutableFields contains the realm fields that can be changed after creation.
+type RealmMutableFields struct {
+	Comment       *string           `json:"comment,omitempty"        url:"comment,omitempty"`
+	Default       *types.CustomBool `json:"default,omitempty"        url:"default,omitempty,int"`
+	TFA           *RealmTFA         `json:"tfa,omitempty"            url:"tfa,omitempty"`
+	CaseSensitive *types.CustomBool `json:"case-sensitive,omitempty" url:"case-sensitive,omitempty,int"`
+
+	// LDAP and Active Directory fields
+	Server1             *string                   `json:"server1,omitempty"               url:"server1,omitempty"`
+	Server2             *string                   `json:"server2,omitempty"               url:"server2,omitempty"`
+	Port                *int64                    `json:"port,omitempty"                  url:"port,omitempty"`
+	Mode                *string                   `json:"mode,omitempty"                  url:"mode,omitempty"`
+	Verify              *types.CustomBool         `json:"verify,omitempty"                url:"verify,omitempty,int"`
+	CAPath              *string                   `json:"capath,omitempty"                url:"capath,omitempty"`
+	Domain              *string                   `json:"domain,omitempty"                url:"domain,omitempty"`
+	BaseDN              *string                   `json:"base_dn,omitempty"               url:"base_dn,omitempty"`
+	BindDN              *string                   `json:"bind_dn,omitempty"               url:"bind_dn,omitempty"`
+	Password            *string                   `json:"password,omitempty"              url:"password,omitempty"`
+	UserAttr            *string                   `json:"user_attr,omitempty"             url:"user_attr,omitempty"`
+	UserClasses         *string                   `json:"user_classes,omitempty"          url:"user_classes,omitempty"`
+	Filter              *string                   `json:"filter,omitempty"                url:"filter,omitempty"`
+	GroupDN             *string                   `json:"group_dn,omitempty"              url:"group_dn,omitempty"`
+	GroupFilter         *string                   `json:"group_filter,omitempty"          url:"group_filter,omitempty"`
+	GroupClasses        *string                   `json:"group_classes,omitempty"         url:"group_classes,omitempty"`
+	GroupNameAttr       *string                   `json:"group_name_attr,omitempty"       url:"group_name_attr,omitempty"`
+	SyncAttributes      *string                   `json:"sync_attributes,omitempty"       url:"sync_attributes,omitempty"`
+	SyncDefaultsOptions *RealmSyncDefaultsOptions `json:"sync-defaults-options,omitempty" url:"sync-defaults-options,omitempty"`
+
+	// OpenID Connect fields
+	IssuerURL        *string           `json:"issuer-url,omitempty"        url:"issuer-url,omitempty"`
+	ClientID         *string           `json:"client-id,omitempty"         url:"client-id,omitempty"`
+	ClientKey        *string           `json:"client-key,omitempty"        url:"client-key,omitempty"`
+	Autocreate       *types.CustomBool `json:"autocreate,omitempty"        url:"autocreate,omitempty,int"`
+	Scopes           *string           `json:"scopes,omitempty"            url:"scopes,omitempty"`
+	Prompt           *string           `json:"prompt,omitempty"            url:"prompt,omitempty"`
+	ACRValues        *string           `json:"acr-values,omitempty"        url:"acr-values,omitempty"`
+	GroupsClaim      *string           `json:"groups-claim,omitempty"      url:"groups-claim,omitempty"`
+	GroupsAutocreate *types.CustomBool `json:"groups-autocreate,omitempty" url:"groups-autocreate,omitempty,int"`
+	GroupsOverwrite  *types.CustomBool `json:"groups-overwrite,omitempty"  url:"groups-overwrite,omitempty,int"`
+	QueryUserinfo    *types.CustomBool `json:"query-userinfo,omitempty"    url:"query-userinfo,omitempty,int"`
+}
+
+// RealmCreateRequestBody contains the data for a realm create request.
+type RealmCreateRequestBody struct {
+	ID   string `url:"realm"`
+	Type string `url:"type"`
+
+	RealmFixedFields
+	RealmMutableFields
+}
+
+// RealmGetResponseBody contains the body from a realm get response.
+type RealmGetResponseBody struct {
+	Data *RealmGetResponseData `json:"data,omitempty"`
+}
+
+// RealmGetResponseData contains the data from a realm get response.
+type RealmGetResponseData struct {
+	Type   string  `json:"type"`
+	Digest *string `json:"digest,omitempty"`
+
+	RealmFixedFields
+	RealmMutableFields
+}
+
+// RealmUpdateRequestBody contains the data for a realm update request.
+type RealmUpdateRequestBody struct {
+	Delete []string `url:"delete,omitempty,comma"`
+
+	RealmMutableFields
+}
+
+// RealmSyncRequestBody contains the data for a realm sync request.
+type RealmSyncRequestBody struct {
+	DryRun *types.CustomBool `url:"dry-run,omitempty,int"`
+
+	RealmSyncOptions
+}
+
+// RealmSyncResponseBody contains the body from a realm sync response.
+type RealmSyncResponseBody struct {
+	Data *string `json:"data,omitempty"`
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,74 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package access
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-querystring/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/helpers/ptr"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+func TestRealmCreateRequestBodyEncode(t *testing.T) {
+	t.Parallel()
+
+	body := &RealmCreateRequestBody{
+		ID:   "example",
+		Type: "ldap",
+		RealmMutableFields: RealmMutableFields{
+			Server1:  ptr.Ptr("ldap.example.com"),
+			BaseDN:   ptr.Ptr("dc=example,dc=com"),
+			UserAttr: ptr.Ptr("uid"),
+			Default:  types.CustomBool(false).Pointer(),
+			TFA:      &RealmTFA{Type: "oath", Digits: ptr.Ptr(int64(8))},
+			SyncDefaultsOptions: &RealmSyncDefaultsOptions{
+				EnableNew:      types.CustomBool(true).Pointer(),
+				RemoveVanished: ptr.Ptr("acl;entry"),
+				Scope:          ptr.Ptr("both"),
+			},
+		},
+	}
+
+	v, err := query.Values(body)
+	require.NoError(t, err)
+	require.Equal(t, "ldap", v.Get("type"))
+	require.Equal(t, "0", v.Get("default"))
+	require.Equal(t, "type=oath,digits=8", v.Get("tfa"))
+	require.Equal(t, "enable-new=1,remove-vanished=acl;entry,scope=both", v.Get("sync-defaults-options"))
+	require.False(t, v.Has("password"))
+}
+
+func TestRealmGetResponseDataUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	data := &RealmGetResponseData{}
+	err := json.Unmarshal([]byte(`{
+		"type": "ad",
+		"domain": "example.com",
+		"server1": "dc1.example.com",
+		"port": 636,
+		"verify": 1,
+		"tfa": "type=yubico,id=42,url=https://api.example.com",
+		"sync-defaults-options": "scope=users,remove-vanished=none"
+	}`), data)
+	require.NoError(t, err)
+	require.Equal(t, "ad", data.Type)
+	require.Equal(t, int64(636), *data.Port)
+	require.True(t, bool(*data.Verify))
+	require.Equal(t, &RealmTFA{
+		Type: "yubico",
+		ID:   ptr.Ptr("42"),
+		URL:  ptr.Ptr("https://api.example.com"),
+	}, data.TFA)
+	require.Equal(t, "users", *data.SyncDefaultsOptions.Scope)
+	require.Equal(t, "none", *data.SyncDefaultsOptions.RemoveVanished)
+	require.Nil(t, data.SyncDefaultsOptions.EnableNew)
+}
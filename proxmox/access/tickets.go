@@ -0,0 +1,37 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package access
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+)
+
+// CreateTicket creates an authentication ticket, which can be used for a cookie-based authentication.
+// The ticket is valid for two hours.
+func (c *Client) CreateTicket(ctx context.Context, d *TicketCreateRequestBody) (*TicketCreateResponseData, error) {
+	resBody := &TicketCreateResponseBody{}
+
+	err := c.DoRequest(ctx, http.MethodPost, c.ExpandPath("ticket"), d, resBody)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ticket: %w", err)
+	}
+
+	if resBody.Data == nil {
+		return nil, api.ErrNoDataObjectInResponse
+	}
+
+	if resBody.Data.Ticket == "" {
+		return nil, errors.New("error creating ticket: the server did not include a ticket in the response")
+	}
+
+	return resBody.Data, nil
+}
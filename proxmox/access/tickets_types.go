@@ -0,0 +1,27 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package access
+
+// TicketCreateRequestBody contains the data for a ticket create request.
+type TicketCreateRequestBody struct {
+	Username string  `json:"username"      url:"username"`
+	Password string  `json:"password"      url:"password"`
+	OTP      *string `json:"otp,omitempty" url:"otp,omitempty"`
+}
+
+// TicketCreateResponseBody contains the body from a ticket create response.
+type TicketCreateResponseBody struct {
+	Data *TicketCreateResponseData `json:"data,omitempty"`
+}
+
+// TicketCreateResponseData contains the data from a ticket create response.
+type TicketCreateResponseData struct {
+	ClusterName         *string `json:"clustername,omitempty"`
+	CSRFPreventionToken string  `json:"CSRFPreventionToken"`
+	Ticket              string  `json:"ticket"`
+	Username            string  `json:"username"`
+}
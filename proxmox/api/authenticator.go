@@ -25,3 +25,11 @@ type Authenticator interface {
 	// AuthenticateRequest adds authentication data to a new request.
 	AuthenticateRequest(ctx context.Context, req *http.Request) error
 }
+
+// renewableAuthenticator is an authenticator whose credentials may be renewed when the API rejects them.
+type renewableAuthenticator interface {
+	// invalidate discards the credentials the API rejected for the request, so they are renewed for the next one.
+	// The credentials renewed since the request was sent are kept. It returns an error if the credentials cannot
+	// be renewed.
+	invalidate(ctx context.Context, req *http.Request) error
+}
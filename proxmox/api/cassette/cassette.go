@@ -0,0 +1,129 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+// Package cassette records the HTTP interactions with the Proxmox VE API, and replays them without calling the API,
+// to be used in deterministic tests of the API clients. The credentials, i.e. the API tokens, tickets, CSRF
+// prevention tokens and passwords, are scrubbed from the recorded interactions.
+//
+// The transports are plugged into the API connection with the api.WithTransport option:
+//
+//	c, err := cassette.Load("testdata/get_vm.json")
+//	...
+//	conn, err := api.NewConnection("https://pve.example.com:8006/", true, "", api.WithTransport(c.Replay))
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+)
+
+// Cassette is a list of recorded HTTP interactions.
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+
+	mu sync.Mutex
+}
+
+// Interaction is a recorded HTTP request and its response.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+
+	replayed bool
+}
+
+// Request is a recorded HTTP request.
+type Request struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// Response is a recorded HTTP response. The status includes the reason phrase, which is used by
+// the API client as the error message.
+type Response struct {
+	Status     string      `json:"status"`
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// New returns an empty cassette, to be used for recording.
+func New() *Cassette {
+	return &Cassette{Interactions: []*Interaction{}}
+}
+
+// Load reads a cassette from a file.
+func Load(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cassette: %w", err)
+	}
+
+	c := New()
+
+	if err = json.Unmarshal(data, c); err != nil {
+		return nil, fmt.Errorf("failed to decode cassette %s: %w", path, err)
+	}
+
+	return c, nil
+}
+
+// Save writes the cassette to a file.
+func (c *Cassette) Save(path string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var buf bytes.Buffer
+
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+
+	if err := enc.Encode(c); err != nil {
+		return fmt.Errorf("failed to encode cassette: %w", err)
+	}
+
+	//nolint:gosec,mnd
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("failed to write cassette: %w", err)
+	}
+
+	return nil
+}
+
+// Record returns a transport which performs the requests with the given one, and adds the interactions
+// to the cassette. It can be passed to api.WithTransport.
+func (c *Cassette) Record(next http.RoundTripper) http.RoundTripper {
+	return &recorder{cassette: c, next: next}
+}
+
+// Replay returns a transport which responds to the requests with the recorded interactions, without
+// performing them. It can be passed to api.WithTransport, the given transport is ignored.
+func (c *Cassette) Replay(_ http.RoundTripper) http.RoundTripper {
+	return &replayer{cassette: c}
+}
+
+// Unreplayed returns the recorded interactions that have not been replayed yet.
+func (c *Cassette) Unreplayed() []*Interaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var res []*Interaction
+
+	for _, i := range c.Interactions {
+		if !i.replayed {
+			res = append(res, i)
+		}
+	}
+
+	return res
+}
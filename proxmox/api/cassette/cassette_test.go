@@ -0,0 +1,116 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package cassette_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/access"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api/cassette"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/fakepve"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/version"
+)
+
+func newClient(t *testing.T, endpoint string, creds api.Credentials, opts ...api.ConnectionOption) api.Client {
+	t.Helper()
+
+	conn, err := api.NewConnection(endpoint, true, "", opts...)
+	require.NoError(t, err)
+
+	c, err := api.NewClient(creds, conn)
+	require.NoError(t, err)
+
+	return c
+}
+
+// exercise performs a few requests, returning the results to compare between the recording and the replay.
+func exercise(ctx context.Context, t *testing.T, c api.Client) []string {
+	t.Helper()
+
+	accessClient := &access.Client{Client: c}
+
+	v, err := (&version.Client{Client: c}).Version(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, accessClient.CreateUser(ctx, &access.UserCreateRequestBody{
+		ID:       "test@pve",
+		Password: "user-password",
+	}))
+
+	token, err := accessClient.CreateUserToken(ctx, "test@pve", "ci", &access.UserTokenCreateRequestBody{})
+	require.NoError(t, err)
+
+	tokenID, _, _ := strings.Cut(token, "=")
+
+	_, err = accessClient.GetUser(ctx, "missing@pve")
+	require.Error(t, err)
+
+	return []string{v.Version, tokenID, err.Error()}
+}
+
+func TestRecordReplay(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	s := fakepve.NewServer()
+	defer s.Close()
+
+	creds, err := api.NewCredentials(fakepve.DefaultUsername, fakepve.DefaultPassword, "", "", "", "")
+	require.NoError(t, err)
+
+	recording := cassette.New()
+	recorded := exercise(ctx, t, newClient(t, s.Endpoint(), creds, api.WithTransport(recording.Record)))
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	require.NoError(t, recording.Save(path))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	// the credentials are scrubbed
+	assert.NotContains(t, string(data), "password="+fakepve.DefaultPassword)
+	assert.NotContains(t, string(data), "user-password")
+	assert.NotContains(t, string(data), "PVE:root@pam:")
+	assert.Contains(t, string(data), cassette.Redacted)
+
+	for _, i := range recording.Interactions {
+		assert.NotContains(t, i.Response.Header, "Set-Cookie")
+	}
+
+	replaying, err := cassette.Load(path)
+	require.NoError(t, err)
+
+	// the replay does not call the API, and is not bound to the endpoint used for the recording
+	s.Close()
+
+	replayed := exercise(ctx, t, newClient(t, "https://pve.example.com:8006/", creds, api.WithTransport(replaying.Replay)))
+
+	assert.Equal(t, recorded, replayed)
+	assert.Empty(t, replaying.Unreplayed())
+}
+
+func TestReplayUnknownRequest(t *testing.T) {
+	t.Parallel()
+
+	creds, err := api.NewCredentials("", "", "", "root@pam!test=00000000-0000-0000-0000-000000000000", "", "")
+	require.NoError(t, err)
+
+	c := newClient(t, "https://pve.example.com:8006/", creds,
+		api.WithTransport(cassette.New().Replay), api.WithRetryPolicy(api.RetryPolicy{MaxAttempts: 1}))
+
+	_, err = (&version.Client{Client: c}).Version(context.Background())
+	require.ErrorContains(t, err, "no recorded interaction for GET /api2/json/version")
+}
@@ -0,0 +1,119 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package cassette
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Redacted replaces the scrubbed credentials in the recorded interactions.
+const Redacted = "REDACTED"
+
+//nolint:gochecknoglobals
+var (
+	// the request form parameters holding credentials, e.g. in the `access/ticket` and `access/password` requests.
+	sensitiveParams = []string{"password", "new-password", "otp", "tfa-challenge", "confirmation-password"}
+
+	// the response data properties holding credentials, e.g. in the `access/ticket` and token create responses.
+	sensitiveProperties = []string{"ticket", "CSRFPreventionToken", "value", "password"}
+)
+
+// scrubRequestHeader returns a copy of the request header without the credentials. The API token secret is
+// replaced, but its ID is kept, as it is useful to know which token has been used.
+func scrubRequestHeader(header http.Header) http.Header {
+	h := header.Clone()
+
+	if auth := h.Get("Authorization"); auth != "" {
+		if token, ok := strings.CutPrefix(auth, "PVEAPIToken="); ok {
+			tokenID, _, _ := strings.Cut(token, "=")
+			h.Set("Authorization", "PVEAPIToken="+tokenID+"="+Redacted)
+		} else {
+			h.Set("Authorization", Redacted)
+		}
+	}
+
+	if h.Get("Cookie") != "" {
+		h.Set("Cookie", "PVEAuthCookie="+Redacted)
+	}
+
+	if h.Get("CSRFPreventionToken") != "" {
+		h.Set("CSRFPreventionToken", Redacted)
+	}
+
+	return h
+}
+
+// scrubResponseHeader returns a copy of the response header without the cookies, and without the date,
+// so the recordings of the same interactions are identical.
+func scrubResponseHeader(header http.Header) http.Header {
+	h := header.Clone()
+	h.Del("Set-Cookie")
+	h.Del("Date")
+
+	return h
+}
+
+// scrubRequestBody returns the form-encoded request body without the credentials. The other bodies,
+// e.g. the multipart uploads, are not recorded.
+func scrubRequestBody(contentType string, body []byte) string {
+	if !strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		return ""
+	}
+
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return string(body)
+	}
+
+	for _, p := range sensitiveParams {
+		if values.Has(p) {
+			values.Set(p, Redacted)
+		}
+	}
+
+	return values.Encode()
+}
+
+// scrubResponseBody returns the JSON response body without the credentials in its data object.
+func scrubResponseBody(body []byte) string {
+	var res map[string]json.RawMessage
+
+	if err := json.Unmarshal(body, &res); err != nil {
+		return string(body)
+	}
+
+	var data map[string]any
+
+	if err := json.Unmarshal(res["data"], &data); err != nil {
+		return string(body)
+	}
+
+	scrubbed := false
+
+	for _, p := range sensitiveProperties {
+		if _, ok := data[p]; ok {
+			data[p] = Redacted
+			scrubbed = true
+		}
+	}
+
+	if !scrubbed {
+		return string(body)
+	}
+
+	res["data"], _ = json.Marshal(data) //nolint:errchkjson
+
+	out, err := json.Marshal(res)
+	if err != nil {
+		return string(body)
+	}
+
+	return string(out)
+}
@@ -0,0 +1,124 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package cassette
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+type recorder struct {
+	cassette *Cassette
+	next     http.RoundTripper
+}
+
+func (r *recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := r.next.RoundTrip(req)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	resBody, err := io.ReadAll(res.Body)
+	_ = res.Body.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the response body: %w", err)
+	}
+
+	res.Body = io.NopCloser(bytes.NewReader(resBody))
+
+	r.cassette.mu.Lock()
+	defer r.cassette.mu.Unlock()
+
+	r.cassette.Interactions = append(r.cassette.Interactions, &Interaction{
+		Request: Request{
+			Method: req.Method,
+			URL:    req.URL.RequestURI(),
+			Header: scrubRequestHeader(req.Header),
+			Body:   reqBody,
+		},
+		Response: Response{
+			Status:     res.Status,
+			StatusCode: res.StatusCode,
+			Header:     scrubResponseHeader(res.Header),
+			Body:       scrubResponseBody(resBody),
+		},
+	})
+
+	return res, nil
+}
+
+type replayer struct {
+	cassette *Cassette
+}
+
+// RoundTrip responds with the first recorded interaction that has not been replayed yet, and matches
+// the request method, URL and form-encoded body.
+func (r *replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cassette.mu.Lock()
+	defer r.cassette.mu.Unlock()
+
+	for _, i := range r.cassette.Interactions {
+		if i.replayed || i.Request.Method != req.Method || i.Request.URL != req.URL.RequestURI() ||
+			i.Request.Body != reqBody {
+			continue
+		}
+
+		i.replayed = true
+
+		header := i.Response.Header.Clone()
+		if header == nil {
+			header = http.Header{}
+		}
+
+		return &http.Response{
+			Status:        i.Response.Status,
+			StatusCode:    i.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(strings.NewReader(i.Response.Body)),
+			ContentLength: int64(len(i.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("no recorded interaction for %s %s", req.Method, req.URL.RequestURI())
+}
+
+// readRequestBody reads the scrubbed form-encoded request body, and restores it so the request can be performed.
+func readRequestBody(req *http.Request) (string, error) {
+	contentType := req.Header.Get("Content-Type")
+	if req.Body == nil || !strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		return "", nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+
+	if err != nil {
+		return "", fmt.Errorf("failed to read the request body: %w", err)
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	return scrubRequestBody(contentType, body), nil
+}
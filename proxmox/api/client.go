@@ -16,12 +16,10 @@ import (
 	"io"
 	"maps"
 	"net/http"
-	"net/url"
 	"slices"
 	"sort"
 	"strings"
 
-	"github.com/avast/retry-go/v4"
 	"github.com/google/go-querystring/query"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
@@ -61,23 +59,20 @@ type Client interface {
 
 // Connection represents a connection to the Proxmox Virtual Environment API.
 type Connection struct {
-	endpoint   string
-	httpClient *http.Client
+	endpoint string
+	// failover is the pool of the endpoints to fail over to, nil if there is only one endpoint
+	failover    *endpointPool
+	httpClient  *http.Client
+	retryPolicy RetryPolicy
+	rateLimiter *rateLimiter
+	nodeTasks   *nodeTaskLimiter
 }
 
 // NewConnection creates and initializes a Connection instance.
-func NewConnection(endpoint string, insecure bool, minTLS string) (*Connection, error) {
-	u, err := url.ParseRequestURI(endpoint)
+func NewConnection(endpoint string, insecure bool, minTLS string, opts ...ConnectionOption) (*Connection, error) {
+	endpoint, err := parseEndpoint(endpoint)
 	if err != nil {
-		return nil, errors.New(
-			"you must specify a valid endpoint for the Proxmox Virtual Environment API (valid: https://host:port/)",
-		)
-	}
-
-	if u.Scheme != "https" {
-		return nil, errors.New(
-			"you must specify a secure endpoint for the Proxmox Virtual Environment API (valid: https://host:port/)",
-		)
+		return nil, err
 	}
 
 	version, err := GetMinTLSVersion(minTLS)
@@ -85,28 +80,80 @@ func NewConnection(endpoint string, insecure bool, minTLS string) (*Connection,
 		return nil, err
 	}
 
-	var transport http.RoundTripper = &http.Transport{
-		Proxy: http.ProxyFromEnvironment,
-		TLSClientConfig: &tls.Config{
-			// deepcode ignore InsecureTLSConfig: the min TLS version is configurable
-			MinVersion:         version,
-			InsecureSkipVerify: insecure, //nolint:gosec
-		},
+	options := &connectionOptions{}
+	for _, opt := range opts {
+		opt.apply(options)
+	}
+
+	if len(options.endpoints) > 0 && options.fingerprint != "" {
+		// each node has its own certificate, so the pinned one would fail the requests to the other endpoints
+		return nil, errors.New("the fingerprint pins the certificate of a single node, and cannot be used with " +
+			"the endpoints to fail over to: use the CA certificates instead")
+	}
+
+	tlsConfig := &tls.Config{
+		// deepcode ignore InsecureTLSConfig: the min TLS version is configurable
+		MinVersion:         version,
+		InsecureSkipVerify: insecure, //nolint:gosec
+	}
+
+	if err := options.configureTLS(tlsConfig); err != nil {
+		return nil, err
+	}
+
+	httpTransport := &http.Transport{
+		Proxy:           http.ProxyFromEnvironment,
+		TLSClientConfig: tlsConfig,
+	}
+
+	if err := options.configureProxy(httpTransport); err != nil {
+		return nil, err
+	}
+
+	var transport http.RoundTripper = httpTransport
+
+	for _, wrap := range options.transportWrappers {
+		transport = wrap(transport)
 	}
 
 	if logging.IsDebugOrHigher() {
 		transport = logging.NewLoggingHTTPTransport(transport)
 	}
 
-	// make sure the path does not contain "/api2/json"
-	u.Path = ""
+	retryPolicy := DefaultRetryPolicy()
+	if options.retryPolicy != nil {
+		retryPolicy = *options.retryPolicy
+	}
 
-	return &Connection{
-		endpoint: strings.TrimRight(u.String(), "/"),
+	conn := &Connection{
+		endpoint: endpoint,
 		httpClient: &http.Client{
 			Transport: transport,
 		},
-	}, nil
+		retryPolicy: retryPolicy,
+		rateLimiter: sharedRateLimiter(endpoint, options.rateLimit),
+		nodeTasks:   sharedNodeTaskLimiter(endpoint, options.nodeTaskConcurrency),
+	}
+
+	if len(options.endpoints) > 0 {
+		endpoints := []string{endpoint}
+
+		for _, e := range options.endpoints {
+			e, err := parseEndpoint(e)
+			if err != nil {
+				return nil, err
+			}
+
+			endpoints = append(endpoints, e)
+		}
+
+		conn.failover, err = newEndpointPool(endpoints)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the endpoints: %w", err)
+		}
+	}
+
+	return conn, nil
 }
 
 // VirtualEnvironmentClient implements an API client for the Proxmox Virtual Environment API.
@@ -233,23 +280,27 @@ func (c *client) DoRequest(
 		)
 	}
 
+	release, err := c.conn.nodeTasks.acquire(ctx, method, modifiedPath)
+	if err != nil {
+		return fmt.Errorf("failed to wait for a node slot for HTTP %s request (path: %s) - Reason: %w",
+			method,
+			modifiedPath,
+			err,
+		)
+	}
+
+	defer func() {
+		if release != nil {
+			release()
+		}
+	}()
+
 	//nolint:bodyclose
-	res, err := retry.DoWithData(
-		func() (*http.Response, error) {
-			return c.conn.httpClient.Do(req)
-		},
-		retry.Context(ctx),
-		retry.RetryIf(func(err error) bool {
-			var urlErr *url.Error
-			if errors.As(err, &urlErr) {
-				return strings.ToUpper(urlErr.Op) == http.MethodGet
-			}
+	res, err := c.conn.do(ctx, req)
+	if err == nil && res.StatusCode == http.StatusUnauthorized {
+		res, err = c.retryUnauthorized(ctx, req, res)
+	}
 
-			return false
-		}),
-		retry.LastErrorOnly(true),
-		retry.Attempts(3),
-	)
 	if err != nil {
 		return fmt.Errorf("failed to perform HTTP %s request (path: %s) - Reason: %w",
 			method,
@@ -265,6 +316,34 @@ func (c *client) DoRequest(
 		return err
 	}
 
+	if upid, polled := c.conn.nodeTasks.isHeld(method, modifiedPath); release != nil || polled {
+		data, err := io.ReadAll(res.Body)
+		if err != nil {
+			return fmt.Errorf(
+				"failed to read HTTP %s response body (path: %s) - Reason: %w",
+				method,
+				modifiedPath,
+				err,
+			)
+		}
+
+		res.Body = io.NopCloser(bytes.NewReader(data))
+
+		// the operation lasts until its task completes, keep the node slot until then
+		if id, ok := taskIDFromResponse(data); ok && release != nil {
+			c.conn.nodeTasks.hold(ctx, id, release)
+			release = nil
+		}
+
+		if polled {
+			if isTaskStopped(data) {
+				c.conn.nodeTasks.complete(upid)
+			} else {
+				c.conn.nodeTasks.refresh(upid)
+			}
+		}
+	}
+
 	//nolint:nestif
 	if responseBody != nil {
 		err = json.NewDecoder(res.Body).Decode(responseBody)
@@ -305,6 +384,52 @@ func (c *client) DoRequest(
 	return nil
 }
 
+// retryUnauthorized sends the request once again with renewed credentials, if the authenticator supports renewing
+// the credentials rejected by the API. Otherwise, the unauthorized response is returned as is.
+func (c *client) retryUnauthorized(ctx context.Context, req *http.Request, res *http.Response) (*http.Response, error) {
+	auth, ok := c.auth.(renewableAuthenticator)
+	if !ok {
+		return res, nil
+	}
+
+	if err := auth.invalidate(ctx, req); err != nil {
+		utils.CloseOrLogError(ctx)(res.Body)
+
+		return nil, err //nolint:wrapcheck
+	}
+
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		// the streamed bodies, e.g. file uploads, cannot be sent again
+		return res, nil
+	}
+
+	utils.CloseOrLogError(ctx)(res.Body)
+
+	tflog.Debug(ctx, "the API rejected the credentials, retrying the request with renewed ones", map[string]any{
+		"method": req.Method,
+		"path":   req.URL.Path,
+	})
+
+	r := req.Clone(ctx)
+	r.Header.Del("Cookie")
+	r.Header.Del("CSRFPreventionToken")
+
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, fmt.Errorf("failed to copy the request body: %w", err)
+		}
+
+		r.Body = body
+	}
+
+	if err := c.auth.AuthenticateRequest(ctx, r); err != nil {
+		return nil, fmt.Errorf("failed to authenticate: %w", err)
+	}
+
+	return c.conn.do(ctx, r)
+}
+
 type dataResponse struct {
 	Data interface{} `json:"data"`
 }
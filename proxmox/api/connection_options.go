@@ -0,0 +1,46 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package api
+
+import (
+	"net/http"
+)
+
+type connectionOptions struct {
+	transportWrappers   []func(http.RoundTripper) http.RoundTripper
+	retryPolicy         *RetryPolicy
+	rateLimit           float64
+	nodeTaskConcurrency int
+	caCertificates      string
+	fingerprint         string
+	clientCertificate   string
+	clientKey           string
+	endpoints           []string
+	proxy               string
+	dialer              DialContextFunc
+}
+
+// ConnectionOption is an option for creating a connection to the Proxmox VE API.
+type ConnectionOption interface {
+	apply(opts *connectionOptions)
+}
+
+type withTransport struct {
+	wrap func(http.RoundTripper) http.RoundTripper
+}
+
+// WithTransport is an option to customize the HTTP transport of the connection. The function receives the
+// default transport and returns the one to use instead, e.g. a wrapper that records the interactions, or
+// a transport that replays them without calling the API. The options are applied in order, the debug
+// logging transport is always the outermost one.
+func WithTransport(wrap func(http.RoundTripper) http.RoundTripper) ConnectionOption {
+	return withTransport{wrap: wrap}
+}
+
+func (w withTransport) apply(opts *connectionOptions) {
+	opts.transportWrappers = append(opts.transportWrappers, w.wrap)
+}
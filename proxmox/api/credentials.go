@@ -35,6 +35,9 @@ type UserCredentials struct {
 	Username string
 	Password string
 	OTP      string
+	// TOTPSecret is the base32 secret of the TOTP second factor of the user, the codes are generated from it
+	// when logging in. It takes precedence over OTP.
+	TOTPSecret string
 }
 
 // TokenCredentials contains the API token for authenticating with the Proxmox VE API.
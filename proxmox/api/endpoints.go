@@ -0,0 +1,185 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package api
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// endpointDownPeriod is how long an endpoint that failed is not used, if there are other endpoints.
+const endpointDownPeriod = time.Minute
+
+type withEndpoints struct {
+	endpoints []string
+}
+
+// WithEndpoints is an option to set the endpoints of the other cluster nodes, to fail over to when the endpoint
+// of the connection is not reachable. The requests are sent to the first healthy endpoint, in order, starting
+// with the endpoint of the connection. An endpoint is unhealthy for a minute after a connection error,
+// a `502`, `503` or `504` response, e.g. from a load balancer, or a `595` or `596` response of the API proxy.
+func WithEndpoints(endpoints ...string) ConnectionOption {
+	return withEndpoints{endpoints: endpoints}
+}
+
+func (w withEndpoints) apply(opts *connectionOptions) {
+	opts.endpoints = append(opts.endpoints, w.endpoints...)
+}
+
+// parseEndpoint validates the endpoint, and returns it without the trailing slash and path.
+func parseEndpoint(endpoint string) (string, error) {
+	u, err := url.ParseRequestURI(endpoint)
+	if err != nil {
+		return "", errors.New(
+			"you must specify a valid endpoint for the Proxmox Virtual Environment API (valid: https://host:port/)",
+		)
+	}
+
+	if u.Scheme != "https" {
+		return "", errors.New(
+			"you must specify a secure endpoint for the Proxmox Virtual Environment API (valid: https://host:port/)",
+		)
+	}
+
+	// make sure the path does not contain "/api2/json"
+	u.Path = ""
+
+	return strings.TrimRight(u.String(), "/"), nil
+}
+
+// endpointPool tracks the health of the endpoints of a connection.
+type endpointPool struct {
+	mu        sync.Mutex
+	endpoints []*url.URL
+	downUntil []time.Time
+}
+
+func newEndpointPool(endpoints []string) (*endpointPool, error) {
+	p := &endpointPool{downUntil: make([]time.Time, 0, len(endpoints))}
+
+	for _, e := range endpoints {
+		u, err := url.Parse(e)
+		if err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+
+		if !p.contains(u) {
+			p.endpoints = append(p.endpoints, u)
+			p.downUntil = append(p.downUntil, time.Time{})
+		}
+	}
+
+	return p, nil
+}
+
+func (p *endpointPool) contains(u *url.URL) bool {
+	for _, e := range p.endpoints {
+		if e.Host == u.Host {
+			return true
+		}
+	}
+
+	return false
+}
+
+// pick returns the first healthy endpoint, or the one that is down for the shortest time if all of them are down.
+func (p *endpointPool) pick() *url.URL {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	best := 0
+
+	for i, until := range p.downUntil {
+		if !now.Before(until) {
+			return p.endpoints[i]
+		}
+
+		if until.Before(p.downUntil[best]) {
+			best = i
+		}
+	}
+
+	return p.endpoints[best]
+}
+
+// markDown marks the endpoint as unhealthy, so the next requests fail over to another one.
+func (p *endpointPool) markDown(ctx context.Context, endpoint *url.URL, reason string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for i, e := range p.endpoints {
+		if e.Host == endpoint.Host {
+			tflog.Warn(ctx, "API endpoint is unhealthy, failing over to another endpoint", map[string]any{
+				"endpoint": e.String(),
+				"reason":   reason,
+			})
+
+			p.downUntil[i] = time.Now().Add(endpointDownPeriod)
+		}
+	}
+}
+
+// failoverError is returned by an attempt that failed because of the endpoint, it is retried with another one
+// without a delay.
+type failoverError struct {
+	err error
+}
+
+func (e *failoverError) Error() string {
+	return e.err.Error()
+}
+
+func (e *failoverError) Unwrap() error {
+	return e.err
+}
+
+// isEndpointError returns true if the request failed because the endpoint is not reachable. Only the errors
+// happening before the request is sent are considered for the requests that are not read-only.
+func isEndpointError(method string, err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) && isReadOnlyMethod(method) {
+		var certErr *tls.CertificateVerificationError
+
+		// the certificate errors are not specific to the endpoint, they are reported as is
+		return !errors.As(err, &certErr)
+	}
+
+	return false
+}
+
+// isEndpointFailure returns true if the response is an error of a load balancer or proxy in front of the API,
+// or of the API proxy failing to connect to its node. The requests that are not read-only may have reached
+// the API before a `502`, `504` or `596` response, so only the `503` and `595` responses are considered for them.
+func isEndpointFailure(method string, res *http.Response) bool {
+	switch res.StatusCode {
+	case http.StatusServiceUnavailable, statusProxyConnectionFailed:
+		return true
+	case http.StatusBadGateway, http.StatusGatewayTimeout, statusProxyConnectionTimeout:
+		return isReadOnlyMethod(method)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,115 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/fakepve"
+)
+
+func TestConnectionFailover(t *testing.T) {
+	t.Parallel()
+
+	s := fakepve.NewServer()
+	t.Cleanup(s.Close)
+
+	// an endpoint that is not reachable
+	down := httptest.NewTLSServer(http.NotFoundHandler())
+	down.Close()
+
+	// an endpoint behind a load balancer that has no healthy backend
+	var unavailableHits atomic.Int32
+
+	unavailable := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		unavailableHits.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(unavailable.Close)
+
+	conn, err := NewConnection(down.URL, true, "",
+		WithEndpoints(unavailable.URL, s.Endpoint()),
+		WithRetryPolicy(RetryPolicy{MaxAttempts: 1}),
+	)
+	require.NoError(t, err)
+
+	creds, err := NewCredentials(fakepve.DefaultUsername, fakepve.DefaultPassword, "", "", "", "")
+	require.NoError(t, err)
+
+	c, err := NewClient(creds, conn)
+	require.NoError(t, err)
+
+	ctx := t.Context()
+
+	// the login and the request fail over to the healthy endpoint
+	require.NoError(t, c.DoRequest(ctx, http.MethodPost, "access/users", &struct {
+		ID string `url:"userid"`
+	}{ID: "test@pve"}, nil))
+	assert.Equal(t, int32(1), unavailableHits.Load())
+
+	// the unhealthy endpoints are skipped by the next requests
+	require.NoError(t, c.DoRequest(ctx, http.MethodGet, "version", nil, nil))
+	assert.Equal(t, int32(1), unavailableHits.Load())
+	assert.Equal(t, s.Endpoint(), conn.failover.pick().String()+"/")
+}
+
+func TestIsEndpointFailure(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		method string
+		status int
+		want   bool
+	}{
+		{http.MethodGet, http.StatusOK, false},
+		{http.MethodGet, http.StatusInternalServerError, false},
+		{http.MethodGet, http.StatusBadGateway, true},
+		{http.MethodPost, http.StatusBadGateway, false},
+		{http.MethodGet, http.StatusServiceUnavailable, true},
+		{http.MethodPost, http.StatusServiceUnavailable, true},
+		{http.MethodGet, http.StatusGatewayTimeout, true},
+		{http.MethodPost, http.StatusGatewayTimeout, false},
+		{http.MethodGet, statusProxyConnectionFailed, true},
+		{http.MethodPost, statusProxyConnectionFailed, true},
+		{http.MethodGet, statusProxyConnectionTimeout, true},
+		{http.MethodPost, statusProxyConnectionTimeout, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s %d", tt.method, tt.status), func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, isEndpointFailure(tt.method, &http.Response{StatusCode: tt.status}))
+		})
+	}
+}
+
+func TestEndpointPool(t *testing.T) {
+	t.Parallel()
+
+	p, err := newEndpointPool([]string{"https://pve1:8006", "https://pve2:8006", "https://pve1:8006"})
+	require.NoError(t, err)
+	require.Len(t, p.endpoints, 2)
+
+	ctx := t.Context()
+
+	assert.Equal(t, "pve1:8006", p.pick().Host)
+
+	p.markDown(ctx, p.endpoints[0], "down")
+	assert.Equal(t, "pve2:8006", p.pick().Host)
+
+	// if all the endpoints are down, the one that recovers first is used
+	p.markDown(ctx, p.endpoints[1], "down")
+	assert.Equal(t, "pve1:8006", p.pick().Host)
+}
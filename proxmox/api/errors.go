@@ -23,6 +23,9 @@ const ErrNoDataObjectInResponse Error = "the server did not include a data objec
 // ErrResourceDoesNotExist is returned when the requested resource does not exist.
 const ErrResourceDoesNotExist Error = "the requested resource does not exist"
 
+// ErrTicketExpired is returned when the authentication ticket passed to the provider has expired.
+const ErrTicketExpired Error = "the authentication ticket has expired"
+
 // HTTPError is a generic error type for HTTP errors.
 type HTTPError struct {
 	Code    int
@@ -0,0 +1,346 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// nodeTaskSlotIdleTimeout is the time after which the slot of a task is released if its status has not been polled,
+// e.g. if the caller did not wait for the task. WaitForTask polls the status at least every few seconds.
+const nodeTaskSlotIdleTimeout = 30 * time.Second
+
+// limiterKey identifies the limiters shared by the connections to the same endpoint with the same limit.
+type limiterKey struct {
+	endpoint string
+	limit    float64
+}
+
+// sharedLimiters holds the limiters of all the connections, so the connections to the same endpoint with the same
+// limits share them, e.g. the ones of the two providers configured from the same provider block.
+var sharedLimiters = struct {
+	mu        sync.Mutex
+	rate      map[limiterKey]*rateLimiter
+	nodeTasks map[limiterKey]*nodeTaskLimiter
+}{
+	rate:      map[limiterKey]*rateLimiter{},
+	nodeTasks: map[limiterKey]*nodeTaskLimiter{},
+}
+
+// sharedRateLimiter returns the rate limiter of the endpoint with the given limit, creating it if needed.
+func sharedRateLimiter(endpoint string, requestsPerSecond float64) *rateLimiter {
+	if requestsPerSecond <= 0 {
+		return nil
+	}
+
+	sharedLimiters.mu.Lock()
+	defer sharedLimiters.mu.Unlock()
+
+	key := limiterKey{endpoint: endpoint, limit: requestsPerSecond}
+
+	l, ok := sharedLimiters.rate[key]
+	if !ok {
+		l = newRateLimiter(requestsPerSecond)
+		sharedLimiters.rate[key] = l
+	}
+
+	return l
+}
+
+// sharedNodeTaskLimiter returns the node task limiter of the endpoint with the given limit, creating it if needed.
+func sharedNodeTaskLimiter(endpoint string, maxTasks int) *nodeTaskLimiter {
+	if maxTasks <= 0 {
+		return nil
+	}
+
+	sharedLimiters.mu.Lock()
+	defer sharedLimiters.mu.Unlock()
+
+	key := limiterKey{endpoint: endpoint, limit: float64(maxTasks)}
+
+	l, ok := sharedLimiters.nodeTasks[key]
+	if !ok {
+		l = newNodeTaskLimiter(maxTasks)
+		sharedLimiters.nodeTasks[key] = l
+	}
+
+	return l
+}
+
+type withRateLimit struct {
+	requestsPerSecond float64
+}
+
+// WithRateLimit is an option to limit the rate of the API requests, including their retries. The requests
+// exceeding the limit are delayed. The limit is shared by the connections to the same endpoint with the same limit.
+// A limit of zero or less disables the rate limiting.
+func WithRateLimit(requestsPerSecond float64) ConnectionOption {
+	return withRateLimit{requestsPerSecond: requestsPerSecond}
+}
+
+func (w withRateLimit) apply(opts *connectionOptions) {
+	opts.rateLimit = w.requestsPerSecond
+}
+
+type withNodeTaskConcurrency struct {
+	maxTasks int
+}
+
+// WithNodeTaskConcurrency is an option to limit the number of concurrent operations on each node. An operation is
+// any request modifying a resource of a node, e.g. a VM clone. When the request starts a task, the operation lasts
+// until the task is seen completing by WaitForTask. The requests exceeding the limit wait for an operation
+// on the same node to complete. The limit is shared by the connections to the same endpoint with the same limit.
+// A limit of zero or less disables the limiting.
+func WithNodeTaskConcurrency(maxTasks int) ConnectionOption {
+	return withNodeTaskConcurrency{maxTasks: maxTasks}
+}
+
+func (w withNodeTaskConcurrency) apply(opts *connectionOptions) {
+	opts.nodeTaskConcurrency = w.maxTasks
+}
+
+// rateLimiter spaces the requests evenly, so there is at most one request per interval.
+type rateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+func newRateLimiter(requestsPerSecond float64) *rateLimiter {
+	if requestsPerSecond <= 0 {
+		return nil
+	}
+
+	return &rateLimiter{interval: time.Duration(float64(time.Second) / requestsPerSecond)}
+}
+
+// wait blocks until the request is allowed to be sent, or the context is canceled.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+
+	slot := l.next
+	l.next = l.next.Add(l.interval)
+
+	l.mu.Unlock()
+
+	delay := time.Until(slot)
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err() //nolint:wrapcheck
+	}
+}
+
+// nodeTaskLimiter limits the number of concurrent operations on each node.
+type nodeTaskLimiter struct {
+	max         int
+	idleTimeout time.Duration
+
+	mu    sync.Mutex
+	slots map[string]chan struct{}
+	tasks map[string]*heldTask
+}
+
+// heldTask is a task holding the slot of its operation.
+type heldTask struct {
+	release func()
+	timer   *time.Timer
+}
+
+func newNodeTaskLimiter(maxTasks int) *nodeTaskLimiter {
+	if maxTasks <= 0 {
+		return nil
+	}
+
+	return &nodeTaskLimiter{
+		max:         maxTasks,
+		idleTimeout: nodeTaskSlotIdleTimeout,
+		slots:       map[string]chan struct{}{},
+		tasks:       map[string]*heldTask{},
+	}
+}
+
+// acquire waits for a free slot of the node targeted by the request, if the request modifies a resource of the node.
+// The returned function releases the slot, and is a no-op if no slot was acquired.
+func (l *nodeTaskLimiter) acquire(ctx context.Context, method, path string) (func(), error) {
+	if l == nil || method == http.MethodGet || method == http.MethodHead {
+		return nil, nil
+	}
+
+	node, rest, ok := splitNodePath(path)
+	if !ok || rest == "tasks" || strings.HasPrefix(rest, "tasks/") {
+		return nil, nil
+	}
+
+	l.mu.Lock()
+
+	slots, ok := l.slots[node]
+	if !ok {
+		slots = make(chan struct{}, l.max)
+		l.slots[node] = slots
+	}
+
+	l.mu.Unlock()
+
+	select {
+	case slots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err() //nolint:wrapcheck
+	}
+
+	var once sync.Once
+
+	return func() { once.Do(func() { <-slots }) }, nil
+}
+
+// hold keeps the slot of an operation until its task is seen completing, or until its status has not been polled
+// for the idle timeout.
+func (l *nodeTaskLimiter) hold(ctx context.Context, upid string, release func()) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// the timer is started while holding the lock, so the task is stored before it can be released
+	timer := time.AfterFunc(l.idleTimeout, func() {
+		tflog.Debug(ctx, "releasing the node slot of a task not polled", map[string]any{"upid": upid})
+		l.complete(upid)
+	})
+
+	l.tasks[upid] = &heldTask{release: release, timer: timer}
+}
+
+// refresh restarts the idle timeout of the task holding a slot, if any.
+func (l *nodeTaskLimiter) refresh(upid string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if t, ok := l.tasks[upid]; ok {
+		t.timer.Reset(l.idleTimeout)
+	}
+}
+
+// complete releases the slot held by the task, if any.
+func (l *nodeTaskLimiter) complete(upid string) {
+	l.mu.Lock()
+	t, ok := l.tasks[upid]
+	delete(l.tasks, upid)
+	l.mu.Unlock()
+
+	if ok {
+		t.timer.Stop()
+		t.release()
+	}
+}
+
+// isHeld returns the task ID if the request is a status poll of a task holding a slot.
+func (l *nodeTaskLimiter) isHeld(method, path string) (string, bool) {
+	if l == nil || method != http.MethodGet {
+		return "", false
+	}
+
+	_, rest, ok := splitNodePath(path)
+	if !ok {
+		return "", false
+	}
+
+	parts := strings.Split(rest, "/")
+	if len(parts) != 3 || parts[0] != "tasks" || parts[2] != "status" {
+		return "", false
+	}
+
+	upid, err := url.PathUnescape(parts[1])
+	if err != nil {
+		return "", false
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	_, ok = l.tasks[upid]
+
+	return upid, ok
+}
+
+// splitNodePath splits a `nodes/<node>/...` path into the node name and the rest of the path.
+func splitNodePath(path string) (string, string, bool) {
+	path, _, _ = strings.Cut(strings.TrimPrefix(path, "/"), "?")
+
+	rest, ok := strings.CutPrefix(path, "nodes/")
+	if !ok {
+		return "", "", false
+	}
+
+	node, rest, ok := strings.Cut(rest, "/")
+	if !ok || node == "" {
+		return "", "", false
+	}
+
+	node, err := url.PathUnescape(node)
+	if err != nil {
+		return "", "", false
+	}
+
+	return node, rest, true
+}
+
+// taskIDFromResponse returns the task ID from the body of a response of a request that started a task.
+func taskIDFromResponse(body []byte) (string, bool) {
+	res := struct {
+		Data any `json:"data"`
+	}{}
+
+	if err := json.NewDecoder(bytes.NewReader(body)).Decode(&res); err != nil {
+		return "", false
+	}
+
+	upid, ok := res.Data.(string)
+	if !ok || !strings.HasPrefix(upid, "UPID:") {
+		return "", false
+	}
+
+	return upid, true
+}
+
+// isTaskStopped returns true if the body of a task status response reports the task is no longer running.
+func isTaskStopped(body []byte) bool {
+	res := struct {
+		Data *struct {
+			Status string `json:"status"`
+		} `json:"data"`
+	}{}
+
+	if err := json.NewDecoder(bytes.NewReader(body)).Decode(&res); err != nil {
+		return false
+	}
+
+	return res.Data != nil && res.Data.Status != "" && res.Data.Status != "running"
+}
@@ -0,0 +1,183 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package api
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, newRateLimiter(0))
+	require.NoError(t, newRateLimiter(0).wait(t.Context()))
+
+	l := newRateLimiter(50)
+	start := time.Now()
+
+	for range 5 {
+		require.NoError(t, l.wait(t.Context()))
+	}
+
+	// the first request is not delayed, the next ones are 20ms apart
+	assert.GreaterOrEqual(t, time.Since(start), 80*time.Millisecond)
+}
+
+func TestNodeTaskConcurrency(t *testing.T) {
+	t.Parallel()
+
+	const upid = "UPID:pve:000A1B2C:0001E240:66000000:qmclone:100:root@pam:"
+
+	var (
+		started atomic.Int32
+		stopped atomic.Bool
+	)
+
+	transport := RoundTripFunc(func(req *http.Request) *http.Response {
+		body := `{"data":null}`
+
+		switch {
+		case req.Method == http.MethodPost && strings.HasSuffix(req.URL.Path, "/clone"):
+			started.Add(1)
+
+			// the task IDs are unique, even across the nodes
+			node := strings.Split(req.URL.Path, "/")[4]
+			body = `{"data":"` + strings.Replace(upid, ":pve:", ":"+node+":", 1) + `"}`
+		case strings.HasSuffix(req.URL.Path, "/status"):
+			if stopped.Load() {
+				body = `{"data":{"status":"stopped","exitstatus":"OK"}}`
+			} else {
+				body = `{"data":{"status":"running"}}`
+			}
+		}
+
+		return &http.Response{
+			Status:     "200 OK",
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{},
+		}
+	})
+
+	conn, err := NewConnection("https://pve.example.com:8006/", true, "",
+		WithTransport(func(http.RoundTripper) http.RoundTripper { return transport }),
+		WithNodeTaskConcurrency(1),
+	)
+	require.NoError(t, err)
+
+	c := &client{conn: conn, auth: dummyAuthenticator{}}
+	ctx := t.Context()
+	statusPath := "nodes/pve/tasks/" + url.PathEscape(upid) + "/status"
+
+	require.NoError(t, c.DoRequest(ctx, http.MethodPost, "nodes/pve/qemu/100/clone", nil, nil))
+
+	// the other nodes are not limited
+	require.NoError(t, c.DoRequest(ctx, http.MethodPost, "nodes/pve2/qemu/200/clone", nil, nil))
+
+	done := make(chan error)
+
+	go func() {
+		done <- c.DoRequest(ctx, http.MethodPost, "nodes/pve/qemu/101/clone", nil, nil)
+	}()
+
+	// the second clone on the node waits for the first task to complete
+	require.NoError(t, c.DoRequest(ctx, http.MethodGet, statusPath, nil, nil))
+	assert.Never(t, func() bool { return started.Load() > 2 }, 100*time.Millisecond, 10*time.Millisecond)
+
+	stopped.Store(true)
+	require.NoError(t, c.DoRequest(ctx, http.MethodGet, statusPath, nil, nil))
+	require.NoError(t, <-done)
+	assert.Equal(t, int32(3), started.Load())
+}
+
+func TestSharedLimiters(t *testing.T) {
+	t.Parallel()
+
+	newConn := func(endpoint string, limit int) *Connection {
+		conn, err := NewConnection(endpoint, true, "",
+			WithRateLimit(float64(limit)),
+			WithNodeTaskConcurrency(limit),
+		)
+		require.NoError(t, err)
+
+		return conn
+	}
+
+	conn := newConn("https://shared.example.com:8006/", 7)
+
+	// the connections of the two providers configured from the same provider block share the limiters
+	other := newConn("https://shared.example.com:8006", 7)
+	assert.Same(t, conn.rateLimiter, other.rateLimiter)
+	assert.Same(t, conn.nodeTasks, other.nodeTasks)
+
+	other = newConn("https://shared.example.com:8006", 8)
+	assert.NotSame(t, conn.rateLimiter, other.rateLimiter)
+	assert.NotSame(t, conn.nodeTasks, other.nodeTasks)
+
+	other = newConn("https://other.example.com:8006", 7)
+	assert.NotSame(t, conn.rateLimiter, other.rateLimiter)
+	assert.NotSame(t, conn.nodeTasks, other.nodeTasks)
+
+	other = newConn("https://shared.example.com:8006", 0)
+	assert.Nil(t, other.rateLimiter)
+	assert.Nil(t, other.nodeTasks)
+}
+
+func TestNodeTaskSlotIdleTimeout(t *testing.T) {
+	t.Parallel()
+
+	const (
+		upid        = "UPID:pve:000A1B2C:0001E240:66000000:qmclone:100:root@pam:"
+		idleTimeout = 100 * time.Millisecond
+	)
+
+	l := newNodeTaskLimiter(1)
+	l.idleTimeout = idleTimeout
+
+	ctx := t.Context()
+
+	release, err := l.acquire(ctx, http.MethodPost, "nodes/pve/qemu/100/clone")
+	require.NoError(t, err)
+
+	l.hold(ctx, upid, release)
+
+	acquired := make(chan struct{})
+
+	go func() {
+		if _, err := l.acquire(ctx, http.MethodPost, "nodes/pve/qemu/101/clone"); err == nil {
+			close(acquired)
+		}
+	}()
+
+	// the slot is kept while the task status is polled
+	for range 4 {
+		time.Sleep(idleTimeout / 2)
+		l.refresh(upid)
+	}
+
+	select {
+	case <-acquired:
+		require.Fail(t, "the slot of a polled task was released")
+	default:
+	}
+
+	// and released once the polling stops
+	select {
+	case <-acquired:
+	case <-time.After(10 * idleTimeout):
+		require.Fail(t, "the slot of an idle task was not released")
+	}
+}
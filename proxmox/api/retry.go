@@ -0,0 +1,291 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/avast/retry-go/v4"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// DefaultRetryMaxAttempts is the default maximum number of attempts of the API requests.
+	DefaultRetryMaxAttempts = 3
+
+	// DefaultRetryBackoff is the default initial delay between the attempts of the API requests.
+	DefaultRetryBackoff = time.Second
+
+	// maxRetryDelay caps the exponential backoff between the attempts.
+	maxRetryDelay = 30 * time.Second
+
+	// statusProxyConnectionFailed is returned by the API proxy when it cannot connect to the node, so the request
+	// has not been processed.
+	statusProxyConnectionFailed = 595
+
+	// statusProxyConnectionTimeout is returned by the API proxy when the connection to the node times out,
+	// the request may have been processed.
+	statusProxyConnectionTimeout = 596
+)
+
+// DefaultRetryStatusCodes returns the HTTP status codes of the API responses that are retried by default:
+//   - 500 with the `got timeout` reason, returned when the node does not respond in time,
+//   - 502, 503 and 504, returned by the load balancers and reverse proxies in front of the API,
+//   - 595 and 596, returned by the API proxy when the connection to the node fails.
+//
+// Only the read-only requests are retried for all of them, see RetryPolicy.StatusCodes.
+func DefaultRetryStatusCodes() []int {
+	return []int{
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout,
+		statusProxyConnectionFailed,
+		statusProxyConnectionTimeout,
+	}
+}
+
+// RetryPolicy configures how the API requests that fail with a transient error are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts of a request, including the first one.
+	MaxAttempts int
+	// Backoff is the delay before the second attempt. It doubles with each attempt, and a random jitter of
+	// up to the same duration is added.
+	Backoff time.Duration
+	// StatusCodes are the HTTP status codes of the responses to retry. The `500 Internal Server Error` responses
+	// are only retried if the reason is `got timeout`, as the API returns this status for most of its errors.
+	// The requests that are not read-only may have been processed by the node before the other errors, so they
+	// are only retried on `595`, returned by the API proxy when it cannot connect to the node.
+	StatusCodes []int
+}
+
+// DefaultRetryPolicy returns the retry policy used when none is configured.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: DefaultRetryMaxAttempts,
+		Backoff:     DefaultRetryBackoff,
+		StatusCodes: DefaultRetryStatusCodes(),
+	}
+}
+
+type withRetryPolicy struct {
+	policy RetryPolicy
+}
+
+// WithRetryPolicy is an option to set the retry policy of the API requests.
+func WithRetryPolicy(policy RetryPolicy) ConnectionOption {
+	return withRetryPolicy{policy: policy}
+}
+
+func (w withRetryPolicy) apply(opts *connectionOptions) {
+	opts.retryPolicy = &w.policy
+}
+
+// retryableResponseError is returned by an attempt that received a response with a retryable status code.
+// The response is kept, so it can be returned as is if there are no attempts left.
+type retryableResponseError struct {
+	res *http.Response
+	// failover is true if the response is a failure of the endpoint, and the request is sent to another one
+	failover bool
+}
+
+func (e *retryableResponseError) Error() string {
+	return fmt.Sprintf("received a retryable HTTP response: %s", e.res.Status)
+}
+
+func (p *RetryPolicy) isRetryableResponse(method string, res *http.Response) bool {
+	if !slices.Contains(p.StatusCodes, res.StatusCode) {
+		return false
+	}
+
+	if !isReadOnlyMethod(method) {
+		return res.StatusCode == statusProxyConnectionFailed
+	}
+
+	if res.StatusCode == http.StatusInternalServerError {
+		return strings.Contains(res.Status, "got timeout")
+	}
+
+	return true
+}
+
+// do performs the request, retrying it according to the retry policy of the connection. The network errors are
+// only retried for the read-only requests, as the other ones may have been received by the API. If the connection
+// has several endpoints, the requests failing because of the endpoint are sent again to the next healthy one
+// without a delay, these attempts are not counted in the retry policy.
+func (c *Connection) do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	policy := c.retryPolicy
+
+	attempts := policy.MaxAttempts
+	if attempts < 1 || (req.Body != nil && req.Body != http.NoBody && req.GetBody == nil) {
+		// the streamed bodies, e.g. file uploads, cannot be sent again
+		attempts = 1
+	} else if c.failover != nil {
+		attempts += len(c.failover.endpoints) - 1
+	}
+
+	opts := []retry.Option{
+		retry.Context(ctx),
+		retry.Attempts(uint(attempts)), //nolint:gosec
+		retry.LastErrorOnly(true),
+		retry.RetryIf(isRetryableError),
+		retry.OnRetry(func(n uint, err error) {
+			tflog.Warn(ctx, "retrying API request", map[string]any{
+				"method":  req.Method,
+				"path":    req.URL.Path,
+				"attempt": n + 1,
+				"error":   err.Error(),
+			})
+		}),
+	}
+
+	delayType := retry.FixedDelay
+
+	if policy.Backoff > 0 {
+		delayType = retry.CombineDelay(retry.BackOffDelay, retry.RandomDelay)
+
+		opts = append(opts,
+			retry.Delay(policy.Backoff),
+			retry.MaxJitter(policy.Backoff),
+			retry.MaxDelay(maxRetryDelay),
+		)
+	} else {
+		opts = append(opts, retry.Delay(0))
+	}
+
+	opts = append(opts, retry.DelayType(func(n uint, err error, config *retry.Config) time.Duration {
+		if isFailover(err) {
+			return 0
+		}
+
+		return delayType(n, err, config)
+	}))
+
+	first := true
+
+	//nolint:bodyclose
+	res, err := retry.DoWithData(
+		func() (*http.Response, error) {
+			r := req
+
+			if !first && req.GetBody != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, retry.Unrecoverable(fmt.Errorf("failed to copy the request body: %w", err))
+				}
+
+				r = req.Clone(ctx)
+				r.Body = body
+			}
+
+			first = false
+
+			var endpoint *url.URL
+
+			if c.failover != nil {
+				endpoint = c.failover.pick()
+
+				if r.URL.Host != endpoint.Host {
+					if r == req {
+						r = req.Clone(ctx)
+					}
+
+					r.URL.Scheme, r.URL.Host, r.Host = endpoint.Scheme, endpoint.Host, ""
+				}
+			}
+
+			if err := c.rateLimiter.wait(ctx); err != nil {
+				return nil, retry.Unrecoverable(err)
+			}
+
+			res, err := c.httpClient.Do(r)
+			if err != nil {
+				if endpoint != nil && isEndpointError(r.Method, err) {
+					c.failover.markDown(ctx, endpoint, err.Error())
+
+					return nil, &failoverError{err: err}
+				}
+
+				return nil, err //nolint:wrapcheck
+			}
+
+			failover := endpoint != nil && isEndpointFailure(r.Method, res)
+			if failover {
+				c.failover.markDown(ctx, endpoint, res.Status)
+			}
+
+			if !failover && !policy.isRetryableResponse(r.Method, res) {
+				return res, nil
+			}
+
+			// keep the body of the response, it is needed if this is the last attempt
+			body, err := io.ReadAll(res.Body)
+			_ = res.Body.Close()
+
+			if err != nil {
+				return nil, fmt.Errorf("failed to read the response body: %w", err)
+			}
+
+			res.Body = io.NopCloser(bytes.NewReader(body))
+
+			return nil, &retryableResponseError{res: res, failover: failover}
+		},
+		opts...,
+	)
+
+	var resErr *retryableResponseError
+	if errors.As(err, &resErr) {
+		return resErr.res, nil
+	}
+
+	return res, err //nolint:wrapcheck
+}
+
+func isRetryableError(err error) bool {
+	if isFailover(err) {
+		return true
+	}
+
+	var resErr *retryableResponseError
+	if errors.As(err, &resErr) {
+		return true
+	}
+
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return isReadOnlyMethod(strings.ToUpper(urlErr.Op))
+	}
+
+	return false
+}
+
+// isReadOnlyMethod returns true if the requests with the given method do not change anything, so they can be
+// sent again safely.
+func isReadOnlyMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead
+}
+
+// isFailover returns true if the attempt failed because of the endpoint, and is sent again to another one.
+func isFailover(err error) bool {
+	var foErr *failoverError
+	if errors.As(err, &foErr) {
+		return true
+	}
+
+	var resErr *retryableResponseError
+
+	return errors.As(err, &resErr) && resErr.failover
+}
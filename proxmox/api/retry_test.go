@@ -0,0 +1,109 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDoRequestRetry(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		method    string
+		statuses  []string
+		wantCalls int
+		wantErr   string
+	}{
+		{"success", http.MethodGet, []string{"200 OK"}, 1, ""},
+		{"retried proxy error", http.MethodGet, []string{"596 Broken pipe", "200 OK"}, 2, ""},
+		{"retried timeout", http.MethodGet, []string{"500 got timeout", "595 Connection timed out", "200 OK"}, 3, ""},
+		{"attempts exhausted", http.MethodGet,
+			[]string{"503 Service Unavailable", "503 Service Unavailable", "503 Service Unavailable"}, 3,
+			"received an HTTP 503 response - Reason: Service Unavailable"},
+		{"not retried error", http.MethodGet, []string{"500 VM 100 already running", "200 OK"}, 1,
+			"received an HTTP 500 response - Reason: VM 100 already running"},
+		{"retried connection failure", http.MethodPost, []string{"595 Connection refused", "200 OK"}, 2, ""},
+		{"not retried timeout", http.MethodPost, []string{"500 got timeout", "200 OK"}, 1,
+			"received an HTTP 500 response - Reason: got timeout"},
+		{"not retried proxy error", http.MethodPost, []string{"596 Broken pipe", "200 OK"}, 1,
+			"received an HTTP 596 response - Reason: Broken pipe"},
+		{"not retried gateway error", http.MethodPost, []string{"504 Gateway Timeout", "200 OK"}, 1,
+			"received an HTTP 504 response - Reason: Gateway Timeout"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var requests []string
+
+			transport := RoundTripFunc(func(req *http.Request) *http.Response {
+				var body []byte
+
+				if req.Body != nil {
+					var err error
+
+					body, err = io.ReadAll(req.Body)
+					require.NoError(t, err)
+				}
+
+				requests = append(requests, req.URL.RawQuery+string(body))
+				status := tt.statuses[len(requests)-1]
+
+				code, err := strconv.Atoi(strings.Fields(status)[0])
+				require.NoError(t, err)
+
+				return &http.Response{
+					Status:     status,
+					StatusCode: code,
+					Body:       io.NopCloser(strings.NewReader(`{"data":null}`)),
+					Header:     http.Header{},
+				}
+			})
+
+			conn, err := NewConnection("https://pve.example.com:8006/", true, "",
+				WithTransport(func(http.RoundTripper) http.RoundTripper { return transport }),
+				WithRetryPolicy(RetryPolicy{
+					MaxAttempts: 3,
+					Backoff:     time.Millisecond,
+					StatusCodes: DefaultRetryStatusCodes(),
+				}),
+			)
+			require.NoError(t, err)
+
+			c := &client{conn: conn, auth: dummyAuthenticator{}}
+
+			err = c.DoRequest(context.Background(), tt.method, "nodes/pve/qemu", &struct {
+				VMID int `url:"vmid"`
+			}{VMID: 100}, nil)
+
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Len(t, requests, tt.wantCalls)
+
+			// the request parameters are sent again with each attempt
+			for _, r := range requests {
+				assert.Equal(t, "vmid=100", r)
+			}
+		})
+	}
+}
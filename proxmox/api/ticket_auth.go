@@ -9,12 +9,20 @@ package api
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 )
 
+// ticketLifetime is the time the tickets issued by the API are valid for.
+const ticketLifetime = 2 * time.Hour
+
 type ticketAuthenticator struct {
 	authData *AuthenticationResponseData
+	// expiresAt is the expiry time of the ticket, zero if it cannot be determined from the ticket
+	expiresAt time.Time
 }
 
 // NewTicketAuthenticator returns a new ticket authenticator.
@@ -35,9 +43,34 @@ func NewTicketAuthenticator(creds TicketCredentials) (Authenticator, error) {
 		return nil, errors.New("username must end with '@pve' or '@pam'")
 	}
 
-	return &ticketAuthenticator{
+	t := &ticketAuthenticator{
 		authData: ard,
-	}, nil
+	}
+
+	// the ticket is `PVE:<username>:<hex timestamp>::<signature>`
+	if issuedAt, err := strconv.ParseInt(authTicketSplits[2], 16, 64); err == nil {
+		t.expiresAt = time.Unix(issuedAt, 0).Add(ticketLifetime)
+	}
+
+	return t, nil
+}
+
+func (t *ticketAuthenticator) expiredError() error {
+	return fmt.Errorf(
+		"%w at %s, the tickets are valid for %s: create a new ticket, "+
+			"or use an API token or the username and password for the long-running operations",
+		ErrTicketExpired, t.expiresAt.Format(time.RFC3339), ticketLifetime,
+	)
+}
+
+// invalidate returns an error explaining the ticket cannot be renewed, as it was passed to the provider.
+func (t *ticketAuthenticator) invalidate(_ context.Context, _ *http.Request) error {
+	if !t.expiresAt.IsZero() && !time.Now().Before(t.expiresAt) {
+		return t.expiredError()
+	}
+
+	return errors.New("the authentication ticket was rejected, and cannot be renewed: " +
+		"create a new ticket, or use an API token or the username and password instead")
 }
 
 func (t *ticketAuthenticator) IsRoot(_ context.Context) bool {
@@ -50,9 +83,13 @@ func (t *ticketAuthenticator) IsRootTicket(ctx context.Context) bool {
 
 // AuthenticateRequest adds authentication data to a new request.
 func (t *ticketAuthenticator) AuthenticateRequest(_ context.Context, req *http.Request) error {
+	if !t.expiresAt.IsZero() && !time.Now().Before(t.expiresAt) {
+		return t.expiredError()
+	}
+
 	req.AddCookie(&http.Cookie{
 		HttpOnly: true,
-		Name:     "PVEAuthCookie",
+		Name:     authCookieName,
 		Secure:   true,
 		Value:    *t.authData.Ticket,
 	})
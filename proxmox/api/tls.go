@@ -0,0 +1,135 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package api
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+type withCACertificates struct {
+	pem string
+}
+
+// WithCACertificates is an option to verify the API server certificate with the given PEM encoded CA certificates,
+// instead of the system ones.
+func WithCACertificates(pem string) ConnectionOption {
+	return withCACertificates{pem: pem}
+}
+
+func (w withCACertificates) apply(opts *connectionOptions) {
+	opts.caCertificates = w.pem
+}
+
+type withFingerprint struct {
+	fingerprint string
+}
+
+// WithFingerprint is an option to pin the API server certificate to the given SHA-256 fingerprint, e.g.
+// `AB:CD:...`, as reported by the `ssl_fingerprint` of the nodes. The certificate is then trusted regardless
+// of its issuer, subject and expiry. It cannot be used with WithCACertificates or WithEndpoints.
+func WithFingerprint(fingerprint string) ConnectionOption {
+	return withFingerprint{fingerprint: fingerprint}
+}
+
+func (w withFingerprint) apply(opts *connectionOptions) {
+	opts.fingerprint = w.fingerprint
+}
+
+type withClientCertificate struct {
+	certificate string
+	key         string
+}
+
+// WithClientCertificate is an option to present the given PEM encoded client certificate and key to the API server,
+// e.g. to a reverse proxy in front of the API requiring the mutual TLS authentication.
+func WithClientCertificate(certificate, key string) ConnectionOption {
+	return withClientCertificate{certificate: certificate, key: key}
+}
+
+func (w withClientCertificate) apply(opts *connectionOptions) {
+	opts.clientCertificate = w.certificate
+	opts.clientKey = w.key
+}
+
+// configureTLS applies the certificate options to the TLS configuration of the connection.
+func (o *connectionOptions) configureTLS(config *tls.Config) error {
+	if o.caCertificates != "" && o.fingerprint != "" {
+		// the pinned certificate skips the verification of the chain, so the CA certificates would be ignored
+		return errors.New("the CA certificates and the fingerprint cannot be used together")
+	}
+
+	if o.caCertificates != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(o.caCertificates)) {
+			return errors.New("the CA certificates must contain at least one PEM encoded certificate")
+		}
+
+		config.RootCAs = pool
+	}
+
+	if o.fingerprint != "" {
+		fingerprint, err := ParseFingerprint(o.fingerprint)
+		if err != nil {
+			return err
+		}
+
+		// the pinned certificate replaces the verification of the chain, which may be self-signed
+		config.InsecureSkipVerify = true
+		config.VerifyPeerCertificate = func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if len(rawCerts) == 0 {
+				return errors.New("the server did not present a certificate")
+			}
+
+			sum := sha256.Sum256(rawCerts[0])
+			if !bytes.Equal(sum[:], fingerprint) {
+				return fmt.Errorf("the server certificate fingerprint %s does not match the expected %s",
+					FormatFingerprint(sum[:]), FormatFingerprint(fingerprint))
+			}
+
+			return nil
+		}
+	}
+
+	if o.clientCertificate != "" || o.clientKey != "" {
+		cert, err := tls.X509KeyPair([]byte(o.clientCertificate), []byte(o.clientKey))
+		if err != nil {
+			return fmt.Errorf("failed to load the client certificate: %w", err)
+		}
+
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	return nil
+}
+
+// ParseFingerprint parses a SHA-256 certificate fingerprint, formatted as hex bytes optionally separated by colons.
+func ParseFingerprint(fingerprint string) ([]byte, error) {
+	b, err := hex.DecodeString(strings.ReplaceAll(strings.TrimSpace(fingerprint), ":", ""))
+	if err != nil || len(b) != sha256.Size {
+		return nil, fmt.Errorf("the fingerprint %q must be a SHA-256 hash, e.g. `AB:CD:...:EF`", fingerprint)
+	}
+
+	return b, nil
+}
+
+// FormatFingerprint formats a SHA-256 certificate fingerprint the way Proxmox VE does, as uppercase hex bytes
+// separated by colons.
+func FormatFingerprint(fingerprint []byte) string {
+	parts := make([]string, len(fingerprint))
+	for i, b := range fingerprint {
+		parts[i] = fmt.Sprintf("%02X", b)
+	}
+
+	return strings.Join(parts, ":")
+}
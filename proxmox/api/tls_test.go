@@ -0,0 +1,160 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package api
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newClientCertificate returns a self-signed client certificate and key, PEM encoded.
+func newClientCertificate(t *testing.T) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+}
+
+func TestConnectionTLS(t *testing.T) {
+	t.Parallel()
+
+	clientCert, clientKey := newClientCertificate(t)
+
+	clientCAs := x509.NewCertPool()
+	require.True(t, clientCAs.AppendCertsFromPEM([]byte(clientCert)))
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) == 0 && strings.HasSuffix(r.URL.Path, "/mtls") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"data":null}`))
+	}))
+	srv.TLS = &tls.Config{ClientAuth: tls.VerifyClientCertIfGiven, ClientCAs: clientCAs, MinVersion: tls.VersionTLS13}
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+
+	serverCA := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}))
+	sum := sha256.Sum256(srv.Certificate().Raw)
+	fingerprint := FormatFingerprint(sum[:])
+
+	tests := []struct {
+		name    string
+		path    string
+		opts    []ConnectionOption
+		wantErr string
+	}{
+		{"system CAs", "version", nil, "certificate signed by unknown authority"},
+		{"CA certificates", "version", []ConnectionOption{WithCACertificates(serverCA)}, ""},
+		{"fingerprint", "version", []ConnectionOption{WithFingerprint(fingerprint)}, ""},
+		{"lowercase fingerprint", "version", []ConnectionOption{
+			WithFingerprint(strings.ToLower(strings.ReplaceAll(fingerprint, ":", ""))),
+		}, ""},
+		{"wrong fingerprint", "version", []ConnectionOption{
+			WithFingerprint(strings.Repeat("AB:", 31) + "AB"),
+		}, "does not match the expected AB:AB"},
+		{"no client certificate", "mtls", []ConnectionOption{WithCACertificates(serverCA)}, "HTTP 403"},
+		{"client certificate", "mtls", []ConnectionOption{
+			WithCACertificates(serverCA),
+			WithClientCertificate(clientCert, clientKey),
+		}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// the TLS errors are not transient
+			opts := append(tt.opts, WithRetryPolicy(RetryPolicy{MaxAttempts: 1}))
+
+			conn, err := NewConnection(srv.URL, false, "", opts...)
+			require.NoError(t, err)
+
+			c := &client{conn: conn, auth: dummyAuthenticator{}}
+
+			err = c.DoRequest(t.Context(), http.MethodGet, tt.path, nil, nil)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestConnectionTLSOptionErrors(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewConnection("https://pve.example.com:8006/", false, "", WithCACertificates("not a certificate"))
+	require.ErrorContains(t, err, "at least one PEM encoded certificate")
+
+	_, err = NewConnection("https://pve.example.com:8006/", false, "", WithFingerprint("AB:CD"))
+	require.ErrorContains(t, err, "must be a SHA-256 hash")
+
+	cert, _ := newClientCertificate(t)
+
+	_, err = NewConnection("https://pve.example.com:8006/", false, "",
+		WithCACertificates(cert),
+		WithFingerprint(strings.Repeat("AB:", 31)+"AB"),
+	)
+	require.ErrorContains(t, err, "cannot be used together")
+
+	_, err = NewConnection("https://pve1.example.com:8006/", false, "",
+		WithEndpoints("https://pve2.example.com:8006/"),
+		WithFingerprint(strings.Repeat("AB:", 31)+"AB"),
+	)
+	require.ErrorContains(t, err, "cannot be used with the endpoints")
+
+	_, err = NewConnection("https://pve.example.com:8006/", false, "", WithClientCertificate("cert", ""))
+	require.ErrorContains(t, err, "failed to load the client certificate")
+}
+
+func TestFingerprint(t *testing.T) {
+	t.Parallel()
+
+	sum := sha256.Sum256([]byte("certificate"))
+	formatted := FormatFingerprint(sum[:])
+
+	assert.Len(t, formatted, 95)
+
+	parsed, err := ParseFingerprint(formatted)
+	require.NoError(t, err)
+	assert.Equal(t, sum[:], parsed)
+}
@@ -15,18 +15,39 @@ import (
 	"net/http"
 	"net/url"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	"github.com/bpg/terraform-provider-proxmox/proxmox/helpers/totp"
 	"github.com/bpg/terraform-provider-proxmox/utils"
 )
 
+const (
+	// ticketRenewAfter is the age of a ticket after which it is renewed, the tickets are valid for ticketLifetime.
+	ticketRenewAfter = time.Hour
+
+	// authCookieName is the name of the cookie holding the ticket.
+	authCookieName = "PVEAuthCookie"
+)
+
 type userAuthenticator struct {
 	conn        *Connection
 	authRequest string
-	authData    *AuthenticationResponseData
 
-	mu sync.Mutex
+	// mu protects the ticket, it is not held while logging in
+	mu       sync.Mutex
+	authData *AuthenticationResponseData
+	issuedAt time.Time
+
+	username   string
+	otp        string
+	totpSecret string
+
+	// loginMu serializes the logins, including the wait for the next TOTP code
+	loginMu sync.Mutex
+	// lastTOTPStep is the step of the last TOTP code sent, the API does not accept a code twice
+	lastTOTPStep int64
 }
 
 // NewUserAuthenticator creates a new authenticator that uses a username and password for authentication.
@@ -37,36 +58,210 @@ func NewUserAuthenticator(creds UserCredentials, conn *Connection) Authenticator
 		url.QueryEscape(creds.Password),
 	)
 
-	// OTP is optional, and probably doesn't make much sense for most provider users.
-	//   TOTP uses 2x requests; one with payloads `username=` and `password=`,
-	//     (this returns a payload including: 'NeedTFA=1')
-	//   followed by a 2nd request with payloads:
-	//     `username=`, `tfa-challenge=<firsts response ticket>`, `password=totp:######`,
-	//   and header: `CSRFPreventionToken: <first response CSRF>`
-	//   Ticket generated lasts for ~2hours (to verify)
-	if creds.OTP != "" {
+	// With the second factor configured for the user, the API responds to the login with a challenge ticket
+	// and `NeedTFA=1`, and expects a second request with `tfa-challenge=<challenge ticket>` and
+	// `password=totp:<code>`, see login. The static OTP is also sent with the first request,
+	// for the realms with the legacy second factor.
+	switch {
+	case creds.TOTPSecret != "":
+		authRequest += "&new-format=1"
+	case creds.OTP != "":
 		authRequest = fmt.Sprintf("%s&otp=%s", authRequest, url.QueryEscape(creds.OTP))
 	}
 
 	return &userAuthenticator{
 		conn:        conn,
 		authRequest: authRequest,
+		username:    creds.Username,
+		otp:         creds.OTP,
+		totpSecret:  creds.TOTPSecret,
 	}
 }
 
 func (t *userAuthenticator) authenticate(ctx context.Context) (*AuthenticationResponseData, error) {
+	if data := t.validTicket(); data != nil {
+		return data, nil
+	}
+
+	if !t.loginMu.TryLock() {
+		// while another request renews the ticket, possibly waiting for the next TOTP code,
+		// the current ticket is used until it expires
+		t.mu.Lock()
+		data, issuedAt := t.authData, t.issuedAt
+		t.mu.Unlock()
+
+		if data != nil && time.Since(issuedAt) < ticketLifetime {
+			return data, nil
+		}
+
+		t.loginMu.Lock()
+	}
+
+	defer t.loginMu.Unlock()
+
+	// another login may have completed while waiting for the lock
+	if data := t.validTicket(); data != nil {
+		return data, nil
+	}
+
+	t.mu.Lock()
+	authData := t.authData
+	t.mu.Unlock()
+
+	// the ticket is renewed using the ticket itself as the password, so the one-time password is not needed again
+	if authData != nil {
+		renewRequest := fmt.Sprintf(
+			"username=%s&password=%s",
+			url.QueryEscape(authData.Username),
+			url.QueryEscape(*authData.Ticket),
+		)
+
+		data, err := t.login(ctx, renewRequest)
+		if err == nil {
+			tflog.Debug(ctx, "Renewed the authentication ticket")
+
+			t.setTicket(data)
+
+			return data, nil
+		}
+
+		tflog.Warn(ctx, "Failed to renew the authentication ticket, logging in again", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	data, err := t.login(ctx, t.authRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	t.setTicket(data)
+
+	return data, nil
+}
+
+// validTicket returns the current ticket, or nil if there is none or it must be renewed.
+func (t *userAuthenticator) validTicket() *AuthenticationResponseData {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.authData != nil && time.Since(t.issuedAt) < ticketRenewAfter {
+		return t.authData
+	}
+
+	return nil
+}
+
+func (t *userAuthenticator) setTicket(data *AuthenticationResponseData) {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
-	if t.authData != nil {
-		return t.authData, nil
+	t.authData, t.issuedAt = data, time.Now()
+}
+
+// invalidate discards the ticket rejected by the API for the request, so the next request logs in again.
+// The ticket is kept if another request has already renewed it.
+func (t *userAuthenticator) invalidate(_ context.Context, req *http.Request) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.authData == nil {
+		return nil
+	}
+
+	if cookie, err := req.Cookie(authCookieName); err == nil && cookie.Value != *t.authData.Ticket {
+		return nil
+	}
+
+	t.authData = nil
+
+	return nil
+}
+
+// login requests a new ticket, answering the second factor challenge if the API asks for it.
+func (t *userAuthenticator) login(ctx context.Context, authRequest string) (*AuthenticationResponseData, error) {
+	data, err := t.requestTicket(ctx, authRequest, "")
+	if err != nil || data.NeedTFA == nil || !bool(*data.NeedTFA) {
+		return data, err
+	}
+
+	code, err := t.secondFactor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	challengeRequest := fmt.Sprintf(
+		"username=%s&tfa-challenge=%s&password=%s",
+		url.QueryEscape(t.username),
+		url.QueryEscape(*data.Ticket),
+		url.QueryEscape("totp:"+code),
+	)
+
+	data, err = t.requestTicket(ctx, challengeRequest, *data.CSRFPreventionToken)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pass the second factor challenge: %w", err)
+	}
+
+	if data.NeedTFA != nil && bool(*data.NeedTFA) {
+		return nil, errors.New("the server did not accept the second factor")
+	}
+
+	return data, nil
+}
+
+// secondFactor returns the code to answer the second factor challenge with. The code is generated from the TOTP
+// secret if there is one, waiting for the next step if the code of the current one was already sent. It is called
+// with loginMu held but not mu, so the other requests keep using the current ticket until it expires.
+func (t *userAuthenticator) secondFactor(ctx context.Context) (string, error) {
+	if t.totpSecret == "" {
+		if t.otp == "" {
+			return "", errors.New("the user requires a second factor, but neither a TOTP secret nor an OTP is provided")
+		}
+
+		return t.otp, nil
+	}
+
+	now := time.Now()
+
+	if totp.Step(now) <= t.lastTOTPStep {
+		next := time.Unix((t.lastTOTPStep+1)*int64(totp.Period/time.Second), 0)
+
+		tflog.Debug(ctx, "Waiting for the next TOTP code", map[string]interface{}{
+			"delay": time.Until(next).String(),
+		})
+
+		timer := time.NewTimer(time.Until(next))
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return "", fmt.Errorf("failed to wait for the next TOTP code: %w", ctx.Err())
+		}
+
+		now = next
+	}
+
+	code, err := totp.Generate(t.totpSecret, now)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate the TOTP code: %w", err)
 	}
 
+	t.lastTOTPStep = totp.Step(now)
+
+	return code, nil
+}
+
+func (t *userAuthenticator) requestTicket(
+	ctx context.Context,
+	authRequest string,
+	csrfPreventionToken string,
+) (*AuthenticationResponseData, error) {
 	req, err := http.NewRequestWithContext(
 		ctx,
 		http.MethodPost,
 		fmt.Sprintf("%s/%s/access/ticket", t.conn.endpoint, basePathJSONAPI),
-		bytes.NewBufferString(t.authRequest),
+		bytes.NewBufferString(authRequest),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create authentication request: %w", err)
@@ -74,12 +269,16 @@ func (t *userAuthenticator) authenticate(ctx context.Context) (*AuthenticationRe
 
 	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
 
+	if csrfPreventionToken != "" {
+		req.Header.Add("CSRFPreventionToken", csrfPreventionToken)
+	}
+
 	tflog.Debug(ctx, "Sending authentication request", map[string]interface{}{
 		"path": req.URL.Path,
 	})
 
 	//nolint:bodyclose
-	res, err := t.conn.httpClient.Do(req)
+	res, err := t.conn.do(ctx, req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve authentication response: %w", err)
 	}
@@ -116,23 +315,20 @@ func (t *userAuthenticator) authenticate(ctx context.Context) (*AuthenticationRe
 		return nil, errors.New("the server did not include the username in the authentication response")
 	}
 
-	t.authData = resBody.Data
-
 	return resBody.Data, nil
 }
 
 func (t *userAuthenticator) IsRoot(ctx context.Context) bool {
-	if t.authData == nil {
-		if _, err := t.authenticate(ctx); err != nil {
-			tflog.Warn(ctx, "Failed to authenticate while checking root status", map[string]interface{}{
-				"error": err.Error(),
-			})
+	data, err := t.authenticate(ctx)
+	if err != nil {
+		tflog.Warn(ctx, "Failed to authenticate while checking root status", map[string]interface{}{
+			"error": err.Error(),
+		})
 
-			return false
-		}
+		return false
 	}
 
-	return t.authData != nil && t.authData.Username == rootUsername
+	return data.Username == rootUsername
 }
 
 func (t *userAuthenticator) IsRootTicket(ctx context.Context) bool {
@@ -148,7 +344,7 @@ func (t *userAuthenticator) AuthenticateRequest(ctx context.Context, req *http.R
 
 	req.AddCookie(&http.Cookie{
 		HttpOnly: true,
-		Name:     "PVEAuthCookie",
+		Name:     authCookieName,
 		Secure:   true,
 		Value:    *a.Ticket,
 	})
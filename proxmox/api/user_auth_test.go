@@ -0,0 +1,274 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/fakepve"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/helpers/totp"
+)
+
+func TestUserAuthenticatorRenewal(t *testing.T) {
+	t.Parallel()
+
+	s := fakepve.NewServer()
+	t.Cleanup(s.Close)
+
+	var (
+		mu     sync.Mutex
+		logins []string
+	)
+
+	record := func(next http.RoundTripper) http.RoundTripper {
+		return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if strings.HasSuffix(req.URL.Path, "/access/ticket") {
+				body, err := io.ReadAll(req.Body)
+				require.NoError(t, err)
+
+				mu.Lock()
+				logins = append(logins, string(body))
+				mu.Unlock()
+
+				req.Body = io.NopCloser(strings.NewReader(string(body)))
+			}
+
+			return next.RoundTrip(req)
+		})
+	}
+
+	conn, err := NewConnection(s.Endpoint(), true, "", WithTransport(record))
+	require.NoError(t, err)
+
+	creds, err := NewCredentials(fakepve.DefaultUsername, fakepve.DefaultPassword, "", "", "", "")
+	require.NoError(t, err)
+
+	c, err := NewClient(creds, conn)
+	require.NoError(t, err)
+
+	auth := c.(*client).auth.(*userAuthenticator) //nolint:forcetypeassert
+	ctx := t.Context()
+
+	require.NoError(t, c.DoRequest(ctx, http.MethodGet, "version", nil, nil))
+	require.Len(t, logins, 1)
+	assert.Contains(t, logins[0], "password="+fakepve.DefaultPassword)
+
+	// the ticket is renewed with itself before it expires
+	ticket := *auth.authData.Ticket
+	auth.issuedAt = time.Now().Add(-ticketRenewAfter)
+
+	require.NoError(t, c.DoRequest(ctx, http.MethodGet, "version", nil, nil))
+	require.Len(t, logins, 2)
+	assert.Contains(t, logins[1], "password=PVE%3A")
+	assert.NotEqual(t, ticket, *auth.authData.Ticket)
+
+	// the request rejected with an expired ticket is retried once after logging in again
+	s.ExpireTickets()
+
+	require.NoError(t, c.DoRequest(ctx, http.MethodPost, "access/users", &struct {
+		ID string `url:"userid"`
+	}{ID: "test@pve"}, nil))
+	require.Len(t, logins, 3)
+	assert.Contains(t, logins[2], "password="+fakepve.DefaultPassword)
+}
+
+func TestUserAuthenticatorInvalidate(t *testing.T) {
+	t.Parallel()
+
+	s := fakepve.NewServer()
+	t.Cleanup(s.Close)
+
+	conn, err := NewConnection(s.Endpoint(), true, "")
+	require.NoError(t, err)
+
+	auth := NewUserAuthenticator(UserCredentials{
+		Username: fakepve.DefaultUsername,
+		Password: fakepve.DefaultPassword,
+	}, conn).(*userAuthenticator) //nolint:forcetypeassert
+
+	ctx := t.Context()
+
+	rejected, err := http.NewRequestWithContext(ctx, http.MethodGet, s.Endpoint(), nil)
+	require.NoError(t, err)
+	require.NoError(t, auth.AuthenticateRequest(ctx, rejected))
+
+	// the requests rejected concurrently do not discard the ticket renewed by the first one
+	var wg sync.WaitGroup
+
+	for range 4 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			assert.NoError(t, auth.invalidate(ctx, rejected))
+			assert.True(t, auth.IsRoot(ctx))
+		}()
+	}
+
+	wg.Wait()
+
+	renewed := auth.authData
+	require.NotNil(t, renewed)
+
+	require.NoError(t, auth.invalidate(ctx, rejected))
+	assert.Same(t, renewed, auth.authData)
+
+	// the ticket rejected for a request sent with it is discarded
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.Endpoint(), nil)
+	require.NoError(t, err)
+	require.NoError(t, auth.AuthenticateRequest(ctx, req))
+	require.NoError(t, auth.invalidate(ctx, req))
+	assert.Nil(t, auth.authData)
+}
+
+func TestUserAuthenticatorTOTP(t *testing.T) {
+	t.Parallel()
+
+	const secret = "JBSWY3DPEHPK3PXP"
+
+	s := fakepve.NewServer(fakepve.WithRootTOTP(secret))
+	t.Cleanup(s.Close)
+
+	conn, err := NewConnection(s.Endpoint(), true, "")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		creds   UserCredentials
+		wantErr string
+	}{
+		{"totp secret", UserCredentials{TOTPSecret: secret}, ""},
+		{"no second factor", UserCredentials{}, "neither a TOTP secret nor an OTP is provided"},
+		{"wrong totp secret", UserCredentials{TOTPSecret: "GEZDGNBVGY3TQOJQ"}, "failed to pass the second factor challenge"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.creds.Username = fakepve.DefaultUsername
+			tt.creds.Password = fakepve.DefaultPassword
+
+			c := &client{conn: conn, auth: NewUserAuthenticator(tt.creds, conn)}
+
+			err := c.DoRequest(t.Context(), http.MethodGet, "version", nil, nil)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUserAuthenticatorTOTPWait(t *testing.T) {
+	t.Parallel()
+
+	const secret = "JBSWY3DPEHPK3PXP"
+
+	s := fakepve.NewServer(fakepve.WithRootTOTP(secret))
+	t.Cleanup(s.Close)
+
+	var logins atomic.Int32
+
+	count := func(next http.RoundTripper) http.RoundTripper {
+		return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			res, err := next.RoundTrip(req)
+			if strings.HasSuffix(req.URL.Path, "/access/ticket") {
+				logins.Add(1)
+			}
+
+			return res, err //nolint:wrapcheck
+		})
+	}
+
+	conn, err := NewConnection(s.Endpoint(), true, "", WithTransport(count))
+	require.NoError(t, err)
+
+	auth := NewUserAuthenticator(UserCredentials{
+		Username:   fakepve.DefaultUsername,
+		Password:   fakepve.DefaultPassword,
+		TOTPSecret: secret,
+	}, conn).(*userAuthenticator) //nolint:forcetypeassert
+
+	ctx := t.Context()
+
+	data, err := auth.authenticate(ctx)
+	require.NoError(t, err)
+
+	logins.Store(0)
+
+	// the renewal fails, so the login waits for the next TOTP code
+	s.ExpireTickets()
+
+	auth.mu.Lock()
+	auth.issuedAt = time.Now().Add(-ticketRenewAfter)
+	auth.mu.Unlock()
+
+	auth.lastTOTPStep = totp.Step(time.Now()) + 1
+
+	loginCtx, cancel := context.WithCancel(ctx)
+	done := make(chan error)
+
+	go func() {
+		_, err := auth.authenticate(loginCtx)
+		done <- err
+	}()
+
+	// the failed renewal and the login with the password are followed by the wait
+	require.Eventually(t, func() bool { return logins.Load() == 2 }, time.Second, 10*time.Millisecond)
+
+	// the other requests keep using the current ticket meanwhile
+	current, err := auth.authenticate(ctx)
+	require.NoError(t, err)
+	assert.Same(t, data, current)
+	assert.True(t, auth.IsRoot(ctx))
+
+	cancel()
+	require.ErrorContains(t, <-done, "failed to wait for the next TOTP code")
+}
+
+func TestTicketAuthenticatorExpiry(t *testing.T) {
+	t.Parallel()
+
+	issuedAt := time.Now().Add(-3 * time.Hour)
+	ticket := fmt.Sprintf("PVE:root@pam:%X::signature", issuedAt.Unix())
+
+	auth, err := NewTicketAuthenticator(TicketCredentials{AuthTicket: ticket, CSRFPreventionToken: "token"})
+	require.NoError(t, err)
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "https://pve.example.com:8006/", nil)
+	require.NoError(t, err)
+
+	err = auth.AuthenticateRequest(t.Context(), req)
+	require.ErrorIs(t, err, ErrTicketExpired)
+	assert.Contains(t, err.Error(), "the tickets are valid for 2h0m0s")
+
+	ticket = fmt.Sprintf("PVE:root@pam:%X::signature", time.Now().Unix())
+
+	auth, err = NewTicketAuthenticator(TicketCredentials{AuthTicket: ticket, CSRFPreventionToken: "token"})
+	require.NoError(t, err)
+	require.NoError(t, auth.AuthenticateRequest(t.Context(), req))
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -0,0 +1,83 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package backup
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+// CustomFleecing handles the backup fleecing options.
+type CustomFleecing struct {
+	Enabled *types.CustomBool `json:"enabled,omitempty"`
+	Storage *string           `json:"storage,omitempty"`
+}
+
+// EncodeValues converts a CustomFleecing struct to a URL value.
+func (r *CustomFleecing) EncodeValues(key string, v *url.Values) error {
+	var values []string
+
+	if r.Enabled != nil {
+		if *r.Enabled {
+			values = append(values, "enabled=1")
+		} else {
+			values = append(values, "enabled=0")
+		}
+	}
+
+	if r.Storage != nil {
+		values = append(values, fmt.Sprintf("storage=%s", *r.Storage))
+	}
+
+	if len(values) > 0 {
+		v.Add(key, strings.Join(values, ","))
+	}
+
+	return nil
+}
+
+// UnmarshalJSON converts the fleecing options to an object.
+// PVE returns them either as a property string or as a JSON object.
+func (r *CustomFleecing) UnmarshalJSON(b []byte) error {
+	var s string
+
+	if err := json.Unmarshal(b, &s); err != nil {
+		type alias CustomFleecing
+
+		var a alias
+
+		if err := json.Unmarshal(b, &a); err != nil {
+			return fmt.Errorf("failed to unmarshal CustomFleecing: %w", err)
+		}
+
+		*r = CustomFleecing(a)
+
+		return nil
+	}
+
+	for _, p := range strings.Split(s, ",") {
+		k, v, found := strings.Cut(strings.TrimSpace(p), "=")
+		if !found {
+			continue
+		}
+
+		switch k {
+		case "enabled":
+			enabled := types.CustomBool(v == "1" || v == "true")
+			r.Enabled = &enabled
+		case "storage":
+			storage := v
+			r.Storage = &storage
+		}
+	}
+
+	return nil
+}
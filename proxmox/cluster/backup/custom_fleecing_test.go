@@ -0,0 +1,65 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package backup
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/helpers/ptr"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+func TestCustomFleecingUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		line string
+		want *CustomFleecing
+	}{
+		{
+			name: "property string",
+			line: `"enabled=1,storage=local-lvm"`,
+			want: &CustomFleecing{
+				Enabled: types.CustomBool(true).Pointer(),
+				Storage: ptr.Ptr("local-lvm"),
+			},
+		},
+		{
+			name: "object",
+			line: `{"enabled":0}`,
+			want: &CustomFleecing{
+				Enabled: types.CustomBool(false).Pointer(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := &CustomFleecing{}
+			require.NoError(t, r.UnmarshalJSON([]byte(tt.line)))
+			require.Equal(t, tt.want, r)
+		})
+	}
+}
+
+func TestCustomFleecingEncodeValues(t *testing.T) {
+	t.Parallel()
+
+	r := &CustomFleecing{
+		Enabled: types.CustomBool(true).Pointer(),
+		Storage: ptr.Ptr("local-lvm"),
+	}
+
+	v := url.Values{}
+	require.NoError(t, r.EncodeValues("fleecing", &v))
+	require.Equal(t, "enabled=1,storage=local-lvm", v.Get("fleecing"))
+}
@@ -0,0 +1,84 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package backup
+
+import (
+	"github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+// JobListResponseBody contains the body from a backup job list response.
+type JobListResponseBody struct {
+	Data []*JobGetResponseData `json:"data,omitempty"`
+}
+
+// JobGetResponseBody contains the body from a backup job get response.
+type JobGetResponseBody struct {
+	Data *JobGetResponseData `json:"data,omitempty"`
+}
+
+// JobDataBase contains fields which are both received from and sent to the backup job API.
+type JobDataBase struct {
+	// Backup schedule in systemd calendar event format.
+	Schedule string `json:"schedule" url:"schedule"`
+	// Whether the job is enabled.
+	Enabled *types.CustomBool `json:"enabled,omitempty" url:"enabled,omitempty,int"`
+	// Description of the job.
+	Comment *string `json:"comment,omitempty" url:"comment,omitempty"`
+	// Storage the backups are written to.
+	Storage *string `json:"storage,omitempty" url:"storage,omitempty"`
+	// Only run the job on this node.
+	Node *string `json:"node,omitempty" url:"node,omitempty"`
+	// Back up all guests on the node(s).
+	All *types.CustomBool `json:"all,omitempty" url:"all,omitempty,int"`
+	// A comma-separated list of guest IDs to back up.
+	VMID *string `json:"vmid,omitempty" url:"vmid,omitempty"`
+	// A comma-separated list of guest IDs excluded from the backup, used together with `All`.
+	Exclude *string `json:"exclude,omitempty" url:"exclude,omitempty"`
+	// Back up all guests in this pool.
+	Pool *string `json:"pool,omitempty" url:"pool,omitempty"`
+	// Compression algorithm.
+	Compress *string `json:"compress,omitempty" url:"compress,omitempty"`
+	// Backup mode.
+	Mode *string `json:"mode,omitempty" url:"mode,omitempty"`
+	// Retention options.
+	PruneBackups *types.CustomPruneBackups `json:"prune-backups,omitempty" url:"prune-backups,omitempty"`
+	// Backup fleecing options.
+	Fleecing *CustomFleecing `json:"fleecing,omitempty" url:"fleecing,omitempty"`
+	// A comma-separated list of email addresses that receive the notifications.
+	MailTo *string `json:"mailto,omitempty" url:"mailto,omitempty"`
+	// When to send legacy email notifications (`always` or `failure`).
+	MailNotification *string `json:"mailnotification,omitempty" url:"mailnotification,omitempty"`
+	// Notification mode.
+	NotificationMode *string `json:"notification-mode,omitempty" url:"notification-mode,omitempty"`
+	// Whether to run missed jobs as soon as possible.
+	RepeatMissed *types.CustomBool `json:"repeat-missed,omitempty" url:"repeat-missed,omitempty,int"`
+}
+
+// JobGetResponseData contains the data from a backup job get response.
+type JobGetResponseData struct {
+	JobDataBase
+	// The job identifier.
+	ID string `json:"id"`
+	// The job type. Always set to `vzdump`.
+	Type string `json:"type"`
+	// The time of the next scheduled run.
+	NextRun *types.CustomTimestamp `json:"next-run,omitempty"`
+}
+
+// JobCreateRequestBody contains the data which must be sent when creating a backup job.
+type JobCreateRequestBody struct {
+	JobDataBase
+	// The job identifier.
+	ID string `url:"id"`
+}
+
+// JobUpdateRequestBody contains the data which must be sent when updating a backup job.
+type JobUpdateRequestBody struct {
+	JobDataBase
+	// A list of settings to delete.
+	Delete []string `url:"delete,omitempty,comma"`
+}
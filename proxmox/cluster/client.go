@@ -11,10 +11,14 @@ import (
 
 	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
 	"github.com/bpg/terraform-provider-proxmox/proxmox/cluster/acme"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/cluster/backup"
 	clusterfirewall "github.com/bpg/terraform-provider-proxmox/proxmox/cluster/firewall"
 	"github.com/bpg/terraform-provider-proxmox/proxmox/cluster/ha"
 	"github.com/bpg/terraform-provider-proxmox/proxmox/cluster/mapping"
 	"github.com/bpg/terraform-provider-proxmox/proxmox/cluster/metrics"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/cluster/notifications"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/cluster/replication"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/cluster/sdn"
 	"github.com/bpg/terraform-provider-proxmox/proxmox/firewall"
 )
 
@@ -54,3 +58,23 @@ func (c *Client) ACME() *acme.Client {
 func (c *Client) Metrics() *metrics.Client {
 	return &metrics.Client{Client: c}
 }
+
+// Backup returns a client for managing the cluster's backup jobs.
+func (c *Client) Backup() *backup.Client {
+	return &backup.Client{Client: c}
+}
+
+// SDN returns a client for managing the cluster's software-defined networking.
+func (c *Client) SDN() *sdn.Client {
+	return &sdn.Client{Client: c}
+}
+
+// Replication returns a client for managing the cluster's storage replication jobs.
+func (c *Client) Replication() *replication.Client {
+	return &replication.Client{Client: c}
+}
+
+// Notifications returns a client for managing the cluster's notification endpoints and matchers.
+func (c *Client) Notifications() *notifications.Client {
+	return &notifications.Client{Client: c}
+}
@@ -0,0 +1,75 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+)
+
+func endpointPath(endpointType string, name string) string {
+	if name == "" {
+		return fmt.Sprintf("endpoints/%s", url.PathEscape(endpointType))
+	}
+
+	return fmt.Sprintf("endpoints/%s/%s", url.PathEscape(endpointType), url.PathEscape(name))
+}
+
+// GetEndpoint retrieves a single notification endpoint of the given type, e.g. `smtp` or `webhook`.
+func (c *Client) GetEndpoint(ctx context.Context, endpointType string, name string) (*EndpointGetResponseData, error) {
+	resBody := &EndpointGetResponseBody{}
+
+	err := c.DoRequest(ctx, http.MethodGet, c.ExpandPath(endpointPath(endpointType, name)), nil, resBody)
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s notification endpoint: %w", endpointType, err)
+	}
+
+	if resBody.Data == nil {
+		return nil, api.ErrNoDataObjectInResponse
+	}
+
+	return resBody.Data, nil
+}
+
+// CreateEndpoint creates a new notification endpoint of the given type.
+func (c *Client) CreateEndpoint(ctx context.Context, endpointType string, data *EndpointCreateRequestBody) error {
+	err := c.DoRequest(ctx, http.MethodPost, c.ExpandPath(endpointPath(endpointType, "")), data, nil)
+	if err != nil {
+		return fmt.Errorf("error creating %s notification endpoint: %w", endpointType, err)
+	}
+
+	return nil
+}
+
+// UpdateEndpoint updates a notification endpoint of the given type.
+func (c *Client) UpdateEndpoint(
+	ctx context.Context,
+	endpointType string,
+	name string,
+	data *EndpointUpdateRequestBody,
+) error {
+	err := c.DoRequest(ctx, http.MethodPut, c.ExpandPath(endpointPath(endpointType, name)), data, nil)
+	if err != nil {
+		return fmt.Errorf("error updating %s notification endpoint: %w", endpointType, err)
+	}
+
+	return nil
+}
+
+// DeleteEndpoint deletes a notification endpoint of the given type.
+func (c *Client) DeleteEndpoint(ctx context.Context, endpointType string, name string) error {
+	err := c.DoRequest(ctx, http.MethodDelete, c.ExpandPath(endpointPath(endpointType, name)), nil, nil)
+	if err != nil {
+		return fmt.Errorf("error deleting %s notification endpoint: %w", endpointType, err)
+	}
+
+	return nil
+}
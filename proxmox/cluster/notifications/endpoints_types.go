@@ -0,0 +1,135 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package notifications
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+// EndpointGetResponseBody contains the body from a notification endpoint get response.
+type EndpointGetResponseBody struct {
+	Data *EndpointGetResponseData `json:"data,omitempty"`
+}
+
+// EndpointGetResponseData contains the data from a notification endpoint get response.
+type EndpointGetResponseData struct {
+	Name   string  `json:"name"`
+	Digest *string `json:"digest,omitempty"`
+	// Origin is either `user-created`, `builtin` or `modified-builtin`.
+	Origin *string `json:"origin,omitempty"`
+
+	EndpointFields
+}
+
+// EndpointFields contains the fields of all notification endpoint types, only the fields of the
+// endpoint type are sent to the API.
+type EndpointFields struct {
+	Author      *string            `json:"author,omitempty"       url:"author,omitempty"`
+	Body        *string            `json:"body,omitempty"         url:"body,omitempty"`
+	Comment     *string            `json:"comment,omitempty"      url:"comment,omitempty"`
+	Disable     *types.CustomBool  `json:"disable,omitempty"      url:"disable,omitempty,int"`
+	FromAddress *string            `json:"from-address,omitempty" url:"from-address,omitempty"`
+	Header      WebhookKeyValues   `json:"header,omitempty"       url:"header,omitempty"`
+	MailTo      []string           `json:"mailto,omitempty"       url:"mailto,omitempty"`
+	MailToUser  []string           `json:"mailto-user,omitempty"  url:"mailto-user,omitempty"`
+	Method      *string            `json:"method,omitempty"       url:"method,omitempty"`
+	Mode        *string            `json:"mode,omitempty"         url:"mode,omitempty"`
+	Password    *string            `json:"password,omitempty"     url:"password,omitempty"`
+	Port        *types.CustomInt64 `json:"port,omitempty"         url:"port,omitempty"`
+	Secret      WebhookKeyValues   `json:"secret,omitempty"       url:"secret,omitempty"`
+	Server      *string            `json:"server,omitempty"       url:"server,omitempty"`
+	Token       *string            `json:"token,omitempty"        url:"token,omitempty"`
+	URL         *string            `json:"url,omitempty"          url:"url,omitempty"`
+	Username    *string            `json:"username,omitempty"     url:"username,omitempty"`
+}
+
+// EndpointCreateRequestBody contains the body for a notification endpoint create request.
+type EndpointCreateRequestBody struct {
+	Name string `url:"name"`
+
+	EndpointFields
+}
+
+// EndpointUpdateRequestBody contains the body for a notification endpoint update request.
+type EndpointUpdateRequestBody struct {
+	Delete []string `url:"delete,omitempty,comma"`
+
+	EndpointFields
+}
+
+// WebhookKeyValue is a webhook header or secret. The value is base64 encoded by the API.
+type WebhookKeyValue struct {
+	Name  string
+	Value *string
+}
+
+// String converts a WebhookKeyValue value to a property string.
+func (kv WebhookKeyValue) String() string {
+	if kv.Value == nil {
+		return "name=" + kv.Name
+	}
+
+	return fmt.Sprintf("name=%s,value=%s", kv.Name, base64.StdEncoding.EncodeToString([]byte(*kv.Value)))
+}
+
+// WebhookKeyValues is a list of webhook headers or secrets.
+type WebhookKeyValues []WebhookKeyValue
+
+// EncodeValues converts a WebhookKeyValues value to multiple URL values.
+func (r WebhookKeyValues) EncodeValues(key string, v *url.Values) error {
+	for _, kv := range r {
+		v.Add(key, kv.String())
+	}
+
+	return nil
+}
+
+// UnmarshalJSON converts a list of property strings to a WebhookKeyValues value.
+// The API only returns the names of the secrets, their values are omitted.
+func (r *WebhookKeyValues) UnmarshalJSON(b []byte) error {
+	var list []string
+
+	if err := json.Unmarshal(b, &list); err != nil {
+		return fmt.Errorf("failed to unmarshal webhook key-value list: %w", err)
+	}
+
+	*r = make(WebhookKeyValues, 0, len(list))
+
+	for _, s := range list {
+		kv := WebhookKeyValue{}
+
+		for _, p := range strings.Split(s, ",") {
+			k, v, ok := strings.Cut(strings.TrimSpace(p), "=")
+			if !ok {
+				return fmt.Errorf("invalid webhook key-value property %q", p)
+			}
+
+			switch k {
+			case "name":
+				kv.Name = v
+			case "value":
+				decoded, err := base64.StdEncoding.DecodeString(v)
+				if err != nil {
+					return fmt.Errorf("failed to decode value of %q: %w", s, err)
+				}
+
+				value := string(decoded)
+				kv.Value = &value
+			}
+		}
+
+		*r = append(*r, kv)
+	}
+
+	return nil
+}
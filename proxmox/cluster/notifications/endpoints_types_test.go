@@ -0,0 +1,65 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package notifications
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-querystring/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/helpers/ptr"
+)
+
+func TestEndpointCreateRequestBodyEncode(t *testing.T) {
+	t.Parallel()
+
+	body := &EndpointCreateRequestBody{
+		Name: "on-call",
+		EndpointFields: EndpointFields{
+			URL:    ptr.Ptr("https://example.com/hook"),
+			Method: ptr.Ptr("post"),
+			Body:   ptr.Ptr("e30="),
+			Header: WebhookKeyValues{
+				{Name: "Content-Type", Value: ptr.Ptr("application/json")},
+			},
+			Secret: WebhookKeyValues{
+				{Name: "token", Value: ptr.Ptr("s3cr3t")},
+				{Name: "channel", Value: ptr.Ptr("ops")},
+			},
+		},
+	}
+
+	v, err := query.Values(body)
+	require.NoError(t, err)
+	require.Equal(t, "on-call", v.Get("name"))
+	require.Equal(t, []string{"name=Content-Type,value=YXBwbGljYXRpb24vanNvbg=="}, v["header"])
+	require.Equal(t, []string{"name=token,value=czNjcjN0", "name=channel,value=b3Bz"}, v["secret"])
+	require.NotContains(t, v, "mailto")
+}
+
+func TestEndpointGetResponseDataUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	data := &EndpointGetResponseData{}
+
+	err := json.Unmarshal([]byte(`{
+		"name": "on-call",
+		"url": "https://example.com/hook",
+		"method": "post",
+		"header": ["name=Content-Type,value=YXBwbGljYXRpb24vanNvbg=="],
+		"secret": ["name=token"]
+	}`), data)
+	require.NoError(t, err)
+
+	require.Equal(t, WebhookKeyValues{{Name: "Content-Type", Value: ptr.Ptr("application/json")}}, data.Header)
+	require.Equal(t, WebhookKeyValues{{Name: "token"}}, data.Secret)
+
+	err = json.Unmarshal([]byte(`{"name": "on-call", "header": ["name=X-Test,value=!"]}`), data)
+	require.Error(t, err)
+}
@@ -0,0 +1,62 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+)
+
+// GetMatcher retrieves a single notification matcher based on its name.
+func (c *Client) GetMatcher(ctx context.Context, name string) (*MatcherGetResponseData, error) {
+	resBody := &MatcherGetResponseBody{}
+
+	err := c.DoRequest(ctx, http.MethodGet, c.ExpandPath("matchers/"+url.PathEscape(name)), nil, resBody)
+	if err != nil {
+		return nil, fmt.Errorf("error reading notification matcher: %w", err)
+	}
+
+	if resBody.Data == nil {
+		return nil, api.ErrNoDataObjectInResponse
+	}
+
+	return resBody.Data, nil
+}
+
+// CreateMatcher creates a new notification matcher.
+func (c *Client) CreateMatcher(ctx context.Context, data *MatcherCreateRequestBody) error {
+	err := c.DoRequest(ctx, http.MethodPost, c.ExpandPath("matchers"), data, nil)
+	if err != nil {
+		return fmt.Errorf("error creating notification matcher: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateMatcher updates a notification matcher.
+func (c *Client) UpdateMatcher(ctx context.Context, name string, data *MatcherUpdateRequestBody) error {
+	err := c.DoRequest(ctx, http.MethodPut, c.ExpandPath("matchers/"+url.PathEscape(name)), data, nil)
+	if err != nil {
+		return fmt.Errorf("error updating notification matcher: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteMatcher deletes a notification matcher.
+func (c *Client) DeleteMatcher(ctx context.Context, name string) error {
+	err := c.DoRequest(ctx, http.MethodDelete, c.ExpandPath("matchers/"+url.PathEscape(name)), nil, nil)
+	if err != nil {
+		return fmt.Errorf("error deleting notification matcher: %w", err)
+	}
+
+	return nil
+}
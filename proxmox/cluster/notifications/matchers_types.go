@@ -0,0 +1,54 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package notifications
+
+import (
+	"github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+// MatcherGetResponseBody contains the body from a notification matcher get response.
+type MatcherGetResponseBody struct {
+	Data *MatcherGetResponseData `json:"data,omitempty"`
+}
+
+// MatcherGetResponseData contains the data from a notification matcher get response.
+type MatcherGetResponseData struct {
+	Name   string  `json:"name"`
+	Digest *string `json:"digest,omitempty"`
+	// Origin is either `user-created`, `builtin` or `modified-builtin`.
+	Origin *string `json:"origin,omitempty"`
+
+	MatcherFields
+}
+
+// MatcherFields contains the fields of a notification matcher.
+type MatcherFields struct {
+	Comment       *string           `json:"comment,omitempty"        url:"comment,omitempty"`
+	Disable       *types.CustomBool `json:"disable,omitempty"        url:"disable,omitempty,int"`
+	InvertMatch   *types.CustomBool `json:"invert-match,omitempty"   url:"invert-match,omitempty,int"`
+	MatchCalendar []string          `json:"match-calendar,omitempty" url:"match-calendar,omitempty"`
+	// MatchField contains the field matching rules in the `[exact|regex]:<field>=<value>` format.
+	MatchField    []string `json:"match-field,omitempty"    url:"match-field,omitempty"`
+	MatchSeverity []string `json:"match-severity,omitempty" url:"match-severity,omitempty"`
+	// Mode is either `all` or `any`.
+	Mode   *string  `json:"mode,omitempty"           url:"mode,omitempty"`
+	Target []string `json:"target,omitempty"         url:"target,omitempty"`
+}
+
+// MatcherCreateRequestBody contains the body for a notification matcher create request.
+type MatcherCreateRequestBody struct {
+	Name string `url:"name"`
+
+	MatcherFields
+}
+
+// MatcherUpdateRequestBody contains the body for a notification matcher update request.
+type MatcherUpdateRequestBody struct {
+	Delete []string `url:"delete,omitempty,comma"`
+
+	MatcherFields
+}
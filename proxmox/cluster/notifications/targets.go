@@ -0,0 +1,47 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+)
+
+// ListTargets retrieves the list of notification targets, i.e. the endpoints of all types.
+func (c *Client) ListTargets(ctx context.Context) ([]*TargetListResponseData, error) {
+	resBody := &TargetListResponseBody{}
+
+	err := c.DoRequest(ctx, http.MethodGet, c.ExpandPath("targets"), nil, resBody)
+	if err != nil {
+		return nil, fmt.Errorf("error listing notification targets: %w", err)
+	}
+
+	if resBody.Data == nil {
+		return nil, api.ErrNoDataObjectInResponse
+	}
+
+	sort.Slice(resBody.Data, func(i, j int) bool {
+		return resBody.Data[i].Name < resBody.Data[j].Name
+	})
+
+	return resBody.Data, nil
+}
+
+// TestTarget sends a test notification to a notification target.
+func (c *Client) TestTarget(ctx context.Context, name string) error {
+	err := c.DoRequest(ctx, http.MethodPost, c.ExpandPath(fmt.Sprintf("targets/%s/test", url.PathEscape(name))), nil, nil)
+	if err != nil {
+		return fmt.Errorf("error testing notification target %q: %w", name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package notifications
+
+import (
+	"github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+// TargetListResponseBody contains the body from a notification target list response.
+type TargetListResponseBody struct {
+	Data []*TargetListResponseData `json:"data,omitempty"`
+}
+
+// TargetListResponseData contains the data from a notification target list response.
+type TargetListResponseData struct {
+	Name    string            `json:"name"`
+	Type    string            `json:"type"`
+	Comment *string           `json:"comment,omitempty"`
+	Disable *types.CustomBool `json:"disable,omitempty"`
+	Origin  *string           `json:"origin,omitempty"`
+}
@@ -0,0 +1,84 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package replication
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+)
+
+// List retrieves the list of replication jobs.
+func (c *Client) List(ctx context.Context) ([]*JobGetResponseData, error) {
+	resBody := &JobListResponseBody{}
+
+	err := c.DoRequest(ctx, http.MethodGet, c.ExpandPath(""), nil, resBody)
+	if err != nil {
+		return nil, fmt.Errorf("error listing replication jobs: %w", err)
+	}
+
+	if resBody.Data == nil {
+		return nil, api.ErrNoDataObjectInResponse
+	}
+
+	sort.Slice(resBody.Data, func(i, j int) bool {
+		return resBody.Data[i].ID < resBody.Data[j].ID
+	})
+
+	return resBody.Data, nil
+}
+
+// Get retrieves a single replication job based on its identifier.
+func (c *Client) Get(ctx context.Context, id string) (*JobGetResponseData, error) {
+	resBody := &JobGetResponseBody{}
+
+	err := c.DoRequest(ctx, http.MethodGet, c.ExpandPath(url.PathEscape(id)), nil, resBody)
+	if err != nil {
+		return nil, fmt.Errorf("error reading replication job: %w", err)
+	}
+
+	if resBody.Data == nil {
+		return nil, api.ErrNoDataObjectInResponse
+	}
+
+	return resBody.Data, nil
+}
+
+// Create creates a new replication job.
+func (c *Client) Create(ctx context.Context, data *JobCreateRequestBody) error {
+	err := c.DoRequest(ctx, http.MethodPost, c.ExpandPath(""), data, nil)
+	if err != nil {
+		return fmt.Errorf("error creating replication job: %w", err)
+	}
+
+	return nil
+}
+
+// Update updates a replication job.
+func (c *Client) Update(ctx context.Context, id string, data *JobUpdateRequestBody) error {
+	err := c.DoRequest(ctx, http.MethodPut, c.ExpandPath(url.PathEscape(id)), data, nil)
+	if err != nil {
+		return fmt.Errorf("error updating replication job: %w", err)
+	}
+
+	return nil
+}
+
+// Delete marks a replication job for removal. The job is removed by the replication runner of the
+// source node, unless `Force` is set, in which case only the job configuration is removed.
+func (c *Client) Delete(ctx context.Context, id string, data *JobDeleteRequestBody) error {
+	err := c.DoRequest(ctx, http.MethodDelete, c.ExpandPath(url.PathEscape(id)), data, nil)
+	if err != nil {
+		return fmt.Errorf("error deleting replication job: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package replication
+
+import (
+	"github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+// JobListResponseBody contains the body from a replication job list response.
+type JobListResponseBody struct {
+	Data []*JobGetResponseData `json:"data,omitempty"`
+}
+
+// JobGetResponseBody contains the body from a replication job get response.
+type JobGetResponseBody struct {
+	Data *JobGetResponseData `json:"data,omitempty"`
+}
+
+// JobDataBase contains fields which are both received from and sent to the replication job API.
+type JobDataBase struct {
+	// Replication schedule in systemd calendar event format.
+	Schedule *string `json:"schedule,omitempty" url:"schedule,omitempty"`
+	// Rate limit in MB/s.
+	Rate *types.CustomFloat64 `json:"rate,omitempty" url:"rate,omitempty"`
+	// Description of the job.
+	Comment *string `json:"comment,omitempty" url:"comment,omitempty"`
+	// Whether the job is disabled.
+	Disable *types.CustomBool `json:"disable,omitempty" url:"disable,omitempty,int"`
+}
+
+// JobGetResponseData contains the data from a replication job get response.
+type JobGetResponseData struct {
+	JobDataBase
+	// The job identifier in the `<guest>-<job number>` format.
+	ID string `json:"id"`
+	// The job type. Always set to `local`.
+	Type string `json:"type"`
+	// The guest ID.
+	Guest types.CustomInt64 `json:"guest"`
+	// The job number.
+	JobNum types.CustomInt64 `json:"jobnum"`
+	// The target node.
+	Target string `json:"target"`
+	// The node the guest was on when the job last ran.
+	Source *string `json:"source,omitempty"`
+	// Set when the job is marked for removal (`local` or `full`).
+	RemoveJob *string `json:"remove_job,omitempty"`
+}
+
+// JobCreateRequestBody contains the data which must be sent when creating a replication job.
+type JobCreateRequestBody struct {
+	JobDataBase
+	// The job identifier in the `<guest>-<job number>` format.
+	ID string `url:"id"`
+	// The job type. Always set to `local`.
+	Type string `url:"type"`
+	// The target node.
+	Target string `url:"target"`
+}
+
+// JobUpdateRequestBody contains the data which must be sent when updating a replication job.
+type JobUpdateRequestBody struct {
+	JobDataBase
+	// A list of settings to delete.
+	Delete []string `url:"delete,omitempty,comma"`
+}
+
+// JobDeleteRequestBody contains the data which can be sent when deleting a replication job.
+type JobDeleteRequestBody struct {
+	// Keep the replicated data on the target node.
+	Keep *types.CustomBool `url:"keep,omitempty,int"`
+	// Remove the job configuration immediately, without cleaning up the replicated data.
+	Force *types.CustomBool `url:"force,omitempty,int"`
+}
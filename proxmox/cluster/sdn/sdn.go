@@ -0,0 +1,36 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package sdn
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+)
+
+// Apply applies the pending SDN configuration to all nodes and waits for the task to finish.
+func (c *Client) Apply(ctx context.Context) error {
+	resBody := &ApplyResponseBody{}
+
+	err := c.DoRequest(ctx, http.MethodPut, "cluster/sdn", nil, resBody)
+	if err != nil {
+		return fmt.Errorf("error applying SDN configuration: %w", err)
+	}
+
+	if resBody.Data == nil {
+		return api.ErrNoDataObjectInResponse
+	}
+
+	err = c.Tasks().WaitForTask(ctx, *resBody.Data)
+	if err != nil {
+		return fmt.Errorf("error waiting for SDN configuration to be applied: %w", err)
+	}
+
+	return nil
+}
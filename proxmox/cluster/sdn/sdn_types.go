@@ -0,0 +1,12 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package sdn
+
+// ApplyResponseBody contains the body from an SDN apply response.
+type ApplyResponseBody struct {
+	Data *string `json:"data,omitempty"`
+}
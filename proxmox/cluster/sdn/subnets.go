@@ -0,0 +1,83 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package sdn
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+)
+
+func (c *Client) subnetsPath(vnet string) string {
+	return c.ExpandPath(fmt.Sprintf("vnets/%s/subnets", url.PathEscape(vnet)))
+}
+
+// ListSubnets retrieves the list of subnets of an SDN VNet.
+func (c *Client) ListSubnets(ctx context.Context, vnet string) ([]*SubnetGetResponseData, error) {
+	resBody := &SubnetListResponseBody{}
+
+	err := c.DoRequest(ctx, http.MethodGet, c.subnetsPath(vnet), nil, resBody)
+	if err != nil {
+		return nil, fmt.Errorf("error listing SDN subnets: %w", err)
+	}
+
+	if resBody.Data == nil {
+		return nil, api.ErrNoDataObjectInResponse
+	}
+
+	return resBody.Data, nil
+}
+
+// GetSubnetByCIDR retrieves a single subnet of an SDN VNet based on its CIDR.
+// The API identifies subnets by a zone-prefixed ID, so the subnet is looked up in the VNet's list instead.
+func (c *Client) GetSubnetByCIDR(ctx context.Context, vnet string, cidr string) (*SubnetGetResponseData, error) {
+	subnets, err := c.ListSubnets(ctx, vnet)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range subnets {
+		if s.CIDR != nil && *s.CIDR == cidr {
+			return s, nil
+		}
+	}
+
+	return nil, api.ErrResourceDoesNotExist
+}
+
+// CreateSubnet creates a new subnet in an SDN VNet.
+func (c *Client) CreateSubnet(ctx context.Context, vnet string, data *SubnetCreateRequestBody) error {
+	err := c.DoRequest(ctx, http.MethodPost, c.subnetsPath(vnet), data, nil)
+	if err != nil {
+		return fmt.Errorf("error creating SDN subnet: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateSubnet updates a subnet of an SDN VNet.
+func (c *Client) UpdateSubnet(ctx context.Context, vnet string, id string, data *SubnetUpdateRequestBody) error {
+	err := c.DoRequest(ctx, http.MethodPut, c.subnetsPath(vnet)+"/"+url.PathEscape(id), data, nil)
+	if err != nil {
+		return fmt.Errorf("error updating SDN subnet: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteSubnet deletes a subnet of an SDN VNet.
+func (c *Client) DeleteSubnet(ctx context.Context, vnet string, id string) error {
+	err := c.DoRequest(ctx, http.MethodDelete, c.subnetsPath(vnet)+"/"+url.PathEscape(id), nil, nil)
+	if err != nil {
+		return fmt.Errorf("error deleting SDN subnet: %w", err)
+	}
+
+	return nil
+}
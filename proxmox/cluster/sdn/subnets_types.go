@@ -0,0 +1,110 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package sdn
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+// DHCPRange is a range of addresses handed out by the DHCP server of a subnet.
+type DHCPRange struct {
+	StartAddress string `json:"start-address"`
+	EndAddress   string `json:"end-address"`
+}
+
+// DHCPRanges is a list of DHCP ranges, sent to the API as a repeated parameter.
+type DHCPRanges []DHCPRange
+
+// EncodeValues converts a DHCPRanges list to multiple URL values.
+func (r DHCPRanges) EncodeValues(key string, v *url.Values) error {
+	for _, dr := range r {
+		v.Add(key, fmt.Sprintf("start-address=%s,end-address=%s", dr.StartAddress, dr.EndAddress))
+	}
+
+	return nil
+}
+
+// UnmarshalJSON converts a DHCP range from either its property string or its object representation.
+func (r *DHCPRange) UnmarshalJSON(b []byte) error {
+	var s string
+
+	if err := json.Unmarshal(b, &s); err != nil {
+		type plain DHCPRange
+
+		var p plain
+
+		if err := json.Unmarshal(b, &p); err != nil {
+			return fmt.Errorf("failed to unmarshal DHCP range: %w", err)
+		}
+
+		*r = DHCPRange(p)
+
+		return nil
+	}
+
+	*r = DHCPRange{}
+
+	for _, part := range strings.Split(s, ",") {
+		k, v, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return fmt.Errorf("invalid DHCP range %q", s)
+		}
+
+		switch k {
+		case "start-address":
+			r.StartAddress = v
+		case "end-address":
+			r.EndAddress = v
+		}
+	}
+
+	return nil
+}
+
+// SubnetDataBase contains the fields shared by the SDN subnet requests and responses.
+type SubnetDataBase struct {
+	Gateway       *string           `json:"gateway,omitempty"         url:"gateway,omitempty"`
+	SNAT          *types.CustomBool `json:"snat,omitempty"            url:"snat,omitempty,int"`
+	DNSZonePrefix *string           `json:"dnszoneprefix,omitempty"   url:"dnszoneprefix,omitempty"`
+	DHCPDNSServer *string           `json:"dhcp-dns-server,omitempty" url:"dhcp-dns-server,omitempty"`
+	DHCPRange     DHCPRanges        `json:"dhcp-range,omitempty"      url:"dhcp-range,omitempty"`
+}
+
+// SubnetListResponseBody contains the body from an SDN subnet list response.
+type SubnetListResponseBody struct {
+	Data []*SubnetGetResponseData `json:"data,omitempty"`
+}
+
+// SubnetGetResponseData contains the data from an SDN subnet get response.
+type SubnetGetResponseData struct {
+	ID   string  `json:"subnet"`
+	CIDR *string `json:"cidr,omitempty"`
+	Zone *string `json:"zone,omitempty"`
+	VNet *string `json:"vnet,omitempty"`
+
+	SubnetDataBase
+}
+
+// SubnetCreateRequestBody contains the body for an SDN subnet create request.
+type SubnetCreateRequestBody struct {
+	CIDR string `url:"subnet"`
+	Type string `url:"type"`
+
+	SubnetDataBase
+}
+
+// SubnetUpdateRequestBody contains the body for an SDN subnet update request.
+type SubnetUpdateRequestBody struct {
+	Delete []string `url:"delete,omitempty,comma"`
+
+	SubnetDataBase
+}
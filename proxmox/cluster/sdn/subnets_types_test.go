@@ -0,0 +1,72 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package sdn
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-querystring/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/helpers/ptr"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+func TestSubnetCreateRequestBodyEncode(t *testing.T) {
+	t.Parallel()
+
+	body := &SubnetCreateRequestBody{
+		CIDR: "10.0.0.0/24",
+		Type: "subnet",
+		SubnetDataBase: SubnetDataBase{
+			Gateway: ptr.Ptr("10.0.0.1"),
+			SNAT:    types.CustomBool(true).Pointer(),
+			DHCPRange: DHCPRanges{
+				{StartAddress: "10.0.0.100", EndAddress: "10.0.0.149"},
+				{StartAddress: "10.0.0.200", EndAddress: "10.0.0.249"},
+			},
+		},
+	}
+
+	v, err := query.Values(body)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"start-address=10.0.0.100,end-address=10.0.0.149",
+		"start-address=10.0.0.200,end-address=10.0.0.249",
+	}, v["dhcp-range"])
+	require.Equal(t, "10.0.0.0/24", v.Get("subnet"))
+	require.Equal(t, "1", v.Get("snat"))
+}
+
+func TestSubnetGetResponseDataUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		json string
+	}{
+		{"property strings", `["start-address=10.0.0.100,end-address=10.0.0.149"]`},
+		{"objects", `[{"start-address": "10.0.0.100", "end-address": "10.0.0.149"}]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			data := &SubnetGetResponseData{}
+			err := json.Unmarshal([]byte(`{
+				"subnet": "zone1-10.0.0.0-24",
+				"cidr": "10.0.0.0/24",
+				"dhcp-range": `+tt.json+`
+			}`), data)
+			require.NoError(t, err)
+			require.Equal(t, "zone1-10.0.0.0-24", data.ID)
+			require.Equal(t, DHCPRanges{{StartAddress: "10.0.0.100", EndAddress: "10.0.0.149"}}, data.DHCPRange)
+		})
+	}
+}
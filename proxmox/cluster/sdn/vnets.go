@@ -0,0 +1,62 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package sdn
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+)
+
+// GetVNet retrieves a single SDN VNet based on its identifier, including pending changes.
+func (c *Client) GetVNet(ctx context.Context, id string) (*VNetGetResponseData, error) {
+	resBody := &VNetGetResponseBody{}
+
+	err := c.DoRequest(ctx, http.MethodGet, c.ExpandPath("vnets/"+url.PathEscape(id)), nil, resBody)
+	if err != nil {
+		return nil, fmt.Errorf("error reading SDN VNet: %w", err)
+	}
+
+	if resBody.Data == nil {
+		return nil, api.ErrNoDataObjectInResponse
+	}
+
+	return resBody.Data, nil
+}
+
+// CreateVNet creates a new SDN VNet.
+func (c *Client) CreateVNet(ctx context.Context, data *VNetCreateRequestBody) error {
+	err := c.DoRequest(ctx, http.MethodPost, c.ExpandPath("vnets"), data, nil)
+	if err != nil {
+		return fmt.Errorf("error creating SDN VNet: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateVNet updates an SDN VNet.
+func (c *Client) UpdateVNet(ctx context.Context, id string, data *VNetUpdateRequestBody) error {
+	err := c.DoRequest(ctx, http.MethodPut, c.ExpandPath("vnets/"+url.PathEscape(id)), data, nil)
+	if err != nil {
+		return fmt.Errorf("error updating SDN VNet: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteVNet deletes an SDN VNet.
+func (c *Client) DeleteVNet(ctx context.Context, id string) error {
+	err := c.DoRequest(ctx, http.MethodDelete, c.ExpandPath("vnets/"+url.PathEscape(id)), nil, nil)
+	if err != nil {
+		return fmt.Errorf("error deleting SDN VNet: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package sdn
+
+import (
+	"github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+// VNetDataBase contains the fields shared by the SDN VNet requests and responses.
+type VNetDataBase struct {
+	Zone         *string           `json:"zone,omitempty"          url:"zone,omitempty"`
+	Tag          *int64            `json:"tag,omitempty"           url:"tag,omitempty"`
+	Alias        *string           `json:"alias,omitempty"         url:"alias,omitempty"`
+	VLANAware    *types.CustomBool `json:"vlanaware,omitempty"     url:"vlanaware,omitempty,int"`
+	IsolatePorts *types.CustomBool `json:"isolate-ports,omitempty" url:"isolate-ports,omitempty,int"`
+}
+
+// VNetGetResponseBody contains the body from an SDN VNet get response.
+type VNetGetResponseBody struct {
+	Data *VNetGetResponseData `json:"data,omitempty"`
+}
+
+// VNetGetResponseData contains the data from an SDN VNet get response.
+type VNetGetResponseData struct {
+	ID string `json:"vnet"`
+
+	VNetDataBase
+}
+
+// VNetCreateRequestBody contains the body for an SDN VNet create request.
+type VNetCreateRequestBody struct {
+	ID string `url:"vnet"`
+
+	VNetDataBase
+}
+
+// VNetUpdateRequestBody contains the body for an SDN VNet update request.
+type VNetUpdateRequestBody struct {
+	Delete []string `url:"delete,omitempty,comma"`
+
+	VNetDataBase
+}
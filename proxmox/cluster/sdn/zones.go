@@ -0,0 +1,62 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package sdn
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+)
+
+// GetZone retrieves a single SDN zone based on its identifier, including pending changes.
+func (c *Client) GetZone(ctx context.Context, id string) (*ZoneGetResponseData, error) {
+	resBody := &ZoneGetResponseBody{}
+
+	err := c.DoRequest(ctx, http.MethodGet, c.ExpandPath("zones/"+url.PathEscape(id)), nil, resBody)
+	if err != nil {
+		return nil, fmt.Errorf("error reading SDN zone: %w", err)
+	}
+
+	if resBody.Data == nil {
+		return nil, api.ErrNoDataObjectInResponse
+	}
+
+	return resBody.Data, nil
+}
+
+// CreateZone creates a new SDN zone.
+func (c *Client) CreateZone(ctx context.Context, data *ZoneCreateRequestBody) error {
+	err := c.DoRequest(ctx, http.MethodPost, c.ExpandPath("zones"), data, nil)
+	if err != nil {
+		return fmt.Errorf("error creating SDN zone: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateZone updates an SDN zone.
+func (c *Client) UpdateZone(ctx context.Context, id string, data *ZoneUpdateRequestBody) error {
+	err := c.DoRequest(ctx, http.MethodPut, c.ExpandPath("zones/"+url.PathEscape(id)), data, nil)
+	if err != nil {
+		return fmt.Errorf("error updating SDN zone: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteZone deletes an SDN zone.
+func (c *Client) DeleteZone(ctx context.Context, id string) error {
+	err := c.DoRequest(ctx, http.MethodDelete, c.ExpandPath("zones/"+url.PathEscape(id)), nil, nil)
+	if err != nil {
+		return fmt.Errorf("error deleting SDN zone: %w", err)
+	}
+
+	return nil
+}
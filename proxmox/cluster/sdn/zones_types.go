@@ -0,0 +1,71 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package sdn
+
+import (
+	"github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+// ZoneDataBase contains the fields shared by the SDN zone requests and responses.
+type ZoneDataBase struct {
+	Nodes      *string `json:"nodes,omitempty"      url:"nodes,omitempty"`
+	MTU        *int64  `json:"mtu,omitempty"        url:"mtu,omitempty"`
+	DNS        *string `json:"dns,omitempty"        url:"dns,omitempty"`
+	DNSZone    *string `json:"dnszone,omitempty"    url:"dnszone,omitempty"`
+	ReverseDNS *string `json:"reversedns,omitempty" url:"reversedns,omitempty"`
+	IPAM       *string `json:"ipam,omitempty"       url:"ipam,omitempty"`
+
+	// simple zone options
+	DHCP *string `json:"dhcp,omitempty" url:"dhcp,omitempty"`
+
+	// VLAN and QinQ zone options
+	Bridge              *string `json:"bridge,omitempty"        url:"bridge,omitempty"`
+	ServiceVLAN         *int64  `json:"tag,omitempty"           url:"tag,omitempty"`
+	ServiceVLANProtocol *string `json:"vlan-protocol,omitempty" url:"vlan-protocol,omitempty"`
+
+	// VXLAN zone options
+	Peers *string `json:"peers,omitempty" url:"peers,omitempty"`
+
+	// EVPN zone options
+	Controller              *string           `json:"controller,omitempty"                 url:"controller,omitempty"`
+	VRFVXLANID              *int64            `json:"vrf-vxlan,omitempty"                  url:"vrf-vxlan,omitempty"`
+	MAC                     *string           `json:"mac,omitempty"                        url:"mac,omitempty"`
+	ExitNodes               *string           `json:"exitnodes,omitempty"                  url:"exitnodes,omitempty"`
+	PrimaryExitNode         *string           `json:"exitnodes-primary,omitempty"          url:"exitnodes-primary,omitempty"`
+	ExitNodesLocalRouting   *types.CustomBool `json:"exitnodes-local-routing,omitempty"    url:"exitnodes-local-routing,omitempty,int"`
+	AdvertiseSubnets        *types.CustomBool `json:"advertise-subnets,omitempty"          url:"advertise-subnets,omitempty,int"`
+	DisableARPNDSuppression *types.CustomBool `json:"disable-arp-nd-suppression,omitempty" url:"disable-arp-nd-suppression,omitempty,int"`
+	RouteTargetImport       *string           `json:"rt-import,omitempty"                  url:"rt-import,omitempty"`
+}
+
+// ZoneGetResponseBody contains the body from an SDN zone get response.
+type ZoneGetResponseBody struct {
+	Data *ZoneGetResponseData `json:"data,omitempty"`
+}
+
+// ZoneGetResponseData contains the data from an SDN zone get response.
+type ZoneGetResponseData struct {
+	ID   string `json:"zone"`
+	Type string `json:"type"`
+
+	ZoneDataBase
+}
+
+// ZoneCreateRequestBody contains the body for an SDN zone create request.
+type ZoneCreateRequestBody struct {
+	ID   string `url:"zone"`
+	Type string `url:"type"`
+
+	ZoneDataBase
+}
+
+// ZoneUpdateRequestBody contains the body for an SDN zone update request.
+type ZoneUpdateRequestBody struct {
+	Delete []string `url:"delete,omitempty,comma"`
+
+	ZoneDataBase
+}
@@ -0,0 +1,416 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package fakepve
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/helpers/totp"
+)
+
+// ticketLifetime is the lifetime of the authentication tickets, which is two hours in Proxmox VE.
+const ticketLifetime = 2 * time.Hour
+
+type ticket struct {
+	userID              string
+	csrfPreventionToken string
+	expires             time.Time
+	// challenge is true for the tickets of the users who still have to pass the second factor
+	challenge bool
+}
+
+type user struct {
+	id       string
+	password string
+	fields   record
+	tokens   map[string]*token
+
+	totpSecret   string
+	lastTOTPStep int64
+}
+
+func (u *user) enabled() bool {
+	return u.fields["enable"] != "0"
+}
+
+type token struct {
+	secret string
+	fields record
+}
+
+func (t *token) expired() bool {
+	expire, err := strconv.ParseInt(t.fields["expire"], 10, 64)
+
+	return err == nil && expire > 0 && time.Now().Unix() > expire
+}
+
+func (s *Server) registerAccessRoutes(mux *http.ServeMux) {
+	route(mux, http.MethodPost, "/access/ticket", s.createTicket)
+	route(mux, http.MethodPut, "/access/password", s.changePassword)
+
+	route(mux, http.MethodGet, "/access/users", s.listUsers)
+	route(mux, http.MethodPost, "/access/users", s.createUser)
+	route(mux, http.MethodGet, "/access/users/{userid}", s.withUser(s.getUser))
+	route(mux, http.MethodPut, "/access/users/{userid}", s.withUser(s.updateUser))
+	route(mux, http.MethodDelete, "/access/users/{userid}", s.withUser(s.deleteUser))
+
+	route(mux, http.MethodGet, "/access/users/{userid}/token", s.withUser(s.listTokens))
+	route(mux, http.MethodGet, "/access/users/{userid}/token/{tokenid}", s.withUser(s.getToken))
+	route(mux, http.MethodPost, "/access/users/{userid}/token/{tokenid}", s.withUser(s.createToken))
+	route(mux, http.MethodPut, "/access/users/{userid}/token/{tokenid}", s.withUser(s.updateToken))
+	route(mux, http.MethodDelete, "/access/users/{userid}/token/{tokenid}", s.withUser(s.deleteToken))
+}
+
+// withUser locks the server state, parses the request form and looks up the user in the request path.
+func (s *Server) withUser(h func(w http.ResponseWriter, r *http.Request, u *user)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if !parseForm(w, r) {
+			return
+		}
+
+		u, ok := s.users[r.PathValue("userid")]
+		if !ok {
+			writeError(w, http.StatusInternalServerError, fmt.Sprintf("user '%s' does not exist", r.PathValue("userid")))
+			return
+		}
+
+		h(w, r, u)
+	}
+}
+
+func (s *Server) createTicket(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !parseForm(w, r) || !requireParams(w, r, "username", "password") {
+		return
+	}
+
+	userID := r.Form.Get("username")
+	if realm := r.Form.Get("realm"); realm != "" && !strings.Contains(userID, "@") {
+		userID = userID + "@" + realm
+	}
+
+	u, ok := s.users[userID]
+	if !ok || !u.enabled() {
+		writeError(w, http.StatusUnauthorized, "authentication failure")
+		return
+	}
+
+	password := r.Form.Get("password")
+
+	switch challenge := r.Form.Get("tfa-challenge"); {
+	case challenge != "":
+		if !s.checkChallenge(r, u, challenge, password) {
+			writeError(w, http.StatusUnauthorized, "authentication failure")
+			return
+		}
+
+		delete(s.tickets, challenge)
+	case !s.checkPassword(u, password):
+		writeError(w, http.StatusUnauthorized, "authentication failure")
+		return
+	case u.totpSecret != "" && !s.isTicket(password):
+		// the renewals with a ticket do not need the second factor
+		s.writeTicket(w, userID, true)
+		return
+	}
+
+	s.writeTicket(w, userID, false)
+}
+
+// writeTicket issues a new ticket for the user, or a challenge ticket if the user has to pass the second factor.
+func (s *Server) writeTicket(w http.ResponseWriter, userID string, challenge bool) {
+	now := time.Now()
+	t := &ticket{
+		userID:              userID,
+		csrfPreventionToken: fmt.Sprintf("%X:%s", now.Unix(), uuid.NewString()),
+		expires:             now.Add(ticketLifetime),
+		challenge:           challenge,
+	}
+
+	value := fmt.Sprintf("PVE:%s:%X::%s", userID, now.Unix(), uuid.NewString())
+	if challenge {
+		value = fmt.Sprintf("PVE:!tfa!%s:%X::%s", userID, now.Unix(), uuid.NewString())
+	}
+
+	s.tickets[value] = t
+
+	data := map[string]any{
+		"username":            userID,
+		"ticket":              value,
+		"CSRFPreventionToken": t.csrfPreventionToken,
+		"clustername":         "fake",
+	}
+
+	if challenge {
+		data["NeedTFA"] = 1
+	}
+
+	writeData(w, data)
+}
+
+// checkChallenge returns true if the challenge ticket was issued to the user, and the password is a valid TOTP code
+// in the `totp:<code>` format. A code is only accepted once.
+func (s *Server) checkChallenge(r *http.Request, u *user, challenge, password string) bool {
+	t, ok := s.tickets[challenge]
+	if !ok || !t.challenge || t.userID != u.id || time.Now().After(t.expires) ||
+		r.Header.Get("CSRFPreventionToken") != t.csrfPreventionToken {
+		return false
+	}
+
+	now := time.Now()
+	step := totp.Step(now)
+
+	code, err := totp.Generate(u.totpSecret, now)
+	if err != nil || step <= u.lastTOTPStep ||
+		subtle.ConstantTimeCompare([]byte("totp:"+code), []byte(password)) != 1 {
+		return false
+	}
+
+	u.lastTOTPStep = step
+
+	return true
+}
+
+// isTicket returns true if the password is a valid ticket, which is how the tickets are renewed.
+func (s *Server) isTicket(password string) bool {
+	t, ok := s.tickets[password]
+
+	return ok && !t.challenge && time.Now().Before(t.expires)
+}
+
+// checkPassword returns true if the password is the one of the user, or a valid ticket of the user, which is how
+// the tickets are renewed.
+func (s *Server) checkPassword(u *user, password string) bool {
+	if t, ok := s.tickets[password]; ok {
+		return t.userID == u.id && s.isTicket(password)
+	}
+
+	return u.password != "" && subtle.ConstantTimeCompare([]byte(u.password), []byte(password)) == 1
+}
+
+// ExpireTickets expires all the authentication tickets issued so far, as if they were issued more than two hours ago.
+func (s *Server) ExpireTickets() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, t := range s.tickets {
+		t.expires = time.Now()
+	}
+}
+
+func (s *Server) changePassword(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !parseForm(w, r) || !requireParams(w, r, "userid", "password") {
+		return
+	}
+
+	u, ok := s.users[r.Form.Get("userid")]
+	if !ok {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("user '%s' does not exist", r.Form.Get("userid")))
+		return
+	}
+
+	u.password = r.Form.Get("password")
+
+	writeData(w, nil)
+}
+
+func (s *Server) listUsers(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	users := make([]map[string]any, 0, len(s.users))
+
+	for _, u := range s.users {
+		data := renderUser(u)
+		data["userid"] = u.id
+
+		users = append(users, data)
+	}
+
+	writeData(w, users)
+}
+
+func (s *Server) createUser(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !parseForm(w, r) || !requireParams(w, r, "userid") {
+		return
+	}
+
+	userID := r.Form.Get("userid")
+
+	if !strings.Contains(userID, "@") {
+		writeError(w, http.StatusBadRequest, "Parameter verification failed.", map[string]string{
+			"userid": "value does not look like a valid user ID",
+		})
+
+		return
+	}
+
+	if _, ok := s.users[userID]; ok {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("create user failed: user '%s' already exists", userID))
+		return
+	}
+
+	u := &user{
+		id:       userID,
+		password: r.Form.Get("password"),
+		fields:   record{},
+		tokens:   map[string]*token{},
+	}
+	u.fields.apply(r, "userid", "password")
+
+	s.users[userID] = u
+
+	writeData(w, nil)
+}
+
+func (s *Server) getUser(w http.ResponseWriter, _ *http.Request, u *user) {
+	data := renderUser(u)
+
+	tokens := map[string]any{}
+	for name, t := range u.tokens {
+		tokens[name] = t.fields.render("comment")
+	}
+
+	data["tokens"] = tokens
+
+	writeData(w, data)
+}
+
+func (s *Server) updateUser(w http.ResponseWriter, r *http.Request, u *user) {
+	if r.Form.Get("append") == "1" && r.Form.Get("groups") != "" {
+		groups := strings.Split(u.fields["groups"], ",")
+
+		for _, g := range strings.Split(r.Form.Get("groups"), ",") {
+			if !slices.Contains(groups, g) {
+				groups = append(groups, g)
+			}
+		}
+
+		r.Form.Set("groups", strings.Trim(strings.Join(groups, ","), ","))
+	}
+
+	u.fields.apply(r, "userid", "password", "append")
+
+	writeData(w, nil)
+}
+
+func (s *Server) deleteUser(w http.ResponseWriter, _ *http.Request, u *user) {
+	delete(s.users, u.id)
+
+	for k, t := range s.tickets {
+		if t.userID == u.id {
+			delete(s.tickets, k)
+		}
+	}
+
+	writeData(w, nil)
+}
+
+func (s *Server) listTokens(w http.ResponseWriter, _ *http.Request, u *user) {
+	tokens := make([]map[string]any, 0, len(u.tokens))
+
+	for name, t := range u.tokens {
+		data := t.fields.render("comment")
+		data["tokenid"] = name
+
+		tokens = append(tokens, data)
+	}
+
+	writeData(w, tokens)
+}
+
+func (s *Server) getToken(w http.ResponseWriter, r *http.Request, u *user) {
+	t, ok := u.tokens[r.PathValue("tokenid")]
+	if !ok {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("no such token '%s' for user '%s'",
+			r.PathValue("tokenid"), u.id))
+
+		return
+	}
+
+	writeData(w, t.fields.render("comment"))
+}
+
+func (s *Server) createToken(w http.ResponseWriter, r *http.Request, u *user) {
+	name := r.PathValue("tokenid")
+
+	if _, ok := u.tokens[name]; ok {
+		writeError(w, http.StatusBadRequest, "Token already exists.")
+		return
+	}
+
+	t := &token{secret: uuid.NewString(), fields: record{"privsep": "1", "expire": "0"}}
+	t.fields.apply(r)
+
+	u.tokens[name] = t
+
+	writeData(w, map[string]any{
+		"full-tokenid": u.id + "!" + name,
+		"info":         t.fields.render("comment"),
+		"value":        t.secret,
+	})
+}
+
+func (s *Server) updateToken(w http.ResponseWriter, r *http.Request, u *user) {
+	t, ok := u.tokens[r.PathValue("tokenid")]
+	if !ok {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("no such token '%s' for user '%s'",
+			r.PathValue("tokenid"), u.id))
+
+		return
+	}
+
+	t.fields.apply(r)
+
+	writeData(w, t.fields.render("comment"))
+}
+
+func (s *Server) deleteToken(w http.ResponseWriter, r *http.Request, u *user) {
+	if _, ok := u.tokens[r.PathValue("tokenid")]; !ok {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("no such token '%s' for user '%s'",
+			r.PathValue("tokenid"), u.id))
+
+		return
+	}
+
+	delete(u.tokens, r.PathValue("tokenid"))
+
+	writeData(w, nil)
+}
+
+// renderUser returns the user properties, with the groups as an array, as the API returns them.
+func renderUser(u *user) map[string]any {
+	data := u.fields.render("comment", "email", "firstname", "lastname", "keys")
+
+	groups := []string{}
+	if u.fields["groups"] != "" {
+		groups = strings.Split(u.fields["groups"], ",")
+	}
+
+	data["groups"] = groups
+
+	return data
+}
@@ -0,0 +1,257 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package fakepve
+
+import (
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+// backupJobStringKeys are the properties of the backup jobs that are rendered as strings even if they are numeric.
+var backupJobStringKeys = []string{"comment", "exclude", "mailto", "node", "pool", "schedule", "storage", "vmid"}
+
+func (s *Server) registerBackupRoutes(mux *http.ServeMux) {
+	route(mux, http.MethodPost, "/nodes/{node}/vzdump", s.backupGuest)
+	route(mux, http.MethodGet, "/cluster/backup", s.listBackupJobs)
+	route(mux, http.MethodPost, "/cluster/backup", s.createBackupJob)
+	route(mux, http.MethodGet, "/cluster/backup/{id}", s.withBackupJob(s.getBackupJob))
+	route(mux, http.MethodPut, "/cluster/backup/{id}", s.withBackupJob(s.updateBackupJob))
+	route(mux, http.MethodDelete, "/cluster/backup/{id}", s.withBackupJob(s.deleteBackupJob))
+}
+
+// withBackupJob looks up the backup job of the request path, and writes a `500` response if it does not exist.
+func (s *Server) withBackupJob(h func(w http.ResponseWriter, r *http.Request, job record)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if !parseForm(w, r) {
+			return
+		}
+
+		job, ok := s.backupJobs[r.PathValue("id")]
+		if !ok {
+			writeError(w, http.StatusInternalServerError, fmt.Sprintf("job '%s' does not exist", r.PathValue("id")))
+			return
+		}
+
+		h(w, r, job)
+	}
+}
+
+// renderBackupJob returns the backup job as the API does. The enabled jobs are scheduled to run at the next hour,
+// the fake does not parse the schedule.
+func renderBackupJob(job record) map[string]any {
+	data := job.render(backupJobStringKeys...)
+	data["type"] = "vzdump"
+
+	if job["enabled"] != "0" {
+		data["next-run"] = time.Now().Truncate(time.Hour).Add(time.Hour).Unix()
+	}
+
+	return data
+}
+
+func (s *Server) listBackupJobs(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	jobs := make([]map[string]any, 0, len(s.backupJobs))
+	for _, job := range s.backupJobs {
+		jobs = append(jobs, renderBackupJob(job))
+	}
+
+	writeData(w, jobs)
+}
+
+func (s *Server) createBackupJob(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !parseForm(w, r) || !requireParams(w, r, "id", "schedule") {
+		return
+	}
+
+	id := r.Form.Get("id")
+
+	if _, ok := s.backupJobs[id]; ok {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("Job '%s' already exists", id))
+		return
+	}
+
+	job := record{}
+	job.apply(r)
+
+	s.backupJobs[id] = job
+
+	writeData(w, nil)
+}
+
+func (s *Server) getBackupJob(w http.ResponseWriter, _ *http.Request, job record) {
+	writeData(w, renderBackupJob(job))
+}
+
+func (s *Server) updateBackupJob(w http.ResponseWriter, r *http.Request, job record) {
+	job.apply(r, "id")
+
+	writeData(w, nil)
+}
+
+func (s *Server) deleteBackupJob(w http.ResponseWriter, r *http.Request, _ record) {
+	delete(s.backupJobs, r.PathValue("id"))
+
+	writeData(w, nil)
+}
+
+// backupGuest creates a backup archive of a guest, which keeps a copy of the guest configuration to restore.
+// Like Proxmox VE, the task log reports the path of the created archive.
+func (s *Server) backupGuest(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.checkNode(w, r) || !parseForm(w, r) || !requireParams(w, r, "vmid") {
+		return
+	}
+
+	vmid, _ := strconv.Atoi(r.Form.Get("vmid"))
+
+	g, ok := s.guests[vmid]
+	if !ok || g.node != r.PathValue("node") {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("unable to find VM '%s'", r.Form.Get("vmid")))
+		return
+	}
+
+	storage := r.Form.Get("storage")
+	if storage == "" {
+		storage = "local"
+	}
+
+	d, ok := s.datastores[storage]
+	if !ok {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("storage '%s' does not exist", storage))
+		return
+	}
+
+	if !slices.Contains(d.content, "backup") {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("storage '%s' does not support backups", storage))
+		return
+	}
+
+	format := "vma.zst"
+	if g.kind == guestKindLXC {
+		format = "tar.zst"
+	}
+
+	var name, id string
+
+	for t := time.Now(); ; t = t.Add(time.Second) {
+		name = fmt.Sprintf("vzdump-%s-%d-%s.%s", g.kind, vmid, t.Format("2006_01_02-15_04_05"), format)
+		id = fmt.Sprintf("%s:backup/%s", storage, name)
+
+		if _, exists := d.volumes[id]; !exists {
+			break
+		}
+	}
+
+	d.volumes[id] = &volume{
+		id:      id,
+		content: "backup",
+		format:  format,
+		size:    1 << 20,
+		vmid:    vmid,
+		backup:  &guest{kind: g.kind, vmid: vmid, node: g.node, config: maps.Clone(g.config)},
+	}
+
+	dumpDir := "/var/lib/vz/dump"
+	if storage != "local" {
+		dumpDir = fmt.Sprintf("/mnt/pve/%s/dump", storage)
+	}
+
+	upid := s.newTask(r, g.node, "vzdump", strconv.Itoa(vmid), taskExitStatusOK)
+	s.tasks[upid].log = []string{
+		fmt.Sprintf("INFO: starting new backup job: vzdump %d --storage %s", vmid, storage),
+		fmt.Sprintf("INFO: creating vzdump archive '%s/%s'", dumpDir, name),
+		"INFO: Finished Backup of VM " + strconv.Itoa(vmid),
+	}
+
+	writeData(w, upid)
+}
+
+// restoreArchive returns the configuration of the guest backed up in the archive of the create request,
+// or nil if the request does not restore a backup.
+func (s *Server) restoreArchive(w http.ResponseWriter, r *http.Request, kind string) (record, bool) {
+	archive := r.Form.Get("archive")
+	if kind == guestKindLXC && r.Form.Get("restore") == "1" {
+		archive = r.Form.Get("ostemplate")
+	}
+
+	if archive == "" {
+		return nil, true
+	}
+
+	_, vol := s.volume(archive)
+	if vol == nil || vol.backup == nil || vol.backup.kind != kind {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("unable to restore from '%s' - no such archive",
+			archive))
+
+		return nil, false
+	}
+
+	config := maps.Clone(vol.backup.config)
+	delete(config, "lock")
+
+	return config, true
+}
+
+// restoreDisks allocates new volumes for the disks of a restored guest, on the datastore of the backed up disk
+// unless the `storage` is set, with the same size.
+func (s *Server) restoreDisks(g *guest, storage string) {
+	for k, v := range g.config {
+		if !g.isDisk(k) || strings.Contains(v, "media=cdrom") {
+			continue
+		}
+
+		opts := slices.DeleteFunc(strings.Split(v, ","), func(o string) bool { return o == "" })
+
+		datastoreID, spec, found := strings.Cut(opts[0], ":")
+		if !found || numberRegex.MatchString(spec) {
+			// a new disk, allocated with the other ones
+			continue
+		}
+
+		d, ok := s.datastores[datastoreID]
+		if storage != "" && s.datastores[storage] != nil {
+			d, ok = s.datastores[storage], true
+		}
+
+		if !ok {
+			continue
+		}
+
+		var size types.DiskSize
+
+		for _, o := range opts[1:] {
+			if value, found := strings.CutPrefix(o, "size="); found {
+				size, _ = types.ParseDiskSize(value)
+			}
+		}
+
+		vol := d.allocate(g.vmid, int64(size), "")
+		if g.kind == guestKindLXC {
+			vol.content = "rootdir"
+		}
+
+		g.config[k] = strings.Join(append([]string{vol.id}, opts[1:]...), ",")
+	}
+}
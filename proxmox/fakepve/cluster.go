@@ -0,0 +1,179 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package fakepve
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	firstGuestID = 100
+	nodeCPUs     = 8
+	nodeMemory   = 32 << 30
+)
+
+func (s *Server) registerClusterRoutes(mux *http.ServeMux) {
+	route(mux, http.MethodGet, "/version", s.getVersion)
+	route(mux, http.MethodGet, "/cluster/nextid", s.getNextID)
+	route(mux, http.MethodGet, "/cluster/resources", s.listClusterResources)
+	route(mux, http.MethodGet, "/nodes", s.listNodes)
+	route(mux, http.MethodGet, "/nodes/{node}/status", s.getNodeStatus)
+	route(mux, http.MethodGet, "/nodes/{node}/time", s.getNodeTime)
+}
+
+func (s *Server) getVersion(w http.ResponseWriter, _ *http.Request) {
+	release, _, _ := strings.Cut(s.version, ".")
+
+	writeData(w, map[string]any{
+		"version": s.version,
+		"release": release,
+		"repoid":  "fake",
+		"console": "xtermjs",
+	})
+}
+
+func (s *Server) getNextID(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !parseForm(w, r) {
+		return
+	}
+
+	if v := r.Form.Get("vmid"); v != "" {
+		vmid, err := strconv.Atoi(v)
+		if err != nil || vmid < firstGuestID {
+			writeError(w, http.StatusBadRequest, "Parameter verification failed.", map[string]string{
+				"vmid": fmt.Sprintf("value must have a minimum value of %d", firstGuestID),
+			})
+
+			return
+		}
+
+		if _, ok := s.guests[vmid]; ok {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("VM %d already exists", vmid))
+			return
+		}
+
+		writeData(w, strconv.Itoa(vmid))
+
+		return
+	}
+
+	vmid := firstGuestID
+	for s.guests[vmid] != nil {
+		vmid++
+	}
+
+	writeData(w, strconv.Itoa(vmid))
+}
+
+func (s *Server) listClusterResources(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !parseForm(w, r) {
+		return
+	}
+
+	resourceType := r.Form.Get("type")
+	data := []map[string]any{}
+
+	if resourceType == "" || resourceType == "node" {
+		for _, n := range s.nodes {
+			data = append(data, map[string]any{
+				"id":     "node/" + n,
+				"type":   "node",
+				"node":   n,
+				"status": "online",
+				"maxcpu": nodeCPUs,
+				"maxmem": nodeMemory,
+			})
+		}
+	}
+
+	if resourceType == "" || resourceType == "vm" {
+		for _, g := range s.sortedGuests() {
+			data = append(data, map[string]any{
+				"id":       fmt.Sprintf("%s/%d", g.kind, g.vmid),
+				"type":     g.kind,
+				"vmid":     g.vmid,
+				"node":     g.node,
+				"name":     g.name(),
+				"status":   g.status,
+				"template": g.template(),
+			})
+		}
+	}
+
+	if resourceType == "" || resourceType == "storage" {
+		for _, n := range s.nodes {
+			for _, d := range s.sortedDatastores() {
+				data = append(data, map[string]any{
+					"id":         fmt.Sprintf("storage/%s/%s", n, d.id),
+					"type":       "storage",
+					"storage":    d.id,
+					"node":       n,
+					"plugintype": d.kind,
+					"status":     "available",
+					"maxdisk":    d.capacity,
+					"disk":       d.used(),
+				})
+			}
+		}
+	}
+
+	writeData(w, data)
+}
+
+func (s *Server) listNodes(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data := make([]map[string]any, len(s.nodes))
+
+	for i, n := range s.nodes {
+		data[i] = map[string]any{
+			"node":   n,
+			"status": "online",
+			"maxcpu": nodeCPUs,
+			"maxmem": nodeMemory,
+			"mem":    0,
+			"cpu":    0.0,
+			"uptime": 3600,
+			"level":  "",
+		}
+	}
+
+	writeData(w, data)
+}
+
+func (s *Server) getNodeStatus(w http.ResponseWriter, r *http.Request) {
+	if !s.checkNode(w, r) {
+		return
+	}
+
+	writeData(w, map[string]any{
+		"cpuinfo": map[string]any{"cores": nodeCPUs, "sockets": 1, "model": "Fake CPU"},
+		"memory":  map[string]any{"total": nodeMemory, "used": 0, "free": nodeMemory},
+		"uptime":  3600,
+	})
+}
+
+func (s *Server) getNodeTime(w http.ResponseWriter, r *http.Request) {
+	if !s.checkNode(w, r) {
+		return
+	}
+
+	now := time.Now()
+
+	writeData(w, map[string]any{"localtime": now.Unix(), "time": now.Unix(), "timezone": "UTC"})
+}
@@ -0,0 +1,703 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package fakepve
+
+import (
+	"crypto/sha1" //nolint:gosec
+	"fmt"
+	"maps"
+	"net/http"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+const (
+	guestKindQEMU = "qemu"
+	guestKindLXC  = "lxc"
+
+	guestStatusRunning = "running"
+	guestStatusStopped = "stopped"
+)
+
+//nolint:gochecknoglobals
+var (
+	qemuDiskRegex = regexp.MustCompile(`^((ide|sata|scsi|virtio)\d+|efidisk0|tpmstate0)$`)
+	lxcDiskRegex  = regexp.MustCompile(`^(rootfs|mp\d+)$`)
+
+	// the free-form string properties, which must not be rendered as numbers.
+	guestStringKeys = []string{
+		"name", "hostname", "description", "tags", "ciuser", "cipassword", "searchdomain", "nameserver", "sshkeys",
+	}
+
+	// the request parameters that are not part of the guest configuration.
+	guestCreateParams = []string{
+		"vmid", "start", "pool", "storage", "archive", "force", "unique", "live-restore", "ostemplate", "password",
+		"ssh-public-keys", "restore", "ignore-unpack-errors",
+	}
+)
+
+type guest struct {
+	kind   string
+	vmid   int
+	node   string
+	config record
+	status string
+}
+
+func (g *guest) name() string {
+	if g.kind == guestKindLXC {
+		return g.config["hostname"]
+	}
+
+	return g.config["name"]
+}
+
+func (g *guest) template() int {
+	if g.config["template"] == "1" {
+		return 1
+	}
+
+	return 0
+}
+
+func (g *guest) isDisk(key string) bool {
+	if g.kind == guestKindLXC {
+		return lxcDiskRegex.MatchString(key)
+	}
+
+	return qemuDiskRegex.MatchString(key)
+}
+
+// taskType returns the type of the task for the given action, e.g. `qmstart` or `vzstart`.
+func (g *guest) taskType(action string) string {
+	if g.kind == guestKindLXC {
+		return "vz" + action
+	}
+
+	return "qm" + action
+}
+
+func (g *guest) notFound() string {
+	if g.kind == guestKindLXC {
+		return fmt.Sprintf("Configuration file 'nodes/%s/lxc/%d.conf' does not exist", g.node, g.vmid)
+	}
+
+	return fmt.Sprintf("Configuration file 'nodes/%s/qemu-server/%d.conf' does not exist", g.node, g.vmid)
+}
+
+func (g *guest) digest() string {
+	keys := make([]string, 0, len(g.config))
+	for k := range g.config {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	h := sha1.New() //nolint:gosec
+	for _, k := range keys {
+		_, _ = fmt.Fprintf(h, "%s: %s\n", k, g.config[k])
+	}
+
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
+
+func (s *Server) sortedGuests() []*guest {
+	guests := make([]*guest, 0, len(s.guests))
+	for _, g := range s.guests {
+		guests = append(guests, g)
+	}
+
+	sort.Slice(guests, func(i, j int) bool {
+		return guests[i].vmid < guests[j].vmid
+	})
+
+	return guests
+}
+
+// allocateDisks allocates the volumes of the new disks, which are specified as `<datastore_id>:<size_in_gb>`,
+// and replaces them with the allocated volume IDs and sizes, like Proxmox VE does.
+func (s *Server) allocateDisks(g *guest) error {
+	for k, v := range g.config {
+		if !g.isDisk(k) {
+			continue
+		}
+
+		spec, format := "", ""
+		kept := []string{}
+
+		for _, o := range strings.Split(v, ",") {
+			name, value, found := strings.Cut(o, "=")
+
+			switch {
+			case o == "":
+				continue
+			case !found && spec == "":
+				spec = o
+			case name == "file" || name == "volume":
+				spec = value
+			case name == "format":
+				format = value
+				kept = append(kept, o)
+			case name == "import-from":
+				if err := s.checkImportSource(value); err != nil {
+					return err
+				}
+			case name != "size":
+				kept = append(kept, o)
+			}
+		}
+
+		datastoreID, size, found := strings.Cut(spec, ":")
+		if !found || !numberRegex.MatchString(size) {
+			continue
+		}
+
+		d, ok := s.datastores[datastoreID]
+		if !ok {
+			return fmt.Errorf("storage '%s' does not exist", datastoreID)
+		}
+
+		sizeGB, _ := strconv.ParseFloat(size, 64)
+		diskSize := types.DiskSize(sizeGB * (1 << 30))
+
+		if k == "efidisk0" || k == "tpmstate0" {
+			diskSize = 4 << 20
+		}
+
+		vol := d.allocate(g.vmid, int64(diskSize), format)
+		if g.kind == guestKindLXC {
+			vol.content = "rootdir"
+		}
+
+		g.config[k] = strings.Join(append(append([]string{vol.id}, kept...), "size="+diskSize.String()), ",")
+	}
+
+	return nil
+}
+
+// checkImportSource returns an error if the `import-from` disk option does not accept the source, which must be
+// an absolute path, or a volume of the `images` or `import` content types.
+func (s *Server) checkImportSource(source string) error {
+	if strings.HasPrefix(source, "/") {
+		return nil
+	}
+
+	_, vol := s.volume(source)
+	if vol == nil {
+		return fmt.Errorf("import-from: volume '%s' does not exist", source)
+	}
+
+	if vol.content != "images" && vol.content != "import" {
+		return fmt.Errorf("import-from: '%s' has wrong type '%s' - needs to be 'images' or 'import'", source, vol.content)
+	}
+
+	return nil
+}
+
+// deleteDisks removes the volumes owned by the guest, its backups are kept.
+func (s *Server) deleteDisks(g *guest) {
+	for _, d := range s.datastores {
+		for id, v := range d.volumes {
+			if v.vmid == g.vmid && (v.content == "images" || v.content == "rootdir") {
+				delete(d.volumes, id)
+			}
+		}
+	}
+}
+
+func (s *Server) registerGuestRoutes(mux *http.ServeMux, kind string) {
+	base := "/nodes/{node}/" + kind
+
+	route(mux, http.MethodGet, base, s.listGuests(kind))
+	route(mux, http.MethodPost, base, s.createGuest(kind))
+	route(mux, http.MethodDelete, base+"/{vmid}", s.withGuest(kind, s.deleteGuest))
+	route(mux, http.MethodGet, base+"/{vmid}/config", s.withGuest(kind, s.getGuestConfig))
+	route(mux, http.MethodPut, base+"/{vmid}/config", s.withGuest(kind, s.updateGuestConfig(false)))
+	route(mux, http.MethodPost, base+"/{vmid}/config", s.withGuest(kind, s.updateGuestConfig(true)))
+	route(mux, http.MethodGet, base+"/{vmid}/status/current", s.withGuest(kind, s.getGuestStatus))
+	route(mux, http.MethodPost, base+"/{vmid}/status/{action}", s.withGuest(kind, s.changeGuestStatus))
+	route(mux, http.MethodPost, base+"/{vmid}/clone", s.withGuest(kind, s.cloneGuest))
+	route(mux, http.MethodPut, base+"/{vmid}/resize", s.withGuest(kind, s.resizeGuestDisk))
+	route(mux, http.MethodPost, base+"/{vmid}/resize", s.withGuest(kind, s.resizeGuestDisk))
+	route(mux, http.MethodPost, base+"/{vmid}/migrate", s.withGuest(kind, s.migrateGuest))
+
+	if kind == guestKindLXC {
+		route(mux, http.MethodPost, base+"/{vmid}/move_volume", s.withGuest(kind, s.moveGuestVolume))
+	}
+
+	if kind == guestKindQEMU {
+		route(mux, http.MethodGet, base+"/{vmid}/agent/{command}", s.withGuest(kind, agentNotRunning))
+		route(mux, http.MethodPost, base+"/{vmid}/agent/{command}", s.withGuest(kind, agentNotRunning))
+	}
+}
+
+// withGuest locks the server state, parses the request form and looks up the guest in the request path.
+func (s *Server) withGuest(kind string, h func(w http.ResponseWriter, r *http.Request, g *guest)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if !s.checkNode(w, r) || !parseForm(w, r) {
+			return
+		}
+
+		vmid, err := strconv.Atoi(r.PathValue("vmid"))
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "Parameter verification failed.", map[string]string{
+				"vmid": "type check ('integer') failed",
+			})
+
+			return
+		}
+
+		g, ok := s.guests[vmid]
+		if !ok || g.kind != kind || g.node != r.PathValue("node") {
+			g = &guest{kind: kind, vmid: vmid, node: r.PathValue("node")}
+
+			writeError(w, http.StatusInternalServerError, g.notFound())
+
+			return
+		}
+
+		h(w, r, g)
+	}
+}
+
+func (s *Server) listGuests(kind string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if !s.checkNode(w, r) {
+			return
+		}
+
+		data := []map[string]any{}
+
+		for _, g := range s.sortedGuests() {
+			if g.kind != kind || g.node != r.PathValue("node") {
+				continue
+			}
+
+			data = append(data, map[string]any{
+				"vmid":     g.vmid,
+				"name":     g.name(),
+				"status":   g.status,
+				"tags":     g.config["tags"],
+				"template": g.template(),
+			})
+		}
+
+		writeData(w, data)
+	}
+}
+
+func (s *Server) createGuest(kind string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if !s.checkNode(w, r) || !parseForm(w, r) || !requireParams(w, r, "vmid") {
+			return
+		}
+
+		if kind == guestKindLXC && !requireParams(w, r, "ostemplate") {
+			return
+		}
+
+		vmid, err := strconv.Atoi(r.Form.Get("vmid"))
+		if err != nil || vmid < firstGuestID {
+			writeError(w, http.StatusBadRequest, "Parameter verification failed.", map[string]string{
+				"vmid": fmt.Sprintf("value must have a minimum value of %d", firstGuestID),
+			})
+
+			return
+		}
+
+		restored, ok := s.restoreArchive(w, r, kind)
+		if !ok {
+			return
+		}
+
+		if existing, ok := s.guests[vmid]; ok {
+			if restored == nil || r.Form.Get("force") != "1" {
+				writeError(w, http.StatusInternalServerError, fmt.Sprintf(
+					"unable to create VM %d - VM %d already exists on node '%s'", vmid, vmid, existing.node))
+
+				return
+			}
+
+			s.deleteDisks(existing)
+		}
+
+		g := &guest{kind: kind, vmid: vmid, node: r.PathValue("node"), config: record{}, status: guestStatusStopped}
+		if restored != nil {
+			g.config = restored
+		}
+
+		g.config.apply(r, guestCreateParams...)
+
+		if restored != nil {
+			s.restoreDisks(g, r.Form.Get("storage"))
+		}
+
+		if kind == guestKindLXC {
+			storage := r.Form.Get("storage")
+			if storage == "" {
+				storage = "local-lvm"
+			}
+
+			if _, ok := g.config["rootfs"]; !ok {
+				g.config["rootfs"] = storage + ":4"
+			}
+		}
+
+		if err = s.allocateDisks(g); err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		if r.Form.Get("start") == "1" {
+			g.status = guestStatusRunning
+		}
+
+		s.guests[vmid] = g
+
+		writeData(w, s.newTask(r, g.node, g.taskType("create"), strconv.Itoa(vmid), taskExitStatusOK))
+	}
+}
+
+func (s *Server) deleteGuest(w http.ResponseWriter, r *http.Request, g *guest) {
+	if g.status == guestStatusRunning {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("VM %d is running - destroy failed", g.vmid))
+		return
+	}
+
+	s.deleteDisks(g)
+	delete(s.guests, g.vmid)
+
+	writeData(w, s.newTask(r, g.node, g.taskType("destroy"), strconv.Itoa(g.vmid), taskExitStatusOK))
+}
+
+func (s *Server) getGuestConfig(w http.ResponseWriter, _ *http.Request, g *guest) {
+	data := g.config.render(guestStringKeys...)
+	data["digest"] = g.digest()
+
+	writeData(w, data)
+}
+
+func (s *Server) updateGuestConfig(async bool) func(w http.ResponseWriter, r *http.Request, g *guest) {
+	return func(w http.ResponseWriter, r *http.Request, g *guest) {
+		if digest := r.Form.Get("digest"); digest != "" && digest != g.digest() {
+			writeError(w, http.StatusInternalServerError, "detected modified configuration - file changed by other user? "+
+				"Try again.")
+
+			return
+		}
+
+		g.config.apply(r, "skiplock", "revert", "background_delay")
+
+		if err := s.allocateDisks(g); err != nil {
+			writeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		if async {
+			writeData(w, s.newTask(r, g.node, g.taskType("config"), strconv.Itoa(g.vmid), taskExitStatusOK))
+			return
+		}
+
+		writeData(w, nil)
+	}
+}
+
+func (s *Server) getGuestStatus(w http.ResponseWriter, _ *http.Request, g *guest) {
+	data := map[string]any{
+		"vmid":   g.vmid,
+		"name":   g.name(),
+		"status": g.status,
+		"uptime": 0,
+	}
+
+	if g.kind == guestKindQEMU {
+		data["qmpstatus"] = g.status
+		data["agent"] = 0
+
+		if strings.HasPrefix(g.config["agent"], "1") || strings.Contains(g.config["agent"], "enabled=1") {
+			data["agent"] = 1
+		}
+	}
+
+	if tags, ok := g.config["tags"]; ok {
+		data["tags"] = tags
+	}
+
+	if lock, ok := g.config["lock"]; ok {
+		data["lock"] = lock
+	}
+
+	writeData(w, data)
+}
+
+func (s *Server) changeGuestStatus(w http.ResponseWriter, r *http.Request, g *guest) {
+	action := r.PathValue("action")
+
+	switch action {
+	case "start", "resume":
+		if g.template() == 1 {
+			writeError(w, http.StatusInternalServerError, fmt.Sprintf("VM %d is a template", g.vmid))
+			return
+		}
+
+		if g.status == guestStatusRunning {
+			writeError(w, http.StatusInternalServerError, fmt.Sprintf("VM %d already running", g.vmid))
+			return
+		}
+
+		g.status = guestStatusRunning
+	case "stop", "shutdown", "suspend":
+		if g.status != guestStatusRunning {
+			writeError(w, http.StatusInternalServerError, fmt.Sprintf("VM %d not running", g.vmid))
+			return
+		}
+
+		g.status = guestStatusStopped
+	case "reboot", "reset":
+		if g.status != guestStatusRunning {
+			writeError(w, http.StatusInternalServerError, fmt.Sprintf("VM %d not running", g.vmid))
+			return
+		}
+	default:
+		writeError(w, http.StatusNotImplemented, fmt.Sprintf("Method 'POST %s' not implemented",
+			strings.TrimPrefix(r.URL.Path, basePath)))
+
+		return
+	}
+
+	writeData(w, s.newTask(r, g.node, g.taskType(action), strconv.Itoa(g.vmid), taskExitStatusOK))
+}
+
+func (s *Server) cloneGuest(w http.ResponseWriter, r *http.Request, g *guest) {
+	if !requireParams(w, r, "newid") {
+		return
+	}
+
+	newID, err := strconv.Atoi(r.Form.Get("newid"))
+	if err != nil || newID < firstGuestID {
+		writeError(w, http.StatusBadRequest, "Parameter verification failed.", map[string]string{
+			"newid": fmt.Sprintf("value must have a minimum value of %d", firstGuestID),
+		})
+
+		return
+	}
+
+	if _, ok := s.guests[newID]; ok {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("unable to create VM %d: config file already exists",
+			newID))
+
+		return
+	}
+
+	node := g.node
+	if target := r.Form.Get("target"); target != "" {
+		node = target
+	}
+
+	clone := &guest{kind: g.kind, vmid: newID, node: node, config: maps.Clone(g.config), status: guestStatusStopped}
+	delete(clone.config, "template")
+	delete(clone.config, "lock")
+
+	nameKey := "name"
+	if g.kind == guestKindLXC {
+		nameKey = "hostname"
+	}
+
+	clone.config[nameKey] = fmt.Sprintf("Copy-of-VM-%s", g.name())
+	if name := r.Form.Get(nameKey); name != "" {
+		clone.config[nameKey] = name
+	}
+
+	if description := r.Form.Get("description"); description != "" {
+		clone.config["description"] = description
+	}
+
+	// the disks of the clone are allocated on the same datastores, unless the target one is set
+	for k, v := range clone.config {
+		if !clone.isDisk(k) || strings.Contains(v, "media=cdrom") {
+			continue
+		}
+
+		d, vol := s.volume(strings.Split(v, ",")[0])
+		if vol == nil {
+			continue
+		}
+
+		if target := r.Form.Get("storage"); target != "" && s.datastores[target] != nil {
+			d = s.datastores[target]
+		}
+
+		size := types.DiskSize(vol.size)
+		opts := strings.Split(v, ",")[1:]
+
+		newVolume := d.allocate(newID, vol.size, vol.format)
+		newVolume.content = vol.content
+
+		for i, o := range opts {
+			if strings.HasPrefix(o, "size=") {
+				opts[i] = "size=" + size.String()
+			}
+		}
+
+		clone.config[k] = strings.Join(append([]string{newVolume.id}, opts...), ",")
+	}
+
+	s.guests[newID] = clone
+
+	writeData(w, s.newTask(r, g.node, g.taskType("clone"), strconv.Itoa(g.vmid), taskExitStatusOK))
+}
+
+func (s *Server) resizeGuestDisk(w http.ResponseWriter, r *http.Request, g *guest) {
+	if !requireParams(w, r, "disk", "size") {
+		return
+	}
+
+	key := r.Form.Get("disk")
+
+	value, ok := g.config[key]
+	if !ok || !g.isDisk(key) {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("disk '%s' does not exist", key))
+		return
+	}
+
+	opts := strings.Split(value, ",")
+
+	_, vol := s.volume(opts[0])
+	if vol == nil {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("volume '%s' does not exist", opts[0]))
+		return
+	}
+
+	sizeParam := r.Form.Get("size")
+
+	size, err := types.ParseDiskSize(strings.TrimPrefix(sizeParam, "+"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Parameter verification failed.", map[string]string{"size": err.Error()})
+		return
+	}
+
+	if strings.HasPrefix(sizeParam, "+") {
+		size += types.DiskSize(vol.size)
+	}
+
+	if int64(size) < vol.size {
+		writeError(w, http.StatusInternalServerError, "shrinking disks is not supported")
+		return
+	}
+
+	vol.size = int64(size)
+
+	opts = append(opts[:1], slices.DeleteFunc(opts[1:], func(o string) bool { return strings.HasPrefix(o, "size=") })...)
+	g.config[key] = strings.Join(append(opts, "size="+size.String()), ",")
+
+	writeData(w, s.newTask(r, g.node, g.taskType("resize"), strconv.Itoa(g.vmid), taskExitStatusOK))
+}
+
+// migrateGuest moves the guest to the target node. Like Proxmox VE, a running VM is migrated only online,
+// and a running container only in restart mode.
+func (s *Server) migrateGuest(w http.ResponseWriter, r *http.Request, g *guest) {
+	if !requireParams(w, r, "target") {
+		return
+	}
+
+	target := r.Form.Get("target")
+	if !slices.Contains(s.nodes, target) {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("no such cluster node '%s'", target))
+		return
+	}
+
+	if target == g.node {
+		writeError(w, http.StatusInternalServerError, "target is local node.")
+		return
+	}
+
+	if g.status == guestStatusRunning {
+		switch {
+		case g.kind == guestKindQEMU && r.Form.Get("online") != "1":
+			writeError(w, http.StatusInternalServerError, "can't migrate running VM without --online")
+			return
+		case g.kind == guestKindLXC && r.Form.Get("restart") != "1":
+			writeError(w, http.StatusInternalServerError, "lxc live migration is currently not implemented")
+			return
+		}
+	}
+
+	taskType := "vzmigrate"
+	if g.kind == guestKindQEMU {
+		taskType = "qmigrate"
+	}
+
+	upid := s.newTask(r, g.node, taskType, strconv.Itoa(g.vmid), taskExitStatusOK)
+	g.node = target
+
+	writeData(w, upid)
+}
+
+// moveGuestVolume moves a volume of a stopped container to another datastore, with the same size.
+func (s *Server) moveGuestVolume(w http.ResponseWriter, r *http.Request, g *guest) {
+	if !requireParams(w, r, "volume", "storage") {
+		return
+	}
+
+	key := r.Form.Get("volume")
+
+	value, ok := g.config[key]
+	if !ok || !g.isDisk(key) {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("volume '%s' does not exist", key))
+		return
+	}
+
+	if g.status == guestStatusRunning {
+		writeError(w, http.StatusInternalServerError, "cannot move volumes of a running container")
+		return
+	}
+
+	d, ok := s.datastores[r.Form.Get("storage")]
+	if !ok || !slices.Contains(d.content, "rootdir") {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("storage '%s' does not support container directories",
+			r.Form.Get("storage")))
+
+		return
+	}
+
+	opts := strings.Split(value, ",")
+
+	oldDatastore, vol := s.volume(opts[0])
+	if vol == nil {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("volume '%s' does not exist", opts[0]))
+		return
+	}
+
+	newVolume := d.allocate(g.vmid, vol.size, "raw")
+	newVolume.content = vol.content
+
+	if r.Form.Get("delete") == "1" {
+		delete(oldDatastore.volumes, vol.id)
+	}
+
+	g.config[key] = strings.Join(append([]string{newVolume.id}, opts[1:]...), ",")
+
+	writeData(w, s.newTask(r, g.node, "move_volume", strconv.Itoa(g.vmid), taskExitStatusOK))
+}
+
+func agentNotRunning(w http.ResponseWriter, _ *http.Request, g *guest) {
+	writeError(w, http.StatusInternalServerError, fmt.Sprintf("QEMU guest agent is not running for VM %d", g.vmid))
+}
@@ -0,0 +1,81 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package fakepve
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var numberRegex = regexp.MustCompile(`^-?(0|[1-9]\d*)(\.\d+)?$`)
+
+// record holds the properties of an object, e.g. a guest configuration, as they are sent by the API client.
+type record map[string]string
+
+// apply sets the properties from the request form, and removes the ones listed in the `delete` parameter.
+// The `digest` parameter and the given keys are not properties of the object, and are skipped.
+func (r record) apply(req *http.Request, skip ...string) {
+	for k, v := range req.Form {
+		if k == "delete" || k == "digest" || slices.Contains(skip, k) || len(v) == 0 {
+			continue
+		}
+
+		r[k] = v[0]
+	}
+
+	for _, k := range strings.Split(req.Form.Get("delete"), ",") {
+		delete(r, strings.TrimSpace(k))
+	}
+}
+
+// render converts the record to the JSON object returned by the API. The API returns the numeric and boolean
+// properties as JSON numbers, which is what the client types expect, so the values that look like numbers are
+// rendered as numbers, except for the given free-form string properties.
+func (r record) render(stringKeys ...string) map[string]any {
+	data := make(map[string]any, len(r))
+
+	for k, v := range r {
+		if numberRegex.MatchString(v) && !slices.Contains(stringKeys, k) {
+			data[k] = json.Number(v)
+		} else {
+			data[k] = v
+		}
+	}
+
+	return data
+}
+
+// parseForm parses the request form, writing a `400` response if it is invalid.
+func parseForm(w http.ResponseWriter, r *http.Request) bool {
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, "Parameter verification failed.", map[string]string{"form": err.Error()})
+		return false
+	}
+
+	return true
+}
+
+// requireParams writes a `400` response and returns false if any of the given parameters is missing.
+func requireParams(w http.ResponseWriter, r *http.Request, names ...string) bool {
+	errs := map[string]string{}
+
+	for _, n := range names {
+		if r.Form.Get(n) == "" {
+			errs[n] = "property is missing and it is not optional"
+		}
+	}
+
+	if len(errs) > 0 {
+		writeError(w, http.StatusBadRequest, "Parameter verification failed.", errs)
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,337 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package fakepve
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	basePath = "/api2/json"
+
+	// DefaultUsername is the name of the root user of the fake API.
+	DefaultUsername = "root@pam"
+
+	// DefaultPassword is the password of the root user of the fake API.
+	DefaultPassword = "password"
+
+	// DefaultNodeName is the name of the node of the fake API.
+	DefaultNodeName = "pve"
+
+	// DefaultVersion is the Proxmox VE version reported by the fake API.
+	DefaultVersion = "8.3.2"
+
+	rootTokenName = "fake"
+)
+
+// Server is an in-process fake of the Proxmox VE JSON API, backed by an in-memory state.
+//
+// It implements the ticket and API token authentication, the task lifecycle, and a subset of the
+// `nodes`, `qemu`, `lxc`, `storage`, `access` and `cluster` endpoints, including the backup jobs, which is
+// enough to run the lifecycle of the basic resources without a Proxmox VE cluster. The unknown endpoints
+// return `501 Not Implemented`, like the real API does.
+type Server struct {
+	*httptest.Server
+
+	mu sync.Mutex
+
+	version      string
+	nodes        []string
+	taskDuration time.Duration
+
+	tickets    map[string]*ticket
+	users      map[string]*user
+	guests     map[int]*guest
+	datastores map[string]*datastore
+	tasks      map[string]*task
+	backupJobs map[string]record
+
+	pid      int64
+	rootKey  string
+	rootTOTP string
+}
+
+// Option is a configuration option of the fake API server.
+type Option func(s *Server)
+
+// WithNodes sets the names of the cluster nodes. The first node is the one used by default.
+func WithNodes(names ...string) Option {
+	return func(s *Server) {
+		s.nodes = names
+	}
+}
+
+// WithDatastore adds a datastore of the given type and content types, e.g. `WithDatastore("nfs", "nfs", "images")`.
+func WithDatastore(id string, kind string, content ...string) Option {
+	return func(s *Server) {
+		s.datastores[id] = &datastore{
+			id:       id,
+			kind:     kind,
+			content:  content,
+			volumes:  map[string]*volume{},
+			capacity: 100 << 30,
+		}
+	}
+}
+
+// WithVersion sets the Proxmox VE version reported by the `/version` endpoint.
+func WithVersion(version string) Option {
+	return func(s *Server) {
+		s.version = version
+	}
+}
+
+// WithTaskDuration sets how long the tasks stay in the `running` status before they are completed.
+// The tasks are completed immediately by default.
+func WithTaskDuration(d time.Duration) Option {
+	return func(s *Server) {
+		s.taskDuration = d
+	}
+}
+
+// WithRootTOTP enables the TOTP second factor of the root user, with the given base32 secret. The logins with the
+// password then return a challenge ticket, and the `/access/ticket` endpoint expects the TOTP code with it.
+func WithRootTOTP(secret string) Option {
+	return func(s *Server) {
+		s.rootTOTP = secret
+	}
+}
+
+// NewServer starts a new fake API server listening on a local TLS endpoint. The caller should call Close
+// when finished, to shut it down.
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		version:    DefaultVersion,
+		nodes:      []string{DefaultNodeName},
+		tickets:    map[string]*ticket{},
+		users:      map[string]*user{},
+		guests:     map[int]*guest{},
+		datastores: map[string]*datastore{},
+		tasks:      map[string]*task{},
+		backupJobs: map[string]record{},
+		pid:        0x1000,
+		rootKey:    uuid.NewString(),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.users[DefaultUsername] = &user{
+		id:       DefaultUsername,
+		password: DefaultPassword,
+		fields:   record{"enable": "1", "comment": "Fake root user"},
+		tokens: map[string]*token{
+			rootTokenName: {secret: s.rootKey, fields: record{"privsep": "0"}},
+		},
+		totpSecret: s.rootTOTP,
+	}
+
+	s.datastores["local"] = &datastore{
+		id:       "local",
+		kind:     "dir",
+		content:  []string{"backup", "import", "iso", "snippets", "vztmpl"},
+		volumes:  map[string]*volume{},
+		capacity: 100 << 30,
+	}
+
+	s.datastores["local-lvm"] = &datastore{
+		id:       "local-lvm",
+		kind:     "lvmthin",
+		content:  []string{"images", "rootdir"},
+		volumes:  map[string]*volume{},
+		capacity: 500 << 30,
+	}
+
+	mux := http.NewServeMux()
+
+	s.registerAccessRoutes(mux)
+	s.registerBackupRoutes(mux)
+	s.registerClusterRoutes(mux)
+	s.registerGuestRoutes(mux, guestKindQEMU)
+	s.registerGuestRoutes(mux, guestKindLXC)
+	s.registerStorageRoutes(mux)
+	s.registerTaskRoutes(mux)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotImplemented, fmt.Sprintf("Method '%s %s' not implemented",
+			r.Method, strings.TrimPrefix(r.URL.Path, basePath)))
+	})
+
+	s.Server = httptest.NewTLSServer(s.authenticate(trimTrailingSlash(mux)))
+
+	return s
+}
+
+// Endpoint returns the endpoint of the fake API, to be used as the provider `endpoint`.
+func (s *Server) Endpoint() string {
+	return s.URL + "/"
+}
+
+// APIToken returns an API token of the root user, with format `<user_id>!<token_name>=<secret>`.
+func (s *Server) APIToken() string {
+	return fmt.Sprintf("%s!%s=%s", DefaultUsername, rootTokenName, s.rootKey)
+}
+
+// NodeName returns the name of the first cluster node.
+func (s *Server) NodeName() string {
+	return s.nodes[0]
+}
+
+type userIDKey struct{}
+
+// requestUserID returns the ID of the user that authenticated the request.
+func requestUserID(r *http.Request) string {
+	userID, _ := r.Context().Value(userIDKey{}).(string)
+
+	return userID
+}
+
+// route registers a handler for a pattern relative to the `/api2/json` base path.
+func route(mux *http.ServeMux, method, pattern string, h http.HandlerFunc) {
+	mux.HandleFunc(fmt.Sprintf("%s %s%s", method, basePath, pattern), h)
+}
+
+// trimTrailingSlash removes the trailing slash from the request path, which the real API ignores,
+// e.g. `DELETE /nodes/pve/qemu/100/`.
+func trimTrailingSlash(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.URL.Path) > 1 && strings.HasSuffix(r.URL.Path, "/") {
+			r.URL.Path = strings.TrimRight(r.URL.Path, "/")
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// authenticate rejects the requests that are not authenticated with a valid ticket or API token.
+// Like the real API, the requests authenticated with a ticket must include the CSRF prevention token
+// unless they are read-only.
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == basePath+"/access/ticket" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		s.mu.Lock()
+		userID, ok := s.authenticateRequest(r)
+		s.mu.Unlock()
+
+		if !ok {
+			writeError(w, http.StatusUnauthorized, "authentication failure")
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), userIDKey{}, userID)))
+	})
+}
+
+func (s *Server) authenticateRequest(r *http.Request) (string, bool) {
+	if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, "PVEAPIToken=") {
+		tokenID, secret, found := strings.Cut(strings.TrimPrefix(auth, "PVEAPIToken="), "=")
+		if !found {
+			return "", false
+		}
+
+		userID, tokenName, found := strings.Cut(tokenID, "!")
+		if !found {
+			return "", false
+		}
+
+		u, ok := s.users[userID]
+		if !ok || !u.enabled() {
+			return "", false
+		}
+
+		t, ok := u.tokens[tokenName]
+		if !ok || t.expired() || subtle.ConstantTimeCompare([]byte(t.secret), []byte(secret)) != 1 {
+			return "", false
+		}
+
+		return userID, true
+	}
+
+	cookie, err := r.Cookie("PVEAuthCookie")
+	if err != nil {
+		return "", false
+	}
+
+	t, ok := s.tickets[cookie.Value]
+	if !ok || t.challenge || time.Now().After(t.expires) {
+		return "", false
+	}
+
+	if r.Method != http.MethodGet && r.Header.Get("CSRFPreventionToken") != t.csrfPreventionToken {
+		return "", false
+	}
+
+	return t.userID, true
+}
+
+// checkNode writes a `500` response and returns false if the node in the request path does not exist.
+func (s *Server) checkNode(w http.ResponseWriter, r *http.Request) bool {
+	if !slices.Contains(s.nodes, r.PathValue("node")) {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("hostname lookup '%s' failed", r.PathValue("node")))
+		return false
+	}
+
+	return true
+}
+
+// writeData writes a successful response with the given data.
+func writeData(w http.ResponseWriter, data any) {
+	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+
+	_ = json.NewEncoder(w).Encode(map[string]any{"data": data}) //nolint:errchkjson
+}
+
+// writeError writes an error response. Like the real API, the message is used as the HTTP reason phrase,
+// which the API client reports as the error, so the response is written to the hijacked connection.
+func writeError(w http.ResponseWriter, code int, message string, fieldErrors ...map[string]string) {
+	body := map[string]any{"data": nil, "message": message + "\n"}
+	if len(fieldErrors) > 0 {
+		body["errors"] = fieldErrors[0]
+	}
+
+	b, _ := json.Marshal(body) //nolint:errchkjson
+
+	hj, ok := w.(http.Hijacker)
+	if !ok {
+		w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+		w.WriteHeader(code)
+		_, _ = w.Write(b)
+
+		return
+	}
+
+	conn, buf, err := hj.Hijack()
+	if err != nil {
+		return
+	}
+
+	defer conn.Close()
+
+	reason := strings.NewReplacer("\r", " ", "\n", " ").Replace(message)
+
+	_, _ = fmt.Fprintf(buf, "HTTP/1.1 %d %s\r\n", code, reason)
+	_, _ = fmt.Fprintf(buf, "Content-Type: application/json;charset=UTF-8\r\n")
+	_, _ = fmt.Fprintf(buf, "Content-Length: %d\r\nConnection: close\r\n\r\n", len(b))
+	_, _ = buf.Write(b)
+	_ = buf.Flush()
+}
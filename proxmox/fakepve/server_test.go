@@ -0,0 +1,302 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package fakepve_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/access"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/cluster"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/fakepve"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/helpers/ptr"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/containers"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/storage"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/vms"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/types"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/version"
+)
+
+func newClient(t *testing.T, s *fakepve.Server, username, password, apiToken string) api.Client {
+	t.Helper()
+
+	creds, err := api.NewCredentials(username, password, "", apiToken, "", "")
+	require.NoError(t, err)
+
+	conn, err := api.NewConnection(s.Endpoint(), true, "")
+	require.NoError(t, err)
+
+	c, err := api.NewClient(creds, conn)
+	require.NoError(t, err)
+
+	return c
+}
+
+func newServer(t *testing.T) (*fakepve.Server, api.Client) {
+	t.Helper()
+
+	s := fakepve.NewServer()
+	t.Cleanup(s.Close)
+
+	return s, newClient(t, s, "", "", s.APIToken())
+}
+
+func TestAuthentication(t *testing.T) {
+	t.Parallel()
+
+	s, tokenClient := newServer(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		client  api.Client
+		wantErr bool
+	}{
+		{"api token", tokenClient, false},
+		{"ticket", newClient(t, s, fakepve.DefaultUsername, fakepve.DefaultPassword, ""), false},
+		{"wrong password", newClient(t, s, fakepve.DefaultUsername, "wrong", ""), true},
+		{"wrong api token", newClient(t, s, "", "", fakepve.DefaultUsername+"!fake=wrong"), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			v, err := (&version.Client{Client: tt.client}).Version(ctx)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, fakepve.DefaultVersion, v.Version)
+
+			// the ticket authentication requires the CSRF prevention token for the write requests
+			accessClient := &access.Client{Client: tt.client}
+			require.NoError(t, accessClient.CreateUser(ctx, &access.UserCreateRequestBody{
+				ID:       "user-" + tt.name[:3] + "@pve",
+				Password: "password",
+			}))
+		})
+	}
+}
+
+func TestNotImplemented(t *testing.T) {
+	t.Parallel()
+
+	_, c := newServer(t)
+
+	err := c.DoRequest(context.Background(), http.MethodGet, "cluster/ha/groups", nil, nil)
+	require.Error(t, err)
+
+	var httpError *api.HTTPError
+
+	require.ErrorAs(t, err, &httpError)
+	assert.Equal(t, http.StatusNotImplemented, httpError.Code)
+}
+
+func TestVMLifecycle(t *testing.T) {
+	t.Parallel()
+
+	s, c := newServer(t)
+	ctx := context.Background()
+
+	nodeClient := &nodes.Client{Client: c, NodeName: s.NodeName()}
+	clusterClient := &cluster.Client{Client: c}
+
+	vmID, err := clusterClient.GetNextID(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 100, *vmID)
+
+	body := &vms.CreateRequestBody{
+		VMID:            *vmID,
+		Name:            ptr.Ptr("test-vm"),
+		DedicatedMemory: ptr.Ptr(2048),
+	}
+	body.AddCustomStorageDevice("scsi0", vms.CustomStorageDevice{
+		FileVolume: "local-lvm:8",
+		IOThread:   types.CustomBool(true).Pointer(),
+	})
+
+	vmClient := nodeClient.VM(*vmID)
+	require.NoError(t, vmClient.CreateVM(ctx, body))
+
+	err = vmClient.CreateVM(ctx, body)
+	require.ErrorContains(t, err, "already exists")
+
+	vm, err := vmClient.GetVM(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "test-vm", *vm.Name)
+	assert.Equal(t, types.CustomInt64(2048), *vm.DedicatedMemory)
+	require.Contains(t, vm.StorageDevices, "scsi0")
+	assert.Equal(t, "local-lvm:vm-100-disk-0", vm.StorageDevices["scsi0"].FileVolume)
+	assert.Equal(t, int64(8), vm.StorageDevices["scsi0"].Size.InGigabytes())
+
+	_, err = vmClient.StartVM(ctx, 60)
+	require.NoError(t, err)
+
+	status, err := vmClient.GetVMStatus(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "running", status.Status)
+
+	err = c.DoRequest(ctx, http.MethodDelete, vmClient.ExpandPath(""), nil, nil)
+	require.ErrorContains(t, err, "is running")
+
+	require.NoError(t, vmClient.StopVM(ctx))
+
+	require.NoError(t, vmClient.ResizeVMDisk(ctx, &vms.ResizeDiskRequestBody{
+		Disk: "scsi0",
+		Size: *types.DiskSizeFromGigabytes(10),
+	}))
+
+	require.NoError(t, vmClient.CloneVM(ctx, 1, &vms.CloneRequestBody{VMIDNew: 101, Name: ptr.Ptr("clone")}))
+
+	clone, err := nodeClient.VM(101).GetVM(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "clone", *clone.Name)
+	assert.Equal(t, "local-lvm:vm-101-disk-0", clone.StorageDevices["scsi0"].FileVolume)
+	assert.Equal(t, int64(10), clone.StorageDevices["scsi0"].Size.InGigabytes())
+
+	list, err := nodeClient.VM(0).ListVMs(ctx)
+	require.NoError(t, err)
+	assert.Len(t, list, 2)
+
+	require.NoError(t, vmClient.DeleteVM(ctx))
+
+	_, err = vmClient.GetVM(ctx)
+	require.ErrorIs(t, err, api.ErrResourceDoesNotExist)
+
+	files, err := (&storage.Client{Client: nodeClient, StorageName: "local-lvm"}).ListDatastoreFiles(ctx)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	assert.Equal(t, "local-lvm:vm-101-disk-0", files[0].VolumeID)
+}
+
+func TestContainerLifecycle(t *testing.T) {
+	t.Parallel()
+
+	s, c := newServer(t)
+	ctx := context.Background()
+
+	containerClient := (&nodes.Client{Client: c, NodeName: s.NodeName()}).Container(200)
+
+	require.NoError(t, containerClient.CreateContainer(ctx, &containers.CreateRequestBody{
+		VMID:                 ptr.Ptr(200),
+		Hostname:             ptr.Ptr("test-ct"),
+		OSTemplateFileVolume: ptr.Ptr("local:vztmpl/debian.tar.zst"),
+		RootFS:               &containers.CustomRootFS{Volume: "local-lvm:4"},
+	}))
+
+	ct, err := containerClient.GetContainer(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "test-ct", *ct.Hostname)
+	assert.Equal(t, "local-lvm:vm-200-disk-0", ct.RootFS.Volume)
+
+	require.NoError(t, containerClient.StartContainer(ctx))
+	require.NoError(t, containerClient.WaitForContainerStatus(ctx, "running"))
+	require.NoError(t, containerClient.StopContainer(ctx))
+	require.NoError(t, containerClient.DeleteContainer(ctx))
+
+	_, err = containerClient.GetContainer(ctx)
+	require.ErrorIs(t, err, api.ErrResourceDoesNotExist)
+}
+
+func TestBackupRestore(t *testing.T) {
+	t.Parallel()
+
+	s, c := newServer(t)
+	ctx := context.Background()
+
+	nodeClient := &nodes.Client{Client: c, NodeName: s.NodeName()}
+
+	body := &vms.CreateRequestBody{VMID: 100, Name: ptr.Ptr("backed-up"), DedicatedMemory: ptr.Ptr(1024)}
+	body.AddCustomStorageDevice("scsi0", vms.CustomStorageDevice{FileVolume: "local-lvm:8"})
+	require.NoError(t, nodeClient.VM(100).CreateVM(ctx, body))
+
+	_, err := nodeClient.Vzdump(ctx, &nodes.VzdumpRequestBody{VMID: 100, Storage: "local-lvm"})
+	require.ErrorContains(t, err, "does not support backups")
+
+	archive, err := nodeClient.Vzdump(ctx, &nodes.VzdumpRequestBody{VMID: 100, Storage: "local"})
+	require.NoError(t, err)
+	assert.Regexp(t, `^local:backup/vzdump-qemu-100-.+\.vma\.zst$`, archive)
+
+	require.NoError(t, nodeClient.VM(100).DeleteVM(ctx))
+
+	restoreBody := &vms.CreateRequestBody{VMID: 101, BackupFile: &archive, Name: ptr.Ptr("restored")}
+	require.NoError(t, nodeClient.VM(0).CreateVM(ctx, restoreBody))
+
+	vm, err := nodeClient.VM(101).GetVM(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "restored", *vm.Name)
+	assert.Equal(t, types.CustomInt64(1024), *vm.DedicatedMemory)
+	assert.Equal(t, "local-lvm:vm-101-disk-0", vm.StorageDevices["scsi0"].FileVolume)
+	assert.Equal(t, int64(8), vm.StorageDevices["scsi0"].Size.InGigabytes())
+
+	err = nodeClient.VM(0).CreateVM(ctx, restoreBody)
+	require.ErrorContains(t, err, "already exists")
+
+	restoreBody.Overwrite = types.CustomBool(true).Pointer()
+	require.NoError(t, nodeClient.VM(0).CreateVM(ctx, restoreBody))
+
+	missing := "local:backup/vzdump-qemu-999.vma.zst"
+	err = nodeClient.VM(0).CreateVM(ctx, &vms.CreateRequestBody{VMID: 102, BackupFile: &missing})
+	require.ErrorContains(t, err, "no such archive")
+}
+
+func TestUnknownNode(t *testing.T) {
+	t.Parallel()
+
+	_, c := newServer(t)
+
+	_, err := (&nodes.Client{Client: c, NodeName: "unknown"}).VM(100).GetVM(context.Background())
+	require.Error(t, err)
+	assert.False(t, errors.Is(err, api.ErrResourceDoesNotExist))
+}
+
+func TestUserTokens(t *testing.T) {
+	t.Parallel()
+
+	s, c := newServer(t)
+	ctx := context.Background()
+
+	accessClient := &access.Client{Client: c}
+
+	require.NoError(t, accessClient.CreateUser(ctx, &access.UserCreateRequestBody{
+		ID:      "test@pve",
+		Comment: ptr.Ptr("test user"),
+	}))
+
+	user, err := accessClient.GetUser(ctx, "test@pve")
+	require.NoError(t, err)
+	assert.Equal(t, "test user", *user.Comment)
+
+	token, err := accessClient.CreateUserToken(ctx, "test@pve", "ci", &access.UserTokenCreateRequestBody{
+		PrivSeparate: types.CustomBool(false).Pointer(),
+	})
+	require.NoError(t, err)
+
+	// the new token can be used to authenticate
+	_, err = (&version.Client{Client: newClient(t, s, "", "", token)}).Version(ctx)
+	require.NoError(t, err)
+
+	tokens, err := accessClient.ListUserTokens(ctx, "test@pve")
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	assert.Equal(t, "ci", tokens[0].TokenID)
+
+	require.NoError(t, accessClient.DeleteUser(ctx, "test@pve"))
+
+	_, err = accessClient.GetUser(ctx, "test@pve")
+	require.Error(t, err)
+}
@@ -0,0 +1,327 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package fakepve
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// maxUploadMemory is the size of the uploaded file that is kept in memory, the rest is stored in temporary files.
+const maxUploadMemory = 32 << 20
+
+type datastore struct {
+	id       string
+	kind     string
+	content  []string
+	volumes  map[string]*volume
+	capacity int64
+}
+
+type volume struct {
+	id      string
+	content string
+	format  string
+	size    int64
+	vmid    int
+
+	// the guest backed up in the archive, for the `backup` content.
+	backup *guest
+}
+
+func (d *datastore) used() int64 {
+	var used int64
+
+	for _, v := range d.volumes {
+		used += v.size
+	}
+
+	return used
+}
+
+func (d *datastore) render() map[string]any {
+	used := d.used()
+
+	return map[string]any{
+		"storage":       d.id,
+		"type":          d.kind,
+		"content":       strings.Join(d.content, ","),
+		"active":        1,
+		"enabled":       1,
+		"shared":        0,
+		"total":         d.capacity,
+		"used":          used,
+		"avail":         d.capacity - used,
+		"used_fraction": float64(used) / float64(d.capacity),
+	}
+}
+
+// allocate creates a new guest disk volume on the datastore, named like Proxmox VE does, e.g. `vm-100-disk-0`.
+func (d *datastore) allocate(vmid int, size int64, format string) *volume {
+	for i := 0; ; i++ {
+		name := fmt.Sprintf("vm-%d-disk-%d", vmid, i)
+
+		if d.kind == "dir" {
+			if format == "" {
+				format = "qcow2"
+			}
+
+			name = fmt.Sprintf("%d/%s.%s", vmid, name, format)
+		} else {
+			format = "raw"
+		}
+
+		id := d.id + ":" + name
+
+		if _, ok := d.volumes[id]; !ok {
+			v := &volume{id: id, content: "images", format: format, size: size, vmid: vmid}
+			d.volumes[id] = v
+
+			return v
+		}
+	}
+}
+
+// volume looks up a volume by its ID, with format `<datastore_id>:<path>`.
+func (s *Server) volume(id string) (*datastore, *volume) {
+	datastoreID, _, _ := strings.Cut(id, ":")
+
+	d, ok := s.datastores[datastoreID]
+	if !ok {
+		return nil, nil
+	}
+
+	return d, d.volumes[id]
+}
+
+func (s *Server) registerStorageRoutes(mux *http.ServeMux) {
+	route(mux, http.MethodGet, "/storage", s.listStorage)
+	route(mux, http.MethodGet, "/storage/{storage}", s.withDatastore(s.getStorage))
+
+	route(mux, http.MethodGet, "/nodes/{node}/storage", s.listDatastores)
+	route(mux, http.MethodGet, "/nodes/{node}/storage/{storage}/status", s.withDatastore(s.getDatastoreStatus))
+	route(mux, http.MethodGet, "/nodes/{node}/storage/{storage}/content", s.withDatastore(s.listContent))
+	route(mux, http.MethodGet, "/nodes/{node}/storage/{storage}/content/{volume}", s.withDatastore(s.getContent))
+	route(mux, http.MethodDelete, "/nodes/{node}/storage/{storage}/content/{volume}", s.withDatastore(s.deleteContent))
+	route(mux, http.MethodPost, "/nodes/{node}/storage/{storage}/upload", s.withDatastore(s.upload))
+	route(mux, http.MethodPost, "/nodes/{node}/storage/{storage}/download-url", s.withDatastore(s.downloadURL))
+}
+
+// withDatastore locks the server state, parses the request form and looks up the datastore in the request path.
+func (s *Server) withDatastore(h func(w http.ResponseWriter, r *http.Request, d *datastore)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if r.PathValue("node") != "" && !s.checkNode(w, r) {
+			return
+		}
+
+		// the uploads are parsed by the handler
+		if !strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/") && !parseForm(w, r) {
+			return
+		}
+
+		d, ok := s.datastores[r.PathValue("storage")]
+		if !ok {
+			writeError(w, http.StatusInternalServerError, fmt.Sprintf("storage '%s' does not exist", r.PathValue("storage")))
+			return
+		}
+
+		h(w, r, d)
+	}
+}
+
+func (s *Server) sortedDatastores() []*datastore {
+	datastores := make([]*datastore, 0, len(s.datastores))
+	for _, d := range s.datastores {
+		datastores = append(datastores, d)
+	}
+
+	sort.Slice(datastores, func(i, j int) bool {
+		return datastores[i].id < datastores[j].id
+	})
+
+	return datastores
+}
+
+func (s *Server) listStorage(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data := []map[string]any{}
+
+	for _, d := range s.sortedDatastores() {
+		data = append(data, map[string]any{"storage": d.id, "type": d.kind, "content": strings.Join(d.content, ",")})
+	}
+
+	writeData(w, data)
+}
+
+func (s *Server) getStorage(w http.ResponseWriter, _ *http.Request, d *datastore) {
+	writeData(w, map[string]any{"storage": d.id, "type": d.kind, "content": strings.Join(d.content, ",")})
+}
+
+func (s *Server) listDatastores(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.checkNode(w, r) || !parseForm(w, r) {
+		return
+	}
+
+	data := []map[string]any{}
+
+	for _, d := range s.sortedDatastores() {
+		if id := r.Form.Get("storage"); id != "" && id != d.id {
+			continue
+		}
+
+		if content := r.Form.Get("content"); content != "" &&
+			!slices.ContainsFunc(strings.Split(content, ","), func(c string) bool { return slices.Contains(d.content, c) }) {
+			continue
+		}
+
+		data = append(data, d.render())
+	}
+
+	writeData(w, data)
+}
+
+func (s *Server) getDatastoreStatus(w http.ResponseWriter, _ *http.Request, d *datastore) {
+	writeData(w, d.render())
+}
+
+func (s *Server) listContent(w http.ResponseWriter, r *http.Request, d *datastore) {
+	volumes := make([]*volume, 0, len(d.volumes))
+
+	for _, v := range d.volumes {
+		if content := r.Form.Get("content"); content != "" && content != v.content {
+			continue
+		}
+
+		if vmid := r.Form.Get("vmid"); vmid != "" && vmid != strconv.Itoa(v.vmid) {
+			continue
+		}
+
+		volumes = append(volumes, v)
+	}
+
+	sort.Slice(volumes, func(i, j int) bool {
+		return volumes[i].id < volumes[j].id
+	})
+
+	data := make([]map[string]any, len(volumes))
+
+	for i, v := range volumes {
+		data[i] = map[string]any{"volid": v.id, "content": v.content, "format": v.format, "size": v.size}
+
+		if v.vmid > 0 {
+			data[i]["vmid"] = v.vmid
+		}
+	}
+
+	writeData(w, data)
+}
+
+func (s *Server) getContent(w http.ResponseWriter, r *http.Request, d *datastore) {
+	v := d.volumes[r.PathValue("volume")]
+	if v == nil {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("volume '%s' does not exist", r.PathValue("volume")))
+		return
+	}
+
+	_, name, _ := strings.Cut(v.id, ":")
+
+	writeData(w, map[string]any{
+		"path":   path.Join("/var/lib/vz", name),
+		"format": v.format,
+		"size":   v.size,
+		"used":   v.size,
+	})
+}
+
+func (s *Server) deleteContent(w http.ResponseWriter, r *http.Request, d *datastore) {
+	if d.volumes[r.PathValue("volume")] == nil {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("volume '%s' does not exist", r.PathValue("volume")))
+		return
+	}
+
+	delete(d.volumes, r.PathValue("volume"))
+
+	writeData(w, s.newTask(r, r.PathValue("node"), "imgdel", r.PathValue("volume"), taskExitStatusOK))
+}
+
+// addFile adds a file of the given content type to the datastore, returning false if the datastore does not
+// support the content type.
+func (s *Server) addFile(w http.ResponseWriter, d *datastore, content, fileName string, size int64) bool {
+	if !slices.Contains(d.content, content) {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("storage '%s' does not support content-type '%s'",
+			d.id, content))
+
+		return false
+	}
+
+	format := strings.TrimPrefix(path.Ext(fileName), ".")
+	if content == "iso" || content == "vztmpl" || content == "snippets" {
+		format = content
+	}
+
+	id := fmt.Sprintf("%s:%s/%s", d.id, content, fileName)
+	d.volumes[id] = &volume{id: id, content: content, format: format, size: size}
+
+	return true
+}
+
+func (s *Server) upload(w http.ResponseWriter, r *http.Request, d *datastore) {
+	if err := r.ParseMultipartForm(maxUploadMemory); err != nil {
+		writeError(w, http.StatusBadRequest, "Parameter verification failed.", map[string]string{"form": err.Error()})
+		return
+	}
+
+	file, header, err := r.FormFile("filename")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Parameter verification failed.", map[string]string{
+			"filename": "property is missing and it is not optional",
+		})
+
+		return
+	}
+
+	defer file.Close()
+
+	size, err := io.Copy(io.Discard, file)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("error reading upload: %s", err))
+		return
+	}
+
+	if !s.addFile(w, d, r.FormValue("content"), header.Filename, size) {
+		return
+	}
+
+	writeData(w, s.newTask(r, r.PathValue("node"), "imgcopy", "", taskExitStatusOK))
+}
+
+// downloadURL adds an empty file to the datastore, the fake API does not download anything.
+func (s *Server) downloadURL(w http.ResponseWriter, r *http.Request, d *datastore) {
+	if !requireParams(w, r, "content", "filename", "url") {
+		return
+	}
+
+	if !s.addFile(w, d, r.Form.Get("content"), r.Form.Get("filename"), 0) {
+		return
+	}
+
+	writeData(w, s.newTask(r, r.PathValue("node"), "download", "", taskExitStatusOK))
+}
@@ -0,0 +1,157 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package fakepve
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+)
+
+const taskExitStatusOK = "OK"
+
+type task struct {
+	upid       string
+	node       string
+	pid        int64
+	taskType   string
+	id         string
+	user       string
+	started    time.Time
+	finished   time.Time
+	exitStatus string
+	log        []string
+}
+
+func (t *task) running() bool {
+	return time.Now().Before(t.finished)
+}
+
+func (t *task) render() map[string]any {
+	data := map[string]any{
+		"upid":      t.upid,
+		"node":      t.node,
+		"pid":       t.pid,
+		"pstart":    t.pid,
+		"starttime": t.started.Unix(),
+		"type":      t.taskType,
+		"id":        t.id,
+		"user":      t.user,
+		"status":    "running",
+	}
+
+	if !t.running() {
+		data["status"] = "stopped"
+		data["exitstatus"] = t.exitStatus
+		data["endtime"] = t.finished.Unix()
+	}
+
+	return data
+}
+
+// newTask registers a task, and returns its UPID. The changes made by the task are applied by the caller
+// right away, the task only reports the `running` status for the configured duration, and then completes
+// with the given exit status.
+func (s *Server) newTask(r *http.Request, node, taskType, id, exitStatus string) string {
+	s.pid++
+
+	started := time.Now()
+	t := &task{
+		node:       node,
+		pid:        s.pid,
+		taskType:   taskType,
+		id:         id,
+		user:       requestUserID(r),
+		started:    started,
+		finished:   started.Add(s.taskDuration),
+		exitStatus: exitStatus,
+	}
+	t.upid = fmt.Sprintf("UPID:%s:%08X:%08X:%08X:%s:%s:%s:", node, t.pid, t.pid, started.Unix(), taskType, id, t.user)
+
+	s.tasks[t.upid] = t
+
+	return t.upid
+}
+
+func (s *Server) registerTaskRoutes(mux *http.ServeMux) {
+	route(mux, http.MethodGet, "/nodes/{node}/tasks", s.listTasks)
+	route(mux, http.MethodGet, "/nodes/{node}/tasks/{upid}/status", s.withTask(s.getTaskStatus))
+	route(mux, http.MethodGet, "/nodes/{node}/tasks/{upid}/log", s.withTask(s.getTaskLog))
+	route(mux, http.MethodDelete, "/nodes/{node}/tasks/{upid}", s.withTask(s.stopTask))
+}
+
+// withTask locks the server state and looks up the task in the request path.
+func (s *Server) withTask(h func(w http.ResponseWriter, r *http.Request, t *task)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		t, ok := s.tasks[r.PathValue("upid")]
+		if !ok || t.node != r.PathValue("node") {
+			writeError(w, http.StatusBadRequest, "no such task")
+			return
+		}
+
+		h(w, r, t)
+	}
+}
+
+func (s *Server) listTasks(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.checkNode(w, r) {
+		return
+	}
+
+	tasks := make([]*task, 0, len(s.tasks))
+
+	for _, t := range s.tasks {
+		if t.node == r.PathValue("node") {
+			tasks = append(tasks, t)
+		}
+	}
+
+	sort.Slice(tasks, func(i, j int) bool {
+		return tasks[i].pid > tasks[j].pid
+	})
+
+	data := make([]map[string]any, len(tasks))
+	for i, t := range tasks {
+		data[i] = t.render()
+	}
+
+	writeData(w, data)
+}
+
+func (s *Server) getTaskStatus(w http.ResponseWriter, _ *http.Request, t *task) {
+	writeData(w, t.render())
+}
+
+func (s *Server) getTaskLog(w http.ResponseWriter, _ *http.Request, t *task) {
+	lines := []map[string]any{}
+
+	for i, l := range t.log {
+		lines = append(lines, map[string]any{"n": i + 1, "t": l})
+	}
+
+	if !t.running() {
+		lines = append(lines, map[string]any{"n": len(lines) + 1, "t": "TASK " + t.exitStatus})
+	}
+
+	writeData(w, lines)
+}
+
+func (s *Server) stopTask(w http.ResponseWriter, _ *http.Request, t *task) {
+	if t.running() {
+		t.finished = time.Now()
+		t.exitStatus = "unexpected status"
+	}
+
+	writeData(w, nil)
+}
@@ -0,0 +1,77 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+// Package totp generates the time-based one-time passwords (RFC 6238) used for the two-factor authentication,
+// with the parameters of the Proxmox VE TOTP factors and of the common authenticator apps: HMAC-SHA1,
+// 30 seconds steps and 6 digits codes.
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/sha1" //nolint:gosec
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// Period is the duration of a step, a code is valid for one step.
+	Period = 30 * time.Second
+
+	digits = 6
+)
+
+// ErrInvalidSecret is returned when the secret is not a valid base32 string.
+var ErrInvalidSecret = errors.New("the TOTP secret must be a base32 string")
+
+// Step returns the step of the given time, codes generated at the times of the same step are equal.
+func Step(t time.Time) int64 {
+	return t.Unix() / int64(Period/time.Second)
+}
+
+// Generate generates the code at the given time from the base32 encoded secret. The secret is case-insensitive,
+// and may contain spaces and padding.
+func Generate(secret string, t time.Time) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+
+	var msg [8]byte
+
+	binary.BigEndian.PutUint64(msg[:], uint64(Step(t))) //nolint:gosec
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	code := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", digits, code%1_000_000), nil
+}
+
+// ValidateSecret returns an error if the secret is not a valid base32 string.
+func ValidateSecret(secret string) error {
+	_, err := decodeSecret(secret)
+
+	return err
+}
+
+func decodeSecret(secret string) ([]byte, error) {
+	secret = strings.ToUpper(strings.ReplaceAll(secret, " ", ""))
+	secret = strings.TrimRight(secret, "=")
+
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	if err != nil || len(key) == 0 {
+		return nil, ErrInvalidSecret
+	}
+
+	return key, nil
+}
@@ -0,0 +1,54 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package totp
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	// the SHA1 test vectors of RFC 6238, truncated to 6 digits
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+	tests := []struct {
+		time int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+
+	for _, tt := range tests {
+		code, err := Generate(secret, time.Unix(tt.time, 0))
+		require.NoError(t, err)
+		assert.Equal(t, tt.code, code, "time %d", tt.time)
+	}
+
+	// the secrets are often displayed in lowercase groups, without padding
+	code, err := Generate("gezd gnbv gy3t qojq gezd gnbv gy3t qojq", time.Unix(59, 0))
+	require.NoError(t, err)
+	assert.Equal(t, "287082", code)
+}
+
+func TestValidateSecret(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, ValidateSecret("JBSWY3DPEHPK3PXP"))
+	require.ErrorIs(t, ValidateSecret(""), ErrInvalidSecret)
+	require.ErrorIs(t, ValidateSecret("not-base32!"), ErrInvalidSecret)
+}
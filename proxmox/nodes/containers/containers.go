@@ -102,6 +102,68 @@ func (c *Client) GetContainerStatus(ctx context.Context) (*GetStatusResponseData
 	return resBody.Data, nil
 }
 
+// MigrateContainer migrates a container.
+func (c *Client) MigrateContainer(ctx context.Context, d *MigrateRequestBody) error {
+	taskID, err := c.MigrateContainerAsync(ctx, d)
+	if err != nil {
+		return err
+	}
+
+	err = c.Tasks().WaitForTask(ctx, *taskID)
+	if err != nil {
+		return fmt.Errorf("error waiting for container migration: %w", err)
+	}
+
+	return nil
+}
+
+// MigrateContainerAsync migrates a container asynchronously.
+func (c *Client) MigrateContainerAsync(ctx context.Context, d *MigrateRequestBody) (*string, error) {
+	resBody := &MigrateResponseBody{}
+
+	err := c.DoRequest(ctx, http.MethodPost, c.ExpandPath("migrate"), d, resBody)
+	if err != nil {
+		return nil, fmt.Errorf("error migrating container: %w", err)
+	}
+
+	if resBody.Data == nil {
+		return nil, api.ErrNoDataObjectInResponse
+	}
+
+	return resBody.Data, nil
+}
+
+// MoveContainerVolume moves a container volume, i.e. the root file system or a mount point, to another storage.
+func (c *Client) MoveContainerVolume(ctx context.Context, d *MoveVolumeRequestBody) error {
+	taskID, err := c.MoveContainerVolumeAsync(ctx, d)
+	if err != nil {
+		return err
+	}
+
+	err = c.Tasks().WaitForTask(ctx, *taskID)
+	if err != nil {
+		return fmt.Errorf("error waiting for container volume move: %w", err)
+	}
+
+	return nil
+}
+
+// MoveContainerVolumeAsync moves a container volume asynchronously.
+func (c *Client) MoveContainerVolumeAsync(ctx context.Context, d *MoveVolumeRequestBody) (*string, error) {
+	resBody := &MoveVolumeResponseBody{}
+
+	err := c.DoRequest(ctx, http.MethodPost, c.ExpandPath("move_volume"), d, resBody)
+	if err != nil {
+		return nil, fmt.Errorf("error moving container volume: %w", err)
+	}
+
+	if resBody.Data == nil {
+		return nil, api.ErrNoDataObjectInResponse
+	}
+
+	return resBody.Data, nil
+}
+
 // RebootContainer reboots a container.
 func (c *Client) RebootContainer(ctx context.Context, d *RebootRequestBody) error {
 	err := c.DoRequest(ctx, http.MethodPost, c.ExpandPath("status/reboot"), d, nil)
@@ -112,6 +174,48 @@ func (c *Client) RebootContainer(ctx context.Context, d *RebootRequestBody) erro
 	return nil
 }
 
+// ResizeContainerDisk resizes a container disk, i.e. the root file system or a mount point.
+func (c *Client) ResizeContainerDisk(ctx context.Context, d *ResizeDiskRequestBody) error {
+	err := retry.Do(
+		func() error {
+			taskID, err := c.ResizeContainerDiskAsync(ctx, d)
+			if err != nil {
+				return err
+			}
+
+			return c.Tasks().WaitForTask(ctx, *taskID)
+		},
+		retry.Context(ctx),
+		retry.Attempts(3),
+		retry.Delay(1*time.Second),
+		retry.LastErrorOnly(false),
+		retry.RetryIf(func(err error) bool {
+			return strings.Contains(err.Error(), "got timeout")
+		}),
+	)
+	if err != nil {
+		return fmt.Errorf("error waiting for container disk resize: %w", err)
+	}
+
+	return nil
+}
+
+// ResizeContainerDiskAsync resizes a container disk asynchronously.
+func (c *Client) ResizeContainerDiskAsync(ctx context.Context, d *ResizeDiskRequestBody) (*string, error) {
+	resBody := &ResizeDiskResponseBody{}
+
+	err := c.DoRequest(ctx, http.MethodPut, c.ExpandPath("resize"), d, resBody)
+	if err != nil {
+		return nil, fmt.Errorf("error resizing container disk: %w", err)
+	}
+
+	if resBody.Data == nil {
+		return nil, api.ErrNoDataObjectInResponse
+	}
+
+	return resBody.Data, nil
+}
+
 // ShutdownContainer shuts down a container.
 func (c *Client) ShutdownContainer(ctx context.Context, d *ShutdownRequestBody) error {
 	taskID, err := c.ShutdownContainerAsync(ctx, d)
@@ -171,6 +171,15 @@ type CreateResponseBody struct {
 
 type ShutdownResponseBody = CreateResponseBody
 
+// MigrateResponseBody contains the body from a container migrate response.
+type MigrateResponseBody = CreateResponseBody
+
+// MoveVolumeResponseBody contains the body from a container move volume response.
+type MoveVolumeResponseBody = CreateResponseBody
+
+// ResizeDiskResponseBody contains the body from a container resize disk response.
+type ResizeDiskResponseBody = CreateResponseBody
+
 // GetResponseBody contains the body from a user get response.
 type GetResponseBody struct {
 	Data *GetResponseData `json:"data,omitempty"`
@@ -233,6 +242,32 @@ type StartResponseBody struct {
 	Data *string `json:"data,omitempty"`
 }
 
+// MigrateRequestBody contains the body for a container migration request.
+type MigrateRequestBody struct {
+	BandwidthLimit *int              `json:"bwlimit,omitempty"        url:"bwlimit,omitempty"`
+	Online         *types.CustomBool `json:"online,omitempty"         url:"online,omitempty,int"`
+	Restart        *types.CustomBool `json:"restart,omitempty"        url:"restart,omitempty,int"`
+	TargetNode     string            `json:"target"                   url:"target"`
+	TargetStorage  *string           `json:"target-storage,omitempty" url:"target-storage,omitempty"`
+	Timeout        *int              `json:"timeout,omitempty"        url:"timeout,omitempty"`
+}
+
+// MoveVolumeRequestBody contains the body for a container move volume request.
+type MoveVolumeRequestBody struct {
+	BandwidthLimit       *int              `json:"bwlimit,omitempty" url:"bwlimit,omitempty"`
+	DeleteOriginalVolume *types.CustomBool `json:"delete,omitempty"  url:"delete,omitempty,int"`
+	Digest               *string           `json:"digest,omitempty"  url:"digest,omitempty"`
+	TargetStorage        string            `json:"storage"           url:"storage"`
+	Volume               string            `json:"volume"            url:"volume"`
+}
+
+// ResizeDiskRequestBody contains the body for a container resize disk request.
+type ResizeDiskRequestBody struct {
+	Digest *string        `json:"digest,omitempty" url:"digest,omitempty"`
+	Disk   string         `json:"disk"             url:"disk"`
+	Size   types.DiskSize `json:"size"             url:"size"`
+}
+
 // RebootRequestBody contains the body for a container reboot request.
 type RebootRequestBody struct {
 	Timeout *int `json:"timeout,omitempty" url:"timeout,omitempty"`
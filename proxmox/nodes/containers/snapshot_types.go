@@ -0,0 +1,50 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package containers
+
+import (
+	"github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+// SnapshotCreateRequestBody contains the body for a container snapshot create request.
+type SnapshotCreateRequestBody struct {
+	Name        string  `json:"snapname"              url:"snapname"`
+	Description *string `json:"description,omitempty" url:"description,omitempty"`
+}
+
+// SnapshotUpdateRequestBody contains the body for a container snapshot update request.
+type SnapshotUpdateRequestBody struct {
+	Description *string `json:"description,omitempty" url:"description,omitempty"`
+}
+
+// SnapshotRollbackRequestBody contains the body for a container snapshot rollback request.
+type SnapshotRollbackRequestBody struct {
+	Start *types.CustomBool `json:"start,omitempty" url:"start,omitempty,int"`
+}
+
+// SnapshotDeleteRequestBody contains the body for a container snapshot delete request.
+type SnapshotDeleteRequestBody struct {
+	Force *types.CustomBool `json:"force,omitempty" url:"force,omitempty,int"`
+}
+
+// SnapshotListResponseBody contains the body from a container snapshot list response.
+type SnapshotListResponseBody struct {
+	Data []*SnapshotListResponseData `json:"data,omitempty"`
+}
+
+// SnapshotListResponseData contains the data from a container snapshot list response.
+type SnapshotListResponseData struct {
+	Name        string                 `json:"name"`
+	Description *string                `json:"description,omitempty"`
+	Parent      *string                `json:"parent,omitempty"`
+	Time        *types.CustomTimestamp `json:"snaptime,omitempty"`
+}
+
+// SnapshotAsyncResponseBody contains the body from a container snapshot task response.
+type SnapshotAsyncResponseBody struct {
+	Data *string `json:"data,omitempty"`
+}
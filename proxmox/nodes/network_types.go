@@ -21,30 +21,39 @@ type NetworkInterfaceListResponseData struct {
 	// See https://github.com/bpg/terraform-provider-proxmox/issues/410
 	// BridgeFD        *int              `json:"bridge_fd,omitempty"`
 
-	Active          *types.CustomBool `json:"active,omitempty"`
-	Address         *string           `json:"address,omitempty"`
-	Address6        *string           `json:"address6,omitempty"`
-	Autostart       *types.CustomBool `json:"autostart,omitempty"`
-	BridgePorts     *string           `json:"bridge_ports,omitempty"`
-	BridgeSTP       *string           `json:"bridge_stp,omitempty"`
-	BridgeVIDs      *string           `json:"bridge_vids,omitempty"`
-	BridgeVLANAware *types.CustomBool `json:"bridge_vlan_aware,omitempty"`
-	CIDR            *string           `json:"cidr,omitempty"`
-	CIDR6           *string           `json:"cidr6,omitempty"`
-	Comments        *string           `json:"comments,omitempty"`
-	Exists          *types.CustomBool `json:"exists,omitempty"`
-	Families        *[]string         `json:"families,omitempty"`
-	Gateway         *string           `json:"gateway,omitempty"`
-	Gateway6        *string           `json:"gateway6,omitempty"`
-	Iface           string            `json:"iface"`
-	MethodIPv4      *string           `json:"method,omitempty"`
-	MethodIPv6      *string           `json:"method6,omitempty"`
-	MTU             *string           `json:"mtu,omitempty"`
-	Netmask         *string           `json:"netmask,omitempty"`
-	VLANID          *string           `json:"vlan-id,omitempty"`
-	VLANRawDevice   *string           `json:"vlan-raw-device,omitempty"`
-	Priority        int               `json:"priority"`
-	Type            string            `json:"type"`
+	Active             *types.CustomBool  `json:"active,omitempty"`
+	Address            *string            `json:"address,omitempty"`
+	Address6           *string            `json:"address6,omitempty"`
+	Autostart          *types.CustomBool  `json:"autostart,omitempty"`
+	BondMode           *string            `json:"bond_mode,omitempty"`
+	BondPrimary        *string            `json:"bond-primary,omitempty"`
+	BondXmitHashPolicy *string            `json:"bond_xmit_hash_policy,omitempty"`
+	BridgePorts        *string            `json:"bridge_ports,omitempty"`
+	BridgeSTP          *string            `json:"bridge_stp,omitempty"`
+	BridgeVIDs         *string            `json:"bridge_vids,omitempty"`
+	BridgeVLANAware    *types.CustomBool  `json:"bridge_vlan_aware,omitempty"`
+	CIDR               *string            `json:"cidr,omitempty"`
+	CIDR6              *string            `json:"cidr6,omitempty"`
+	Comments           *string            `json:"comments,omitempty"`
+	Exists             *types.CustomBool  `json:"exists,omitempty"`
+	Families           *[]string          `json:"families,omitempty"`
+	Gateway            *string            `json:"gateway,omitempty"`
+	Gateway6           *string            `json:"gateway6,omitempty"`
+	Iface              string             `json:"iface"`
+	MethodIPv4         *string            `json:"method,omitempty"`
+	MethodIPv6         *string            `json:"method6,omitempty"`
+	MTU                *string            `json:"mtu,omitempty"`
+	Netmask            *string            `json:"netmask,omitempty"`
+	OVSBonds           *string            `json:"ovs_bonds,omitempty"`
+	OVSBridge          *string            `json:"ovs_bridge,omitempty"`
+	OVSOptions         *string            `json:"ovs_options,omitempty"`
+	OVSPorts           *string            `json:"ovs_ports,omitempty"`
+	OVSTag             *types.CustomInt64 `json:"ovs_tag,omitempty"`
+	Slaves             *string            `json:"slaves,omitempty"`
+	VLANID             *string            `json:"vlan-id,omitempty"`
+	VLANRawDevice      *string            `json:"vlan-raw-device,omitempty"`
+	Priority           int                `json:"priority"`
+	Type               string             `json:"type"`
 }
 
 // NetworkInterfaceCreateUpdateRequestBody contains the body for a node network interface create / update request.
@@ -66,7 +75,7 @@ type NetworkInterfaceCreateUpdateRequestBody struct {
 	Comments6          *string           `json:"comments6,omitempty"             url:"comments6,omitempty"`
 	Gateway            *string           `json:"gateway,omitempty"               url:"gateway,omitempty"`
 	Gateway6           *string           `json:"gateway6,omitempty"              url:"gateway6,omitempty"`
-	Delete             *[]string         `json:"delete,omitempty"                url:"delete,omitempty"`
+	Delete             *[]string         `json:"delete,omitempty"                url:"delete,omitempty,comma"`
 	MTU                *int64            `json:"mtu,omitempty"                   url:"mtu,omitempty"`
 	Netmask            *string           `json:"netmask,omitempty"               url:"netmask,omitempty"`
 	Netmask6           *string           `json:"netmask6,omitempty"              url:"netmask6,omitempty"`
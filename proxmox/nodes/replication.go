@@ -0,0 +1,38 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package nodes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+)
+
+// GetReplicationJobStatus retrieves the status of a replication job running on the node.
+func (c *Client) GetReplicationJobStatus(ctx context.Context, id string) (*ReplicationJobStatusResponseData, error) {
+	resBody := &ReplicationJobStatusResponseBody{}
+
+	err := c.DoRequest(
+		ctx,
+		http.MethodGet,
+		c.ExpandPath(fmt.Sprintf("replication/%s/status", url.PathEscape(id))),
+		nil,
+		resBody,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving replication job status: %w", err)
+	}
+
+	if resBody.Data == nil {
+		return nil, api.ErrNoDataObjectInResponse
+	}
+
+	return resBody.Data, nil
+}
@@ -0,0 +1,27 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package nodes
+
+import (
+	"github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+// ReplicationJobStatusResponseBody contains the body from a replication job status response.
+type ReplicationJobStatusResponseBody struct {
+	Data *ReplicationJobStatusResponseData `json:"data,omitempty"`
+}
+
+// ReplicationJobStatusResponseData contains the data from a replication job status response.
+type ReplicationJobStatusResponseData struct {
+	ID        string                 `json:"id"`
+	Duration  *types.CustomFloat64   `json:"duration,omitempty"`
+	Error     *string                `json:"error,omitempty"`
+	FailCount *types.CustomInt64     `json:"fail_count,omitempty"`
+	LastSync  *types.CustomTimestamp `json:"last_sync,omitempty"`
+	LastTry   *types.CustomTimestamp `json:"last_try,omitempty"`
+	NextSync  *types.CustomTimestamp `json:"next_sync,omitempty"`
+}
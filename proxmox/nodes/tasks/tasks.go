@@ -19,6 +19,14 @@ import (
 	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
 )
 
+const (
+	// taskPollInitialDelay is the delay between the first polls of the task status, it doubles with each poll.
+	taskPollInitialDelay = 250 * time.Millisecond
+
+	// taskPollMaxDelay caps the delay between the polls of the task status.
+	taskPollMaxDelay = 5 * time.Second
+)
+
 // GetTaskStatus retrieves the status of a task.
 func (c *Client) GetTaskStatus(ctx context.Context, upid string) (*GetTaskStatusResponseData, error) {
 	resBody := &GetTaskStatusResponseBody{}
@@ -165,8 +173,12 @@ func (c *Client) WaitForTask(ctx context.Context, upid string, opts ...TaskWaitO
 		}),
 		retry.LastErrorOnly(true),
 		retry.UntilSucceeded(),
-		retry.DelayType(retry.FixedDelay),
-		retry.Delay(time.Second),
+		// the short tasks complete in a fraction of a second, the long ones are polled less often, with a jitter
+		// so the concurrent waits do not poll in lockstep
+		retry.DelayType(retry.CombineDelay(retry.BackOffDelay, retry.RandomDelay)),
+		retry.Delay(taskPollInitialDelay),
+		retry.MaxJitter(taskPollInitialDelay),
+		retry.MaxDelay(taskPollMaxDelay),
 	)
 
 	if errors.Is(err, context.DeadlineExceeded) {
@@ -0,0 +1,132 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package vms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/avast/retry-go/v4"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+)
+
+// AgentExec starts a command in the guest using the QEMU agent. Returns the PID of the started process.
+func (c *Client) AgentExec(ctx context.Context, d *AgentExecRequestBody) (int, error) {
+	resBody := &AgentExecResponseBody{}
+
+	err := c.DoRequest(ctx, http.MethodPost, c.ExpandPath("agent/exec"), d, resBody)
+	if err != nil {
+		return 0, fmt.Errorf("error executing command with agent: %w", err)
+	}
+
+	if resBody.Data == nil {
+		return 0, api.ErrNoDataObjectInResponse
+	}
+
+	return resBody.Data.PID, nil
+}
+
+// AgentExecStatus retrieves the status of a command started with AgentExec.
+func (c *Client) AgentExecStatus(ctx context.Context, pid int) (*AgentExecStatusResponseData, error) {
+	resBody := &AgentExecStatusResponseBody{}
+
+	reqBody := &AgentExecStatusRequestBody{PID: pid}
+
+	err := c.DoRequest(ctx, http.MethodGet, c.ExpandPath("agent/exec-status"), reqBody, resBody)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving status of agent command %d: %w", pid, err)
+	}
+
+	if resBody.Data == nil {
+		return nil, api.ErrNoDataObjectInResponse
+	}
+
+	return resBody.Data, nil
+}
+
+// WaitForAgentExec waits for a command started with AgentExec to exit, and returns its final status.
+func (c *Client) WaitForAgentExec(ctx context.Context, pid int) (*AgentExecStatusResponseData, error) {
+	stillRunning := errors.New("still running")
+
+	var status *AgentExecStatusResponseData
+
+	err := retry.Do(
+		func() error {
+			data, err := c.AgentExecStatus(ctx, pid)
+			if err != nil {
+				return err
+			}
+
+			if !data.Exited {
+				return stillRunning
+			}
+
+			status = data
+
+			return nil
+		},
+		retry.Context(ctx),
+		retry.RetryIf(func(err error) bool {
+			return errors.Is(err, stillRunning)
+		}),
+		retry.UntilSucceeded(),
+		retry.Delay(1*time.Second),
+		retry.LastErrorOnly(true),
+	)
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return nil, fmt.Errorf("timeout while waiting for agent command %d of VM %d to exit", pid, c.VMID)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error waiting for agent command %d of VM %d to exit: %w", pid, c.VMID, err)
+	}
+
+	return status, nil
+}
+
+// AgentFileRead reads a file in the guest using the QEMU agent. PVE truncates the content of large files.
+func (c *Client) AgentFileRead(ctx context.Context, file string) (*AgentFileReadResponseData, error) {
+	resBody := &AgentFileReadResponseBody{}
+
+	reqBody := &AgentFileReadRequestBody{File: file}
+
+	err := c.DoRequest(ctx, http.MethodGet, c.ExpandPath("agent/file-read"), reqBody, resBody)
+	if err != nil {
+		return nil, fmt.Errorf("error reading file %q with agent: %w", file, err)
+	}
+
+	if resBody.Data == nil {
+		return nil, api.ErrNoDataObjectInResponse
+	}
+
+	return resBody.Data, nil
+}
+
+// AgentFileWrite writes a file in the guest using the QEMU agent.
+func (c *Client) AgentFileWrite(ctx context.Context, d *AgentFileWriteRequestBody) error {
+	err := c.DoRequest(ctx, http.MethodPost, c.ExpandPath("agent/file-write"), d, nil)
+	if err != nil {
+		return fmt.Errorf("error writing file %q with agent: %w", d.File, err)
+	}
+
+	return nil
+}
+
+// AgentSetUserPassword sets the password of a guest user using the QEMU agent.
+func (c *Client) AgentSetUserPassword(ctx context.Context, d *AgentSetUserPasswordRequestBody) error {
+	err := c.DoRequest(ctx, http.MethodPost, c.ExpandPath("agent/set-user-password"), d, nil)
+	if err != nil {
+		return fmt.Errorf("error setting password of user %q with agent: %w", d.Username, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package vms
+
+import (
+	"github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+// AgentExecRequestBody contains the body for a QEMU agent exec request.
+type AgentExecRequestBody struct {
+	// Command is the program to run and its arguments.
+	Command   []string `json:"command"              url:"command"`
+	InputData *string  `json:"input-data,omitempty" url:"input-data,omitempty"`
+}
+
+// AgentExecResponseBody contains the body from a QEMU agent exec response.
+type AgentExecResponseBody struct {
+	Data *AgentExecResponseData `json:"data,omitempty"`
+}
+
+// AgentExecResponseData contains the data from a QEMU agent exec response.
+type AgentExecResponseData struct {
+	PID int `json:"pid"`
+}
+
+// AgentExecStatusRequestBody contains the body for a QEMU agent exec status request.
+type AgentExecStatusRequestBody struct {
+	PID int `json:"pid" url:"pid"`
+}
+
+// AgentExecStatusResponseBody contains the body from a QEMU agent exec status response.
+type AgentExecStatusResponseBody struct {
+	Data *AgentExecStatusResponseData `json:"data,omitempty"`
+}
+
+// AgentExecStatusResponseData contains the data from a QEMU agent exec status response.
+type AgentExecStatusResponseData struct {
+	Exited       types.CustomBool  `json:"exited"`
+	ExitCode     *int              `json:"exitcode,omitempty"`
+	Signal       *int              `json:"signal,omitempty"`
+	OutData      *string           `json:"out-data,omitempty"`
+	OutTruncated *types.CustomBool `json:"out-truncated,omitempty"`
+	ErrData      *string           `json:"err-data,omitempty"`
+	ErrTruncated *types.CustomBool `json:"err-truncated,omitempty"`
+}
+
+// AgentFileReadRequestBody contains the body for a QEMU agent file read request.
+type AgentFileReadRequestBody struct {
+	File string `json:"file" url:"file"`
+}
+
+// AgentFileReadResponseBody contains the body from a QEMU agent file read response.
+type AgentFileReadResponseBody struct {
+	Data *AgentFileReadResponseData `json:"data,omitempty"`
+}
+
+// AgentFileReadResponseData contains the data from a QEMU agent file read response.
+type AgentFileReadResponseData struct {
+	Content   string            `json:"content"`
+	Truncated *types.CustomBool `json:"truncated,omitempty"`
+}
+
+// AgentFileWriteRequestBody contains the body for a QEMU agent file write request.
+type AgentFileWriteRequestBody struct {
+	Content string `json:"content" url:"content"`
+	// Encode tells PVE to encode the content as base64, as required by the agent. When disabled, the content
+	// must be encoded beforehand, which allows writing binary files.
+	Encode *types.CustomBool `json:"encode,omitempty" url:"encode,omitempty,int"`
+	File   string            `json:"file"             url:"file"`
+}
+
+// AgentSetUserPasswordRequestBody contains the body for a QEMU agent set user password request.
+type AgentSetUserPasswordRequestBody struct {
+	// Crypted tells the agent that the password is already hashed, e.g. with crypt(3).
+	Crypted  *types.CustomBool `json:"crypted,omitempty" url:"crypted,omitempty,int"`
+	Password string            `json:"password"          url:"password"`
+	Username string            `json:"username"          url:"username"`
+}
@@ -0,0 +1,72 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package vms
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-querystring/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/helpers/ptr"
+)
+
+func TestAgentExecRequestBodyEncoding(t *testing.T) {
+	t.Parallel()
+
+	input := "hello"
+
+	v, err := query.Values(&AgentExecRequestBody{
+		Command:   []string{"/bin/sh", "-c", "cat > /tmp/greeting"},
+		InputData: &input,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"/bin/sh", "-c", "cat > /tmp/greeting"}, v["command"])
+	assert.Equal(t, "hello", v.Get("input-data"))
+}
+
+func TestUnmarshalAgentExecStatusResponseData(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		json     string
+		exited   bool
+		exitCode *int
+		outData  *string
+	}{
+		{
+			name:   "running",
+			json:   `{"exited": 0}`,
+			exited: false,
+		},
+		{
+			name:     "exited",
+			json:     `{"exited": 1, "exitcode": 2, "out-data": "done\n"}`,
+			exited:   true,
+			exitCode: ptr.Ptr(2),
+			outData:  ptr.Ptr("done\n"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var data AgentExecStatusResponseData
+
+			require.NoError(t, json.Unmarshal([]byte(tt.json), &data))
+
+			assert.Equal(t, tt.exited, bool(data.Exited))
+			assert.Equal(t, tt.exitCode, data.ExitCode)
+			assert.Equal(t, tt.outData, data.OutData)
+		})
+	}
+}
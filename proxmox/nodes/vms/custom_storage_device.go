@@ -37,6 +37,7 @@ type CustomStorageDevice struct {
 	Cache                   *string           `json:"cache,omitempty"       url:"cache,omitempty"`
 	Discard                 *string           `json:"discard,omitempty"     url:"discard,omitempty"`
 	Format                  *string           `json:"format,omitempty"      url:"format,omitempty"`
+	ImportFrom              *string           `json:"import-from,omitempty" url:"import-from,omitempty"`
 	IopsRead                *int              `json:"iops_rd,omitempty"     url:"iops_rd,omitempty"`
 	IopsWrite               *int              `json:"iops_wr,omitempty"     url:"iops_wr,omitempty"`
 	IOThread                *types.CustomBool `json:"iothread,omitempty"    url:"iothread,omitempty,int"`
@@ -219,6 +220,10 @@ func (d *CustomStorageDevice) EncodeValues(key string, v *url.Values) error {
 		values = append(values, fmt.Sprintf("size=%d", *d.Size))
 	}
 
+	if d.ImportFrom != nil {
+		values = append(values, fmt.Sprintf("import-from=%s", *d.ImportFrom))
+	}
+
 	values = append(values, d.EncodeOptions())
 
 	v.Add(key, strings.Join(values, ","))
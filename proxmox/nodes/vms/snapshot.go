@@ -0,0 +1,179 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package vms
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+)
+
+// currentSnapshotName is the name of the pseudo-snapshot PVE uses to mark the current VM state.
+const currentSnapshotName = "current"
+
+func (c *Client) snapshotPath(name string, path string) string {
+	ep := fmt.Sprintf("snapshot/%s", url.PathEscape(name))
+	if path != "" {
+		ep = fmt.Sprintf("%s/%s", ep, path)
+	}
+
+	return c.ExpandPath(ep)
+}
+
+// ListSnapshots retrieves the list of VM snapshots, excluding the "current" pseudo-snapshot.
+func (c *Client) ListSnapshots(ctx context.Context) ([]*SnapshotListResponseData, error) {
+	resBody := &SnapshotListResponseBody{}
+
+	err := c.DoRequest(ctx, http.MethodGet, c.ExpandPath("snapshot"), nil, resBody)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving VM snapshots: %w", err)
+	}
+
+	if resBody.Data == nil {
+		return nil, api.ErrNoDataObjectInResponse
+	}
+
+	snapshots := make([]*SnapshotListResponseData, 0, len(resBody.Data))
+
+	for _, s := range resBody.Data {
+		if s.Name != currentSnapshotName {
+			snapshots = append(snapshots, s)
+		}
+	}
+
+	return snapshots, nil
+}
+
+// GetSnapshot retrieves a single VM snapshot.
+func (c *Client) GetSnapshot(ctx context.Context, name string) (*SnapshotListResponseData, error) {
+	snapshots, err := c.ListSnapshots(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range snapshots {
+		if s.Name == name {
+			return s, nil
+		}
+	}
+
+	return nil, fmt.Errorf("error retrieving VM snapshot %q: %w", name, api.ErrResourceDoesNotExist)
+}
+
+// CreateSnapshot creates a VM snapshot.
+func (c *Client) CreateSnapshot(ctx context.Context, d *SnapshotCreateRequestBody) error {
+	taskID, err := c.CreateSnapshotAsync(ctx, d)
+	if err != nil {
+		return err
+	}
+
+	err = c.Tasks().WaitForTask(ctx, *taskID)
+	if err != nil {
+		return fmt.Errorf("error waiting for VM snapshot creation: %w", err)
+	}
+
+	return nil
+}
+
+// CreateSnapshotAsync creates a VM snapshot asynchronously. Returns ID of the started task.
+func (c *Client) CreateSnapshotAsync(ctx context.Context, d *SnapshotCreateRequestBody) (*string, error) {
+	resBody := &SnapshotAsyncResponseBody{}
+
+	err := c.DoRequest(ctx, http.MethodPost, c.ExpandPath("snapshot"), d, resBody)
+	if err != nil {
+		return nil, fmt.Errorf("error creating VM snapshot: %w", err)
+	}
+
+	if resBody.Data == nil {
+		return nil, api.ErrNoDataObjectInResponse
+	}
+
+	return resBody.Data, nil
+}
+
+// UpdateSnapshot updates the metadata of a VM snapshot.
+func (c *Client) UpdateSnapshot(ctx context.Context, name string, d *SnapshotUpdateRequestBody) error {
+	err := c.DoRequest(ctx, http.MethodPut, c.snapshotPath(name, "config"), d, nil)
+	if err != nil {
+		return fmt.Errorf("error updating VM snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// RollbackSnapshot rolls a VM back to a snapshot.
+func (c *Client) RollbackSnapshot(ctx context.Context, name string, d *SnapshotRollbackRequestBody) error {
+	taskID, err := c.RollbackSnapshotAsync(ctx, name, d)
+	if err != nil {
+		return err
+	}
+
+	err = c.Tasks().WaitForTask(ctx, *taskID)
+	if err != nil {
+		return fmt.Errorf("error waiting for VM snapshot rollback: %w", err)
+	}
+
+	return nil
+}
+
+// RollbackSnapshotAsync rolls a VM back to a snapshot asynchronously. Returns ID of the started task.
+func (c *Client) RollbackSnapshotAsync(
+	ctx context.Context,
+	name string,
+	d *SnapshotRollbackRequestBody,
+) (*string, error) {
+	resBody := &SnapshotAsyncResponseBody{}
+
+	err := c.DoRequest(ctx, http.MethodPost, c.snapshotPath(name, "rollback"), d, resBody)
+	if err != nil {
+		return nil, fmt.Errorf("error rolling back VM snapshot: %w", err)
+	}
+
+	if resBody.Data == nil {
+		return nil, api.ErrNoDataObjectInResponse
+	}
+
+	return resBody.Data, nil
+}
+
+// DeleteSnapshot deletes a VM snapshot.
+func (c *Client) DeleteSnapshot(ctx context.Context, name string, d *SnapshotDeleteRequestBody) error {
+	taskID, err := c.DeleteSnapshotAsync(ctx, name, d)
+	if err != nil {
+		return err
+	}
+
+	err = c.Tasks().WaitForTask(ctx, *taskID)
+	if err != nil {
+		return fmt.Errorf("error waiting for VM snapshot deletion: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteSnapshotAsync deletes a VM snapshot asynchronously. Returns ID of the started task.
+func (c *Client) DeleteSnapshotAsync(
+	ctx context.Context,
+	name string,
+	d *SnapshotDeleteRequestBody,
+) (*string, error) {
+	resBody := &SnapshotAsyncResponseBody{}
+
+	err := c.DoRequest(ctx, http.MethodDelete, c.snapshotPath(name, ""), d, resBody)
+	if err != nil {
+		return nil, fmt.Errorf("error deleting VM snapshot: %w", err)
+	}
+
+	if resBody.Data == nil {
+		return nil, api.ErrNoDataObjectInResponse
+	}
+
+	return resBody.Data, nil
+}
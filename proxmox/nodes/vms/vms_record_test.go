@@ -0,0 +1,62 @@
+//go:build acceptance || all
+
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package vms
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api/cassette"
+)
+
+// TestGetVMRecord records `testdata/get_vm.json` against the cluster of `PROXMOX_VE_ENDPOINT`, using the API token
+// of `PROXMOX_VE_API_TOKEN`. It runs only if `PROXMOX_VE_ACC_RECORD` is set, as it overwrites the cassette.
+//
+// The node must be named `pve`, have the `gpu` PCI and `share` directory mappings, and the VM 100 configured as
+// asserted by TestGetVMReplay, e.g. created with:
+//
+//	qm create 100 --name test-vm --memory 4096 --cores 2 --cpu x86-64-v2-AES --ostype l26 --tags 'prod;web' \
+//	  --scsihw virtio-scsi-single --scsi0 local-lvm:32,aio=io_uring,cache=writeback,discard=on,iothread=1,ssd=1 \
+//	  --efidisk0 local-lvm:1,efitype=4m,pre-enrolled-keys=1 --ide2 local:iso/debian-12.iso,media=cdrom \
+//	  --net0 virtio,bridge=vmbr0,firewall=1 --hostpci0 mapping=gpu,pcie=1,x-vga=1 \
+//	  --virtiofs0 dirid=share,cache=always,expose-acl=1 --boot 'order=scsi0;net0' --machine q35
+//	qm set 100 --unused0 local-lvm:vm-100-disk-2
+//
+// The VM 101 must not exist.
+func TestGetVMRecord(t *testing.T) {
+	if os.Getenv("PROXMOX_VE_ACC_RECORD") == "" {
+		t.Skip("PROXMOX_VE_ACC_RECORD is not set")
+	}
+
+	c := cassette.New()
+
+	creds, err := api.NewCredentials("", "", "", os.Getenv("PROXMOX_VE_API_TOKEN"), "", "")
+	require.NoError(t, err)
+
+	conn, err := api.NewConnection(os.Getenv("PROXMOX_VE_ENDPOINT"), true, "", api.WithTransport(c.Record))
+	require.NoError(t, err)
+
+	apiClient, err := api.NewClient(creds, conn)
+	require.NoError(t, err)
+
+	client := &nodeClient{Client: apiClient}
+	ctx := context.Background()
+
+	_, err = (&Client{Client: client, VMID: 100}).GetVM(ctx)
+	require.NoError(t, err)
+
+	_, err = (&Client{Client: client, VMID: 101}).GetVM(ctx)
+	require.ErrorIs(t, err, api.ErrResourceDoesNotExist)
+
+	require.NoError(t, c.Save("testdata/get_vm.json"))
+}
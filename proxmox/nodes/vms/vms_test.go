@@ -0,0 +1,84 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package vms
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api/cassette"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+// nodeClient is a minimal node client, the nodes package cannot be imported here.
+type nodeClient struct {
+	api.Client
+}
+
+func (c *nodeClient) ExpandPath(path string) string {
+	return "nodes/pve/" + path
+}
+
+func replayClient(t *testing.T, path string) (*cassette.Cassette, api.Client) {
+	t.Helper()
+
+	c, err := cassette.Load(path)
+	require.NoError(t, err)
+
+	creds, err := api.NewCredentials("", "", "", "root@pam!test=00000000-0000-0000-0000-000000000000", "", "")
+	require.NoError(t, err)
+
+	conn, err := api.NewConnection("https://pve.example.com:8006/", true, "", api.WithTransport(c.Replay))
+	require.NoError(t, err)
+
+	client, err := api.NewClient(creds, conn)
+	require.NoError(t, err)
+
+	return c, &nodeClient{Client: client}
+}
+
+// TestGetVMReplay replays `testdata/get_vm.json`. The cassette was written by hand in the format of the
+// recorded ones, it has not been recorded against a real cluster yet: run TestGetVMRecord to record it.
+func TestGetVMReplay(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c, client := replayClient(t, "testdata/get_vm.json")
+
+	vm, err := (&Client{Client: client, VMID: 100}).GetVM(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, "test-vm", *vm.Name)
+	assert.Equal(t, types.CustomInt64(4096), *vm.DedicatedMemory)
+	assert.Equal(t, int64(2), *vm.CPUCores)
+	assert.Equal(t, "virtio-scsi-single", *vm.SCSIHardware)
+	assert.Equal(t, "prod;web", *vm.Tags)
+
+	// `scsihw` is not a storage device, `efidisk0` and `unused0` are decoded separately
+	assert.Len(t, vm.StorageDevices, 2)
+	require.Contains(t, vm.StorageDevices, "scsi0")
+	assert.Equal(t, "local-lvm:vm-100-disk-0", vm.StorageDevices["scsi0"].FileVolume)
+	assert.Equal(t, int64(32), vm.StorageDevices["scsi0"].Size.InGigabytes())
+	assert.True(t, bool(*vm.StorageDevices["scsi0"].IOThread))
+	require.Contains(t, vm.StorageDevices, "ide2")
+	assert.Equal(t, "cdrom", *vm.StorageDevices["ide2"].Media)
+	assert.Equal(t, "4m", *vm.EFIDisk.Type)
+
+	require.Contains(t, vm.PCIDevices, "hostpci0")
+	assert.Equal(t, "gpu", *vm.PCIDevices["hostpci0"].Mapping)
+	require.Contains(t, vm.VirtiofsShares, "virtiofs0")
+	assert.Equal(t, "share", vm.VirtiofsShares["virtiofs0"].DirId)
+
+	_, err = (&Client{Client: client, VMID: 101}).GetVM(ctx)
+	require.ErrorIs(t, err, api.ErrResourceDoesNotExist)
+
+	assert.Empty(t, c.Unreplayed())
+}
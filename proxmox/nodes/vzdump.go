@@ -0,0 +1,79 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package nodes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"regexp"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+)
+
+var (
+	vzdumpArchiveRegex    = regexp.MustCompile(`creating vzdump archive '([^']+)'`)
+	vzdumpPBSArchiveRegex = regexp.MustCompile(`creating Proxmox Backup Server archive '([^']+)'`)
+)
+
+// Vzdump creates a backup of a guest and waits for it to finish. Returns the volume ID of the created archive.
+func (c *Client) Vzdump(ctx context.Context, d *VzdumpRequestBody) (string, error) {
+	taskID, err := c.VzdumpAsync(ctx, d)
+	if err != nil {
+		return "", err
+	}
+
+	err = c.Tasks().WaitForTask(ctx, *taskID)
+	if err != nil {
+		return "", fmt.Errorf("error waiting for backup: %w", err)
+	}
+
+	lines, err := c.Tasks().GetTaskLog(ctx, *taskID)
+	if err != nil {
+		return "", fmt.Errorf("error retrieving backup task log: %w", err)
+	}
+
+	volumeID := VzdumpVolumeID(d.Storage, lines)
+	if volumeID == "" {
+		return "", fmt.Errorf("unable to find the archive of backup task %s", *taskID)
+	}
+
+	return volumeID, nil
+}
+
+// VzdumpAsync creates a backup of a guest asynchronously. Returns ID of the started task.
+func (c *Client) VzdumpAsync(ctx context.Context, d *VzdumpRequestBody) (*string, error) {
+	resBody := &VzdumpResponseBody{}
+
+	err := c.DoRequest(ctx, http.MethodPost, c.ExpandPath("vzdump"), d, resBody)
+	if err != nil {
+		return nil, fmt.Errorf("error creating backup: %w", err)
+	}
+
+	if resBody.Data == nil {
+		return nil, api.ErrNoDataObjectInResponse
+	}
+
+	return resBody.Data, nil
+}
+
+// VzdumpVolumeID extracts the volume ID of the created archive from the log of a backup task.
+// Returns an empty string if the log does not reference an archive.
+func VzdumpVolumeID(storage string, lines []string) string {
+	for _, line := range lines {
+		if m := vzdumpArchiveRegex.FindStringSubmatch(line); m != nil {
+			return fmt.Sprintf("%s:backup/%s", storage, filepath.Base(m[1]))
+		}
+
+		if m := vzdumpPBSArchiveRegex.FindStringSubmatch(line); m != nil {
+			return fmt.Sprintf("%s:backup/%s", storage, m[1])
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,55 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package nodes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVzdumpVolumeID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		storage string
+		lines   []string
+		want    string
+	}{
+		{
+			name:    "vzdump archive",
+			storage: "local",
+			lines: []string{
+				"INFO: starting new backup job: vzdump 100 --storage local --mode snapshot",
+				"INFO: creating vzdump archive '/var/lib/vz/dump/vzdump-qemu-100-2024_05_01-10_00_00.vma.zst'",
+			},
+			want: "local:backup/vzdump-qemu-100-2024_05_01-10_00_00.vma.zst",
+		},
+		{
+			name:    "pbs archive",
+			storage: "pbs",
+			lines: []string{
+				"INFO: creating Proxmox Backup Server archive 'ct/101/2024-05-01T10:00:00Z'",
+			},
+			want: "pbs:backup/ct/101/2024-05-01T10:00:00Z",
+		},
+		{
+			name:    "no archive",
+			storage: "local",
+			lines:   []string{"ERROR: Backup of VM 100 failed"},
+			want:    "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, VzdumpVolumeID(tt.storage, tt.lines))
+		})
+	}
+}
@@ -0,0 +1,26 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package nodes
+
+import (
+	"github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+// VzdumpRequestBody contains the body for a backup request.
+type VzdumpRequestBody struct {
+	VMID          int               `json:"vmid"                     url:"vmid"`
+	Storage       string            `json:"storage"                  url:"storage"`
+	Mode          *string           `json:"mode,omitempty"           url:"mode,omitempty"`
+	Compress      *string           `json:"compress,omitempty"       url:"compress,omitempty"`
+	NotesTemplate *string           `json:"notes-template,omitempty" url:"notes-template,omitempty"`
+	Protected     *types.CustomBool `json:"protected,omitempty"      url:"protected,omitempty,int"`
+}
+
+// VzdumpResponseBody contains the body from a backup response.
+type VzdumpResponseBody struct {
+	Data *string `json:"data,omitempty"`
+}
@@ -0,0 +1,163 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package ssh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/crypto/ssh"
+)
+
+// JumpHost tunnels network connections through an SSH server, e.g. to reach the Proxmox VE API from
+// a network that has access to the cluster only through a bastion host.
+type JumpHost struct {
+	client *client
+	node   ProxmoxNode
+
+	mu   sync.Mutex
+	conn *ssh.Client
+}
+
+// NewJumpHost creates a jump host connecting to the SSH server at the given address, `host`, `host:port` or
+// `user@host:port`, with the same credentials as the SSH client. The user of the address, if any, replaces
+// the username of the SSH client. The SSH connection is established on the first use.
+func NewJumpHost(
+	address string,
+	username string, password string,
+	agent bool, agentSocket string,
+	privateKey string,
+	socks5Server string, socks5Username string, socks5Password string,
+) (*JumpHost, error) {
+	user, node, err := parseJumpHostAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	if user != "" {
+		username = user
+	}
+
+	c, err := NewClient(
+		username, password, agent, agentSocket, privateKey,
+		socks5Server, socks5Username, socks5Password,
+		noNodeResolver{},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &JumpHost{client: c.(*client), node: node}, nil
+}
+
+// DialContext connects to the address through the jump host. The address is resolved by the jump host.
+func (j *JumpHost) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	sshClient, err := j.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := sshClient.DialContext(ctx, network, addr)
+	if err == nil {
+		return conn, nil
+	}
+
+	if ctx.Err() != nil {
+		return nil, fmt.Errorf("failed to dial %s through the SSH jump host: %w", addr, err)
+	}
+
+	// the SSH connection may have been closed by the server, e.g. after being idle, try again with a new one
+	tflog.Debug(ctx, "Failed to dial through the SSH jump host, reconnecting", map[string]interface{}{
+		"address": addr,
+		"error":   err,
+	})
+
+	j.reset(sshClient)
+
+	sshClient, err = j.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err = sshClient.DialContext(ctx, network, addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial %s through the SSH jump host: %w", addr, err)
+	}
+
+	return conn, nil
+}
+
+func (j *JumpHost) connect(ctx context.Context) (*ssh.Client, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.conn != nil {
+		return j.conn, nil
+	}
+
+	sshClient, err := j.client.openNodeShell(ctx, j.node)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the SSH jump host: %w", err)
+	}
+
+	j.conn = sshClient
+
+	return sshClient, nil
+}
+
+// reset closes the SSH connection, unless it was already replaced by another dial.
+func (j *JumpHost) reset(sshClient *ssh.Client) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.conn == sshClient {
+		_ = j.conn.Close()
+		j.conn = nil
+	}
+}
+
+// parseJumpHostAddress parses the address of the jump host, `host`, `host:port` or `user@host:port`, and returns
+// its user, empty if not set, and the node to connect to, on port 22 if not set.
+func parseJumpHostAddress(address string) (string, ProxmoxNode, error) {
+	invalid := fmt.Errorf("the SSH jump host %q must be `host`, `host:port` or `user@host:port`", address)
+
+	var user string
+
+	hostPort := address
+	if i := strings.LastIndex(address, "@"); i >= 0 {
+		user, hostPort = address[:i], address[i+1:]
+		if user == "" {
+			return "", ProxmoxNode{}, invalid
+		}
+	}
+
+	host, port, err := net.SplitHostPort(hostPort)
+	if err != nil {
+		// no port, e.g. `bastion` or `[2001:db8::1]`
+		host, port = strings.Trim(hostPort, "[]"), "22"
+	}
+
+	p, err := strconv.ParseUint(port, 10, 16)
+	if err != nil || p == 0 || host == "" {
+		return "", ProxmoxNode{}, invalid
+	}
+
+	return user, ProxmoxNode{Address: host, Port: int32(p)}, nil
+}
+
+// noNodeResolver is the resolver of the jump host client, which connects only to the jump host itself.
+type noNodeResolver struct{}
+
+func (noNodeResolver) Resolve(_ context.Context, nodeName string) (ProxmoxNode, error) {
+	return ProxmoxNode{}, errors.New("the SSH jump host does not resolve the node " + nodeName)
+}
@@ -0,0 +1,271 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package ssh
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestParseJumpHostAddress(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		address  string
+		wantUser string
+		wantNode ProxmoxNode
+		wantErr  bool
+	}{
+		{"bastion", "", ProxmoxNode{Address: "bastion", Port: 22}, false},
+		{"bastion:2222", "", ProxmoxNode{Address: "bastion", Port: 2222}, false},
+		{"jump@bastion:2222", "jump", ProxmoxNode{Address: "bastion", Port: 2222}, false},
+		{"jump@bastion", "jump", ProxmoxNode{Address: "bastion", Port: 22}, false},
+		{"jump@example.com@bastion:2222", "jump@example.com", ProxmoxNode{Address: "bastion", Port: 2222}, false},
+		{"[2001:db8::1]", "", ProxmoxNode{Address: "2001:db8::1", Port: 22}, false},
+		{"jump@[2001:db8::1]:2222", "jump", ProxmoxNode{Address: "2001:db8::1", Port: 2222}, false},
+		{"", "", ProxmoxNode{}, true},
+		{":22", "", ProxmoxNode{}, true},
+		{"bastion:0", "", ProxmoxNode{}, true},
+		{"bastion:ssh", "", ProxmoxNode{}, true},
+		{"bastion:65536", "", ProxmoxNode{}, true},
+		{"@bastion:22", "", ProxmoxNode{}, true},
+		{"jump@", "", ProxmoxNode{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			t.Parallel()
+
+			user, node, err := parseJumpHostAddress(tt.address)
+			if tt.wantErr {
+				require.ErrorContains(t, err, "must be `host`, `host:port` or `user@host:port`")
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantUser, user)
+			assert.Equal(t, tt.wantNode, node)
+		})
+	}
+}
+
+// jumpHostServer is an SSH server forwarding the `direct-tcpip` channels, like the SSH server of a bastion host.
+type jumpHostServer struct {
+	listener net.Listener
+
+	mu     sync.Mutex
+	logins []string
+	conns  []*ssh.ServerConn
+}
+
+// newJumpHostServer starts an SSH server accepting the given password for any user.
+func newJumpHostServer(t *testing.T, password string) *jumpHostServer {
+	t.Helper()
+
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	signer, err := ssh.NewSignerFromKey(key)
+	require.NoError(t, err)
+
+	s := &jumpHostServer{}
+
+	config := &ssh.ServerConfig{
+		PasswordCallback: func(meta ssh.ConnMetadata, p []byte) (*ssh.Permissions, error) {
+			if string(p) != password {
+				return nil, errors.New("wrong password")
+			}
+
+			s.mu.Lock()
+			s.logins = append(s.logins, meta.User())
+			s.mu.Unlock()
+
+			return &ssh.Permissions{}, nil
+		},
+	}
+	config.AddHostKey(signer)
+
+	s.listener, err = net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = s.listener.Close() })
+
+	go func() {
+		for {
+			conn, err := s.listener.Accept()
+			if err != nil {
+				return
+			}
+
+			go s.serve(conn, config)
+		}
+	}()
+
+	return s
+}
+
+func (s *jumpHostServer) serve(conn net.Conn, config *ssh.ServerConfig) {
+	serverConn, channels, requests, err := ssh.NewServerConn(conn, config)
+	if err != nil {
+		return
+	}
+
+	s.mu.Lock()
+	s.conns = append(s.conns, serverConn)
+	s.mu.Unlock()
+
+	go ssh.DiscardRequests(requests)
+
+	for newChannel := range channels {
+		if newChannel.ChannelType() != "direct-tcpip" {
+			_ = newChannel.Reject(ssh.UnknownChannelType, "unsupported channel type")
+			continue
+		}
+
+		var target struct {
+			Host       string
+			Port       uint32
+			OriginHost string
+			OriginPort uint32
+		}
+
+		if err := ssh.Unmarshal(newChannel.ExtraData(), &target); err != nil {
+			_ = newChannel.Reject(ssh.ConnectionFailed, err.Error())
+			continue
+		}
+
+		targetConn, err := net.Dial("tcp", net.JoinHostPort(target.Host, fmt.Sprint(target.Port)))
+		if err != nil {
+			_ = newChannel.Reject(ssh.ConnectionFailed, err.Error())
+			continue
+		}
+
+		channel, channelRequests, err := newChannel.Accept()
+		if err != nil {
+			_ = targetConn.Close()
+			continue
+		}
+
+		go ssh.DiscardRequests(channelRequests)
+
+		go func() {
+			_, _ = io.Copy(channel, targetConn)
+			_ = channel.Close()
+		}()
+
+		go func() {
+			_, _ = io.Copy(targetConn, channel)
+			_ = targetConn.Close()
+		}()
+	}
+}
+
+// closeConnections closes the SSH connections on the server side, e.g. like after being idle.
+func (s *jumpHostServer) closeConnections() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, c := range s.conns {
+		_ = c.Close()
+	}
+
+	s.conns = nil
+}
+
+func (s *jumpHostServer) loginUsers() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string{}, s.logins...)
+}
+
+// newEchoServer starts a TCP server echoing the data it receives.
+func newEchoServer(t *testing.T) string {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			go func() {
+				defer conn.Close()
+
+				_, _ = io.Copy(conn, conn)
+			}()
+		}
+	}()
+
+	return listener.Addr().String()
+}
+
+func TestJumpHostReconnect(t *testing.T) {
+	// the host key of the jump host is added to the known_hosts of the home directory
+	t.Setenv("HOME", t.TempDir())
+
+	server := newJumpHostServer(t, "secret")
+	echo := newEchoServer(t)
+
+	j, err := NewJumpHost("jump@"+server.listener.Addr().String(), "root", "secret", false, "", "", "", "", "")
+	require.NoError(t, err)
+
+	ctx := t.Context()
+
+	ping := func() {
+		t.Helper()
+
+		conn, err := j.DialContext(ctx, "tcp", echo)
+		require.NoError(t, err)
+
+		defer conn.Close()
+
+		_, err = conn.Write([]byte("ping"))
+		require.NoError(t, err)
+
+		buf := make([]byte, 4)
+		_, err = io.ReadFull(conn, buf)
+		require.NoError(t, err)
+		assert.Equal(t, "ping", string(buf))
+	}
+
+	// the user of the address replaces the one of the SSH credentials
+	ping()
+	assert.Equal(t, []string{"jump"}, server.loginUsers())
+
+	// the SSH connection is reused
+	ping()
+	assert.Len(t, server.loginUsers(), 1)
+
+	// and established again after the client is closed
+	j.mu.Lock()
+	require.NoError(t, j.conn.Close())
+	j.mu.Unlock()
+
+	ping()
+	assert.Len(t, server.loginUsers(), 2)
+
+	// or after the server closed it
+	server.closeConnections()
+
+	ping()
+	assert.Len(t, server.loginUsers(), 3)
+}
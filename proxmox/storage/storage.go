@@ -52,3 +52,33 @@ func (c *Client) GetDatastore(
 
 	return resBody.Data, nil
 }
+
+// CreateDatastore creates a datastore.
+func (c *Client) CreateDatastore(ctx context.Context, d *DatastoreCreateRequestBody) error {
+	err := c.DoRequest(ctx, http.MethodPost, "storage", d, nil)
+	if err != nil {
+		return fmt.Errorf("error creating datastore %s: %w", d.ID, err)
+	}
+
+	return nil
+}
+
+// UpdateDatastore updates a datastore.
+func (c *Client) UpdateDatastore(ctx context.Context, datastoreID string, d *DatastoreUpdateRequestBody) error {
+	err := c.DoRequest(ctx, http.MethodPut, fmt.Sprintf("storage/%s", url.PathEscape(datastoreID)), d, nil)
+	if err != nil {
+		return fmt.Errorf("error updating datastore %s: %w", datastoreID, err)
+	}
+
+	return nil
+}
+
+// DeleteDatastore deletes a datastore.
+func (c *Client) DeleteDatastore(ctx context.Context, datastoreID string) error {
+	err := c.DoRequest(ctx, http.MethodDelete, fmt.Sprintf("storage/%s", url.PathEscape(datastoreID)), nil, nil)
+	if err != nil {
+		return fmt.Errorf("error deleting datastore %s: %w", datastoreID, err)
+	}
+
+	return nil
+}
@@ -19,8 +19,63 @@ type DatastoreGetResponseBody struct {
 type DatastoreGetResponseData struct {
 	Content types.CustomCommaSeparatedList `json:"content,omitempty" url:"content,omitempty,comma"`
 	Digest  *string                        `json:"digest,omitempty"`
-	Path    *string                        `json:"path,omitempty"`
-	Shared  *types.CustomBool              `json:"shared,omitempty"`
 	Storage *string                        `json:"storage,omitempty"`
 	Type    *string                        `json:"type,omitempty"`
+
+	DatastoreFixedFields
+	DatastoreMutableFields
+}
+
+// DatastoreFixedFields contains the datastore fields that can only be set when the datastore is created.
+type DatastoreFixedFields struct {
+	Datastore   *string `json:"datastore,omitempty" url:"datastore,omitempty"`
+	Export      *string `json:"export,omitempty"    url:"export,omitempty"`
+	Path        *string `json:"path,omitempty"      url:"path,omitempty"`
+	Portal      *string `json:"portal,omitempty"    url:"portal,omitempty"`
+	Server      *string `json:"server,omitempty"    url:"server,omitempty"`
+	Share       *string `json:"share,omitempty"     url:"share,omitempty"`
+	Target      *string `json:"target,omitempty"    url:"target,omitempty"`
+	ThinPool    *string `json:"thinpool,omitempty"  url:"thinpool,omitempty"`
+	VolumeGroup *string `json:"vgname,omitempty"    url:"vgname,omitempty"`
+	ZFSPool     *string `json:"pool,omitempty"      url:"pool,omitempty"`
+}
+
+// DatastoreMutableFields contains the datastore fields that can be changed after the datastore is created.
+type DatastoreMutableFields struct {
+	BlockSize     *string                   `json:"blocksize,omitempty"      url:"blocksize,omitempty"`
+	Disable       *types.CustomBool         `json:"disable,omitempty"        url:"disable,omitempty,int"`
+	Domain        *string                   `json:"domain,omitempty"         url:"domain,omitempty"`
+	EncryptionKey *string                   `json:"encryption-key,omitempty" url:"encryption-key,omitempty"`
+	Fingerprint   *string                   `json:"fingerprint,omitempty"    url:"fingerprint,omitempty"`
+	Namespace     *string                   `json:"namespace,omitempty"      url:"namespace,omitempty"`
+	Nodes         *string                   `json:"nodes,omitempty"          url:"nodes,omitempty"`
+	Options       *string                   `json:"options,omitempty"        url:"options,omitempty"`
+	Password      *string                   `json:"password,omitempty"       url:"password,omitempty"`
+	Port          *int64                    `json:"port,omitempty"           url:"port,omitempty"`
+	Preallocation *string                   `json:"preallocation,omitempty"  url:"preallocation,omitempty"`
+	PruneBackups  *types.CustomPruneBackups `json:"prune-backups,omitempty"  url:"prune-backups,omitempty"`
+	SafeRemove    *types.CustomBool         `json:"saferemove,omitempty"     url:"saferemove,omitempty,int"`
+	Shared        *types.CustomBool         `json:"shared,omitempty"         url:"shared,omitempty,int"`
+	SMBVersion    *string                   `json:"smbversion,omitempty"     url:"smbversion,omitempty"`
+	Sparse        *types.CustomBool         `json:"sparse,omitempty"         url:"sparse,omitempty,int"`
+	Subdir        *string                   `json:"subdir,omitempty"         url:"subdir,omitempty"`
+	Username      *string                   `json:"username,omitempty"       url:"username,omitempty"`
+}
+
+// DatastoreCreateRequestBody contains the body for a datastore create request.
+type DatastoreCreateRequestBody struct {
+	ID      string  `url:"storage"`
+	Type    string  `url:"type"`
+	Content *string `url:"content,omitempty"`
+
+	DatastoreFixedFields
+	DatastoreMutableFields
+}
+
+// DatastoreUpdateRequestBody contains the body for a datastore update request.
+type DatastoreUpdateRequestBody struct {
+	Content *string  `url:"content,omitempty"`
+	Delete  []string `url:"delete,omitempty,comma"`
+
+	DatastoreMutableFields
 }
@@ -0,0 +1,69 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package storage
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-querystring/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/helpers/ptr"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+func TestDatastoreCreateRequestBodyEncode(t *testing.T) {
+	t.Parallel()
+
+	body := &DatastoreCreateRequestBody{
+		ID:      "backups",
+		Type:    "dir",
+		Content: ptr.Ptr("backup,iso"),
+		DatastoreFixedFields: DatastoreFixedFields{
+			Path: ptr.Ptr("/mnt/backups"),
+		},
+		DatastoreMutableFields: DatastoreMutableFields{
+			Shared: types.CustomBool(true).Pointer(),
+			PruneBackups: &types.CustomPruneBackups{
+				KeepLast: ptr.Ptr(int64(3)),
+			},
+		},
+	}
+
+	v, err := query.Values(body)
+	require.NoError(t, err)
+	require.Equal(t,
+		"content=backup%2Ciso&path=%2Fmnt%2Fbackups&prune-backups=keep-last%3D3&shared=1&storage=backups&type=dir",
+		v.Encode(),
+	)
+}
+
+func TestDatastoreGetResponseDataUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	data := &DatastoreGetResponseData{}
+	err := json.Unmarshal([]byte(`{
+		"storage": "pbs",
+		"type": "pbs",
+		"content": "backup",
+		"server": "pbs.example.com",
+		"datastore": "backups",
+		"port": 8007,
+		"nodes": "pve1,pve2",
+		"prune-backups": "keep-daily=7"
+	}`), data)
+	require.NoError(t, err)
+
+	require.Equal(t, "pbs", *data.Storage)
+	require.Equal(t, types.CustomCommaSeparatedList{"backup"}, data.Content)
+	require.Equal(t, "pbs.example.com", *data.Server)
+	require.Equal(t, "backups", *data.Datastore)
+	require.Equal(t, int64(8007), *data.Port)
+	require.Equal(t, "pve1,pve2", *data.Nodes)
+	require.Equal(t, int64(7), *data.PruneBackups.KeepDaily)
+}
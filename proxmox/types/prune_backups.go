@@ -0,0 +1,132 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// CustomPruneBackups handles the `prune-backups` retention options shared by backup jobs and storages.
+type CustomPruneBackups struct {
+	KeepAll     *CustomBool `json:"keep-all,omitempty"`
+	KeepLast    *int64      `json:"keep-last,omitempty"`
+	KeepHourly  *int64      `json:"keep-hourly,omitempty"`
+	KeepDaily   *int64      `json:"keep-daily,omitempty"`
+	KeepWeekly  *int64      `json:"keep-weekly,omitempty"`
+	KeepMonthly *int64      `json:"keep-monthly,omitempty"`
+	KeepYearly  *int64      `json:"keep-yearly,omitempty"`
+}
+
+// String returns the property string representation of the retention options.
+func (r *CustomPruneBackups) String() string {
+	var values []string
+
+	if r.KeepAll != nil {
+		values = append(values, fmt.Sprintf("keep-all=%d", boolToInt(bool(*r.KeepAll))))
+	}
+
+	for _, kv := range []struct {
+		key   string
+		value *int64
+	}{
+		{"keep-last", r.KeepLast},
+		{"keep-hourly", r.KeepHourly},
+		{"keep-daily", r.KeepDaily},
+		{"keep-weekly", r.KeepWeekly},
+		{"keep-monthly", r.KeepMonthly},
+		{"keep-yearly", r.KeepYearly},
+	} {
+		if kv.value != nil {
+			values = append(values, fmt.Sprintf("%s=%d", kv.key, *kv.value))
+		}
+	}
+
+	return strings.Join(values, ",")
+}
+
+// EncodeValues converts a CustomPruneBackups struct to a URL value.
+func (r *CustomPruneBackups) EncodeValues(key string, v *url.Values) error {
+	if s := r.String(); s != "" {
+		v.Add(key, s)
+	}
+
+	return nil
+}
+
+// UnmarshalJSON converts the retention options to an object.
+// PVE returns them either as a property string or as a JSON object, depending on the endpoint.
+func (r *CustomPruneBackups) UnmarshalJSON(b []byte) error {
+	var s string
+
+	if err := json.Unmarshal(b, &s); err == nil {
+		return r.parse(s)
+	}
+
+	var m map[string]json.RawMessage
+
+	if err := json.Unmarshal(b, &m); err != nil {
+		return fmt.Errorf("failed to unmarshal CustomPruneBackups: %w", err)
+	}
+
+	pairs := make([]string, 0, len(m))
+
+	for k, v := range m {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", k, strings.Trim(string(v), `"`)))
+	}
+
+	return r.parse(strings.Join(pairs, ","))
+}
+
+func (r *CustomPruneBackups) parse(s string) error {
+	for _, p := range strings.Split(s, ",") {
+		k, v, found := strings.Cut(strings.TrimSpace(p), "=")
+		if !found {
+			continue
+		}
+
+		if k == "keep-all" {
+			keepAll := CustomBool(v == "1" || v == "true")
+			r.KeepAll = &keepAll
+
+			continue
+		}
+
+		i, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return fmt.Errorf("failed to parse %s: %w", k, err)
+		}
+
+		switch k {
+		case "keep-last":
+			r.KeepLast = &i
+		case "keep-hourly":
+			r.KeepHourly = &i
+		case "keep-daily":
+			r.KeepDaily = &i
+		case "keep-weekly":
+			r.KeepWeekly = &i
+		case "keep-monthly":
+			r.KeepMonthly = &i
+		case "keep-yearly":
+			r.KeepYearly = &i
+		}
+	}
+
+	return nil
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,91 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package types
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/helpers/ptr"
+)
+
+func TestCustomPruneBackupsUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		line    string
+		want    *CustomPruneBackups
+		wantErr bool
+	}{
+		{
+			name: "property string",
+			line: `"keep-last=3,keep-daily=7,keep-monthly=2"`,
+			want: &CustomPruneBackups{
+				KeepLast:    ptr.Ptr(int64(3)),
+				KeepDaily:   ptr.Ptr(int64(7)),
+				KeepMonthly: ptr.Ptr(int64(2)),
+			},
+		},
+		{
+			name: "object",
+			line: `{"keep-all":"1"}`,
+			want: &CustomPruneBackups{
+				KeepAll: CustomBool(true).Pointer(),
+			},
+		},
+		{
+			name: "object with numbers",
+			line: `{"keep-weekly":4,"keep-yearly":"1"}`,
+			want: &CustomPruneBackups{
+				KeepWeekly: ptr.Ptr(int64(4)),
+				KeepYearly: ptr.Ptr(int64(1)),
+			},
+		},
+		{
+			name:    "invalid number",
+			line:    `"keep-last=many"`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := &CustomPruneBackups{}
+			err := r.UnmarshalJSON([]byte(tt.line))
+
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, r)
+		})
+	}
+}
+
+func TestCustomPruneBackupsEncodeValues(t *testing.T) {
+	t.Parallel()
+
+	r := &CustomPruneBackups{
+		KeepAll:   CustomBool(false).Pointer(),
+		KeepLast:  ptr.Ptr(int64(3)),
+		KeepDaily: ptr.Ptr(int64(7)),
+	}
+
+	v := url.Values{}
+	require.NoError(t, r.EncodeValues("prune-backups", &v))
+	require.Equal(t, "keep-all=0,keep-last=3,keep-daily=7", v.Get("prune-backups"))
+
+	v = url.Values{}
+	require.NoError(t, (&CustomPruneBackups{}).EncodeValues("prune-backups", &v))
+	require.False(t, v.Has("prune-backups"))
+}
@@ -11,6 +11,8 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/hashicorp/go-version"
+
 	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
 )
 
@@ -29,3 +31,24 @@ func (c *Client) Version(ctx context.Context) (*ResponseData, error) {
 
 	return resBody.Data, nil
 }
+
+// ProxmoxVersion retrieves the parsed version of Proxmox VE.
+func (c *Client) ProxmoxVersion(ctx context.Context) (*ProxmoxVersion, error) {
+	data, err := c.Version(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseProxmoxVersion(data.Version)
+}
+
+// parseProxmoxVersion parses a Proxmox VE version, e.g. `8.2.4`. The package release of older versions,
+// e.g. the `-3` of `7.4-3`, is ignored.
+func parseProxmoxVersion(s string) (*ProxmoxVersion, error) {
+	v, err := version.NewVersion(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Proxmox VE version %q: %w", s, err)
+	}
+
+	return &ProxmoxVersion{Version: *v.Core()}, nil
+}
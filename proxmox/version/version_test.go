@@ -0,0 +1,40 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseProxmoxVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		version           string
+		importFrom        bool
+		importContentType bool
+	}{
+		{"7.1-10", false, false},
+		{"7.2-3", true, false},
+		{"8.3.5", true, false},
+		{"8.4.1", true, true},
+		{"9.0.3", true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			t.Parallel()
+
+			pv, err := parseProxmoxVersion(tt.version)
+			require.NoError(t, err)
+			require.Equal(t, tt.importFrom, pv.SupportImportFrom())
+			require.Equal(t, tt.importContentType, pv.SupportImportContentType())
+		})
+	}
+}
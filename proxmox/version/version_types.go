@@ -6,6 +6,18 @@
 
 package version
 
+import (
+	"github.com/hashicorp/go-version"
+)
+
+var (
+	// MinimumProxmoxVersionImportFrom is the first Proxmox VE version supporting the `import-from` disk option.
+	MinimumProxmoxVersionImportFrom = version.Must(version.NewVersion("7.2"))
+	// MinimumProxmoxVersionImportContentType is the first Proxmox VE version supporting downloads of disk
+	// images to the `import` content type.
+	MinimumProxmoxVersionImportContentType = version.Must(version.NewVersion("8.4"))
+)
+
 // ResponseBody contains the body from a version response.
 type ResponseBody struct {
 	Data *ResponseData `json:"data,omitempty"`
@@ -18,3 +30,20 @@ type ResponseData struct {
 	RepositoryID string `json:"repoid"`
 	Version      string `json:"version"`
 }
+
+// ProxmoxVersion is a parsed Proxmox VE version.
+type ProxmoxVersion struct {
+	version.Version
+}
+
+// SupportImportFrom returns true if the `import-from` disk option can be used to import disk images
+// from volumes of the `images` and `import` content types.
+func (v *ProxmoxVersion) SupportImportFrom() bool {
+	return v.GreaterThanOrEqual(MinimumProxmoxVersionImportFrom)
+}
+
+// SupportImportContentType returns true if disk images can be downloaded to datastores with the
+// `import` content type.
+func (v *ProxmoxVersion) SupportImportContentType() bool {
+	return v.GreaterThanOrEqual(MinimumProxmoxVersionImportContentType)
+}
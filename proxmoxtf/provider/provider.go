@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"net"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -51,6 +52,7 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 
 	// Check environment variables
 	endpoint := utils.GetAnyStringEnv("PROXMOX_VE_ENDPOINT", "PM_VE_ENDPOINT")
+	endpoints := utils.GetAnyListEnv("PROXMOX_VE_ENDPOINTS")
 	insecure := utils.GetAnyBoolEnv("PROXMOX_VE_INSECURE", "PM_VE_INSECURE")
 	minTLS := utils.GetAnyStringEnv("PROXMOX_VE_MIN_TLS", "PM_VE_MIN_TLS")
 	authTicket := utils.GetAnyStringEnv("PROXMOX_VE_AUTH_TICKET", "PM_VE_AUTH_TICKET")
@@ -59,11 +61,34 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 	otp := utils.GetAnyStringEnv("PROXMOX_VE_OTP", "PM_VE_OTP")
 	username := utils.GetAnyStringEnv("PROXMOX_VE_USERNAME", "PM_VE_USERNAME")
 	password := utils.GetAnyStringEnv("PROXMOX_VE_PASSWORD", "PM_VE_PASSWORD")
+	totpSecret := utils.GetAnyStringEnv("PROXMOX_VE_TOTP_SECRET")
+	caCertificate := utils.GetAnyStringEnv("PROXMOX_VE_CA_CERTIFICATE")
+	sslFingerprint := utils.GetAnyStringEnv("PROXMOX_VE_SSL_FINGERPRINT")
+	clientCertificate := utils.GetAnyStringEnv("PROXMOX_VE_CLIENT_CERTIFICATE")
+	clientKey := utils.GetAnyStringEnv("PROXMOX_VE_CLIENT_KEY")
+	apiProxy := utils.GetAnyStringEnv("PROXMOX_VE_API_PROXY")
+	apiSSHJumpHost := utils.GetAnyStringEnv("PROXMOX_VE_API_SSH_JUMP_HOST")
+	retryMaxAttempts := utils.GetAnyIntEnv("PROXMOX_VE_RETRY_MAX_ATTEMPTS")
+	retryBackoff := utils.GetAnyStringEnv("PROXMOX_VE_RETRY_BACKOFF")
+	rateLimit := utils.GetAnyIntEnv("PROXMOX_VE_RATE_LIMIT")
+	nodeTaskConcurrency := utils.GetAnyIntEnv("PROXMOX_VE_NODE_TASK_CONCURRENCY")
 
 	if v, ok := d.GetOk(mkProviderEndpoint); ok {
 		endpoint = v.(string)
 	}
 
+	if v, ok := d.GetOk(mkProviderEndpoints); ok {
+		endpoints = []string{}
+
+		for _, e := range v.([]interface{}) {
+			endpoints = append(endpoints, e.(string))
+		}
+	}
+
+	if endpoint == "" && len(endpoints) > 0 {
+		endpoint, endpoints = endpoints[0], endpoints[1:]
+	}
+
 	if v, ok := d.GetOk(mkProviderInsecure); ok {
 		insecure = v.(bool)
 	}
@@ -99,19 +124,77 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		password = v.(string)
 	}
 
-	creds, err = api.NewCredentials(username, password, otp, apiToken, authTicket, csrfPreventionToken)
-	diags = append(diags, diag.FromErr(err)...)
+	if v, ok := d.GetOk(mkProviderTOTPSecret); ok {
+		totpSecret = v.(string)
+	}
 
-	conn, err = api.NewConnection(endpoint, insecure, minTLS)
-	diags = append(diags, diag.FromErr(err)...)
+	if v, ok := d.GetOk(mkProviderCACertificate); ok {
+		caCertificate = v.(string)
+	}
 
-	if diags.HasError() {
-		return nil, diags
+	if v, ok := d.GetOk(mkProviderSSLFingerprint); ok {
+		sslFingerprint = v.(string)
 	}
 
-	apiClient, err = api.NewClient(creds, conn)
-	if err != nil {
-		return nil, diag.Errorf("error creating virtual environment client: %s", err)
+	if v, ok := d.GetOk(mkProviderClientCertificate); ok {
+		clientCertificate = v.(string)
+	}
+
+	if v, ok := d.GetOk(mkProviderClientKey); ok {
+		clientKey = v.(string)
+	}
+
+	if v, ok := d.GetOk(mkProviderAPIProxy); ok {
+		apiProxy = v.(string)
+	}
+
+	if v, ok := d.GetOk(mkProviderAPISSHJumpHost); ok {
+		apiSSHJumpHost = v.(string)
+	}
+
+	if v, ok := d.GetOk(mkProviderRetryMaxAttempts); ok {
+		retryMaxAttempts = v.(int)
+	}
+
+	if v, ok := d.GetOk(mkProviderRetryBackoff); ok {
+		retryBackoff = v.(string)
+	}
+
+	if v, ok := d.GetOk(mkProviderRateLimit); ok {
+		rateLimit = v.(int)
+	}
+
+	if v, ok := d.GetOk(mkProviderNodeTaskConcurrency); ok {
+		nodeTaskConcurrency = v.(int)
+	}
+
+	retryPolicy := api.DefaultRetryPolicy()
+
+	if retryMaxAttempts > 0 {
+		retryPolicy.MaxAttempts = retryMaxAttempts
+	}
+
+	if retryBackoff != "" {
+		retryPolicy.Backoff, err = time.ParseDuration(retryBackoff)
+		if err != nil {
+			diags = append(diags, diag.Errorf("invalid retry backoff %q: %s", retryBackoff, err)...)
+		}
+	}
+
+	// an empty list disables the retries, unlike an omitted one, so the raw configuration is checked
+	if raw := d.GetRawConfig(); !raw.IsNull() && !raw.GetAttr(mkProviderRetryStatusCodes).IsNull() {
+		retryPolicy.StatusCodes = []int{}
+
+		for _, c := range d.Get(mkProviderRetryStatusCodes).([]interface{}) {
+			retryPolicy.StatusCodes = append(retryPolicy.StatusCodes, c.(int))
+		}
+	}
+
+	creds, err = api.NewCredentials(username, password, otp, apiToken, authTicket, csrfPreventionToken)
+	diags = append(diags, diag.FromErr(err)...)
+
+	if creds.UserCredentials != nil {
+		creds.UserCredentials.TOTPSecret = totpSecret
 	}
 
 	// ////////////////////////////////////////////////////////////////////////////////////
@@ -191,6 +274,54 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		}
 	}
 
+	var apiDialer api.DialContextFunc
+
+	if apiSSHJumpHost != "" {
+		var jumpHost *ssh.JumpHost
+
+		jumpHost, err = ssh.NewJumpHost(
+			apiSSHJumpHost,
+			sshConf[mkProviderSSHUsername].(string),
+			sshConf[mkProviderSSHPassword].(string),
+			sshConf[mkProviderSSHAgent].(bool),
+			sshConf[mkProviderSSHAgentSocket].(string),
+			sshConf[mkProviderSSHPrivateKey].(string),
+			sshConf[mkProviderSSHSocks5Server].(string),
+			sshConf[mkProviderSSHSocks5Username].(string),
+			sshConf[mkProviderSSHSocks5Password].(string),
+		)
+		if err != nil {
+			diags = append(diags, diag.Errorf("error creating API SSH jump host: %s", err)...)
+		} else {
+			apiDialer = jumpHost.DialContext
+		}
+	}
+
+	conn, err = api.NewConnection(
+		endpoint,
+		insecure,
+		minTLS,
+		api.WithRetryPolicy(retryPolicy),
+		api.WithRateLimit(float64(rateLimit)),
+		api.WithNodeTaskConcurrency(nodeTaskConcurrency),
+		api.WithCACertificates(caCertificate),
+		api.WithFingerprint(sslFingerprint),
+		api.WithClientCertificate(clientCertificate, clientKey),
+		api.WithEndpoints(endpoints...),
+		api.WithProxy(apiProxy),
+		api.WithDialer(apiDialer),
+	)
+	diags = append(diags, diag.FromErr(err)...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	apiClient, err = api.NewClient(creds, conn)
+	if err != nil {
+		return nil, diag.Errorf("error creating virtual environment client: %s", err)
+	}
+
 	sshClient, err = ssh.NewClient(
 		sshConf[mkProviderSSHUsername].(string),
 		sshConf[mkProviderSSHPassword].(string),
@@ -7,10 +7,21 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
 	"testing"
 
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/bpg/terraform-provider-proxmox/proxmoxtf"
 	"github.com/bpg/terraform-provider-proxmox/proxmoxtf/test"
 )
 
@@ -57,3 +68,109 @@ func TestProviderSchema(t *testing.T) {
 	// do not limit number of nodes in the cluster
 	test.AssertListMaxItems(t, providerSSHSchema, mkProviderSSHNode, 0)
 }
+
+// TestProviderRetryStatusCodes tests that an empty `retry_status_codes` list disables the retries, while an omitted
+// one keeps the default status codes.
+func TestProviderRetryStatusCodes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		statusCodes []int
+		wantCalls   int32
+	}{
+		{"default", nil, 3},
+		{"empty", []int{}, 1},
+		{"other", []int{502}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls atomic.Int32
+
+			s := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				calls.Add(1)
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}))
+			t.Cleanup(s.Close)
+
+			config := map[string]any{
+				mkProviderEndpoint:         s.URL,
+				mkProviderInsecure:         true,
+				mkProviderAPIToken:         "root@pam!test=00000000-0000-0000-0000-000000000000",
+				mkProviderRetryMaxAttempts: 3,
+				mkProviderRetryBackoff:     "1ms",
+			}
+
+			if tt.statusCodes != nil {
+				config[mkProviderRetryStatusCodes] = tt.statusCodes
+			}
+
+			p := ProxmoxVirtualEnvironment()
+			block := schema.InternalMap(p.Schema).CoreConfigSchema()
+
+			raw, err := json.Marshal(config)
+			require.NoError(t, err)
+
+			val, err := ctyjson.Unmarshal(raw, block.ImpliedType())
+			require.NoError(t, err)
+
+			// like the gRPC server, keep the raw configuration for GetRawConfig
+			resourceConfig := terraform.NewResourceConfigShimmed(val, block)
+			resourceConfig.CtyValue = val
+
+			diags := p.Configure(context.Background(), resourceConfig)
+			require.False(t, diags.HasError(), "%v", diags)
+
+			providerConfig := p.Meta().(proxmoxtf.ProviderConfiguration)
+
+			client, err := providerConfig.GetClient()
+			require.NoError(t, err)
+
+			err = client.API().DoRequest(context.Background(), http.MethodGet, "version", nil, nil)
+			require.Error(t, err)
+			assert.Equal(t, tt.wantCalls, calls.Load())
+		})
+	}
+}
+
+// TestProviderTLSConflicts tests that the `ssl_fingerprint` cannot be used with the `ca_certificate`, or with the
+// `endpoints` to fail over to.
+func TestProviderTLSConflicts(t *testing.T) {
+	t.Parallel()
+
+	caCertificate := "-----BEGIN CERTIFICATE-----"
+	fingerprint := strings.Repeat("AB:", 31) + "AB"
+	endpoints := []any{"https://pve2.example.com:8006/"}
+
+	tests := []struct {
+		name    string
+		config  map[string]any
+		wantErr bool
+	}{
+		{"ca certificate", map[string]any{mkProviderCACertificate: caCertificate}, false},
+		{"fingerprint", map[string]any{mkProviderSSLFingerprint: fingerprint}, false},
+		{"both", map[string]any{mkProviderCACertificate: caCertificate, mkProviderSSLFingerprint: fingerprint}, true},
+		{"endpoints", map[string]any{mkProviderEndpoints: endpoints}, false},
+		{"fingerprint and endpoints", map[string]any{
+			mkProviderEndpoints:      endpoints,
+			mkProviderSSLFingerprint: fingerprint,
+		}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := ProxmoxVirtualEnvironment().Validate(terraform.NewResourceConfigRaw(tt.config))
+			if tt.wantErr {
+				require.True(t, diags.HasError())
+				assert.Equal(t, "Conflicting configuration arguments", diags[0].Summary)
+			} else {
+				assert.False(t, diags.HasError(), "%v", diags)
+			}
+		})
+	}
+}
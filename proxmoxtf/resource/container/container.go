@@ -16,11 +16,13 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/bpg/terraform-provider-proxmox/proxmox"
 	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
 	"github.com/bpg/terraform-provider-proxmox/proxmox/helpers/ptr"
 	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/containers"
@@ -59,6 +61,7 @@ const (
 	dvHookScript                        = ""
 	dvMemoryDedicated                   = 512
 	dvMemorySwap                        = 0
+	dvMigrate                           = false
 	dvMountPointACL                     = false
 	dvMountPointBackup                  = false
 	dvMountPointPath                    = ""
@@ -77,6 +80,8 @@ const (
 	dvOperatingSystemType               = "unmanaged"
 	dvPoolID                            = ""
 	dvProtection                        = false
+	dvRestoreForce                      = false
+	dvRestoreUnique                     = false
 	dvStarted                           = true
 	dvStartupOrder                      = -1
 	dvStartupUpDelay                    = -1
@@ -93,93 +98,100 @@ const (
 	maxPassthroughDevices = 128
 	maxMountPoints        = 256
 
-	mkClone                             = "clone"
-	mkCloneDatastoreID                  = "datastore_id"
-	mkCloneNodeName                     = "node_name"
-	mkCloneVMID                         = "vm_id"
-	mkConsole                           = "console"
-	mkConsoleEnabled                    = "enabled"
-	mkConsoleMode                       = "type"
-	mkConsoleTTYCount                   = "tty_count"
-	mkCPU                               = "cpu"
-	mkCPUArchitecture                   = "architecture"
-	mkCPUCores                          = "cores"
-	mkCPUUnits                          = "units"
-	mkDescription                       = "description"
-	mkDisk                              = "disk"
-	mkDiskDatastoreID                   = "datastore_id"
-	mkDiskSize                          = "size"
-	mkFeatures                          = "features"
-	mkFeaturesNesting                   = "nesting"
-	mkFeaturesKeyControl                = "keyctl"
-	mkFeaturesFUSE                      = "fuse"
-	mkFeaturesMountTypes                = "mount"
-	mkHookScriptFileID                  = "hook_script_file_id"
-	mkInitialization                    = "initialization"
-	mkInitializationDNS                 = "dns"
-	mkInitializationDNSDomain           = "domain"
-	mkInitializationDNSServer           = "server"
-	mkInitializationDNSServers          = "servers"
-	mkInitializationHostname            = "hostname"
-	mkInitializationIPConfig            = "ip_config"
-	mkInitializationIPConfigIPv4        = "ipv4"
-	mkInitializationIPConfigIPv4Address = "address"
-	mkInitializationIPConfigIPv4Gateway = "gateway"
-	mkInitializationIPConfigIPv6        = "ipv6"
-	mkInitializationIPConfigIPv6Address = "address"
-	mkInitializationIPConfigIPv6Gateway = "gateway"
-	mkInitializationUserAccount         = "user_account"
-	mkInitializationUserAccountKeys     = "keys"
-	mkInitializationUserAccountPassword = "password"
-	mkMemory                            = "memory"
-	mkMemoryDedicated                   = "dedicated"
-	mkMemorySwap                        = "swap"
-	mkMountPoint                        = "mount_point"
-	mkMountPointACL                     = "acl"
-	mkMountPointBackup                  = "backup"
-	mkMountPointMountOptions            = "mount_options"
-	mkMountPointPath                    = "path"
-	mkMountPointQuota                   = "quota"
-	mkMountPointReadOnly                = "read_only"
-	mkMountPointReplicate               = "replicate"
-	mkMountPointShared                  = "shared"
-	mkMountPointSize                    = "size"
-	mkMountPointVolume                  = "volume"
-	mkDevicePassthroughDenyWrite        = "deny_write"
-	mkDevicePassthrough                 = "device_passthrough" // #nosec G101
-	mkDevicePassthroughPath             = "path"
-	mkDevicePassthroughUID              = "uid"
-	mkDevicePassthroughGID              = "gid"
-	mkDevicePassthroughMode             = "mode"
-	mkNetworkInterface                  = "network_interface"
-	mkNetworkInterfaceBridge            = "bridge"
-	mkNetworkInterfaceEnabled           = "enabled"
-	mkNetworkInterfaceFirewall          = "firewall"
-	mkNetworkInterfaceMACAddress        = "mac_address"
-	mkNetworkInterfaceName              = "name"
-	mkNetworkInterfaceRateLimit         = "rate_limit"
-	mkNetworkInterfaceVLANID            = "vlan_id"
-	mkNetworkInterfaceMTU               = "mtu"
-	mkNodeName                          = "node_name"
-	mkOperatingSystem                   = "operating_system"
-	mkOperatingSystemTemplateFileID     = "template_file_id"
-	mkOperatingSystemType               = "type"
-	mkPoolID                            = "pool_id"
-	mkProtection                        = "protection"
-	mkStarted                           = "started"
-	mkStartup                           = "startup"
-	mkStartupOrder                      = "order"
-	mkStartupUpDelay                    = "up_delay"
-	mkStartupDownDelay                  = "down_delay"
-	mkStartOnBoot                       = "start_on_boot"
-	mkTags                              = "tags"
-	mkTemplate                          = "template"
-	mkTimeoutCreate                     = "timeout_create"
-	mkTimeoutClone                      = "timeout_clone"
-	mkTimeoutUpdate                     = "timeout_update"
-	mkTimeoutDelete                     = "timeout_delete"
-	mkUnprivileged                      = "unprivileged"
-	mkVMID                              = "vm_id"
+	mkClone                                      = "clone"
+	mkCloneDatastoreID                           = "datastore_id"
+	mkCloneNodeName                              = "node_name"
+	mkCloneVMID                                  = "vm_id"
+	mkConsole                                    = "console"
+	mkConsoleEnabled                             = "enabled"
+	mkConsoleMode                                = "type"
+	mkConsoleTTYCount                            = "tty_count"
+	mkCPU                                        = "cpu"
+	mkCPUArchitecture                            = "architecture"
+	mkCPUCores                                   = "cores"
+	mkCPUUnits                                   = "units"
+	mkDescription                                = "description"
+	mkDisk                                       = "disk"
+	mkDiskDatastoreID                            = "datastore_id"
+	mkDiskSize                                   = "size"
+	mkFeatures                                   = "features"
+	mkFeaturesNesting                            = "nesting"
+	mkFeaturesKeyControl                         = "keyctl"
+	mkFeaturesFUSE                               = "fuse"
+	mkFeaturesMountTypes                         = "mount"
+	mkHookScriptFileID                           = "hook_script_file_id"
+	mkInitialization                             = "initialization"
+	mkInitializationDNS                          = "dns"
+	mkInitializationDNSDomain                    = "domain"
+	mkInitializationDNSServer                    = "server"
+	mkInitializationDNSServers                   = "servers"
+	mkInitializationHostname                     = "hostname"
+	mkInitializationIPConfig                     = "ip_config"
+	mkInitializationIPConfigIPv4                 = "ipv4"
+	mkInitializationIPConfigIPv4Address          = "address"
+	mkInitializationIPConfigIPv4Gateway          = "gateway"
+	mkInitializationIPConfigIPv6                 = "ipv6"
+	mkInitializationIPConfigIPv6Address          = "address"
+	mkInitializationIPConfigIPv6Gateway          = "gateway"
+	mkInitializationUserAccount                  = "user_account"
+	mkInitializationUserAccountKeys              = "keys"
+	mkInitializationUserAccountPassword          = "password"
+	mkInitializationUserAccountPasswordWO        = "password_wo"
+	mkInitializationUserAccountPasswordWOVersion = "password_wo_version"
+	mkMemory                                     = "memory"
+	mkMemoryDedicated                            = "dedicated"
+	mkMemorySwap                                 = "swap"
+	mkMigrate                                    = "migrate"
+	mkMountPoint                                 = "mount_point"
+	mkMountPointACL                              = "acl"
+	mkMountPointBackup                           = "backup"
+	mkMountPointMountOptions                     = "mount_options"
+	mkMountPointPath                             = "path"
+	mkMountPointQuota                            = "quota"
+	mkMountPointReadOnly                         = "read_only"
+	mkMountPointReplicate                        = "replicate"
+	mkMountPointShared                           = "shared"
+	mkMountPointSize                             = "size"
+	mkMountPointVolume                           = "volume"
+	mkDevicePassthroughDenyWrite                 = "deny_write"
+	mkDevicePassthrough                          = "device_passthrough" // #nosec G101
+	mkDevicePassthroughPath                      = "path"
+	mkDevicePassthroughUID                       = "uid"
+	mkDevicePassthroughGID                       = "gid"
+	mkDevicePassthroughMode                      = "mode"
+	mkNetworkInterface                           = "network_interface"
+	mkNetworkInterfaceBridge                     = "bridge"
+	mkNetworkInterfaceEnabled                    = "enabled"
+	mkNetworkInterfaceFirewall                   = "firewall"
+	mkNetworkInterfaceMACAddress                 = "mac_address"
+	mkNetworkInterfaceName                       = "name"
+	mkNetworkInterfaceRateLimit                  = "rate_limit"
+	mkNetworkInterfaceVLANID                     = "vlan_id"
+	mkNetworkInterfaceMTU                        = "mtu"
+	mkNodeName                                   = "node_name"
+	mkOperatingSystem                            = "operating_system"
+	mkOperatingSystemTemplateFileID              = "template_file_id"
+	mkOperatingSystemType                        = "type"
+	mkPoolID                                     = "pool_id"
+	mkProtection                                 = "protection"
+	mkRestore                                    = "restore"
+	mkRestoreArchive                             = "archive"
+	mkRestoreForce                               = "force"
+	mkRestoreUnique                              = "unique"
+	mkStarted                                    = "started"
+	mkStartup                                    = "startup"
+	mkStartupOrder                               = "order"
+	mkStartupUpDelay                             = "up_delay"
+	mkStartupDownDelay                           = "down_delay"
+	mkStartOnBoot                                = "start_on_boot"
+	mkTags                                       = "tags"
+	mkTemplate                                   = "template"
+	mkTimeoutCreate                              = "timeout_create"
+	mkTimeoutClone                               = "timeout_clone"
+	mkTimeoutUpdate                              = "timeout_update"
+	mkTimeoutDelete                              = "timeout_delete"
+	mkUnprivileged                               = "unprivileged"
+	mkVMID                                       = "vm_id"
 )
 
 // Container returns a resource that manages a container.
@@ -322,7 +334,6 @@ func Container() *schema.Resource {
 				Type:        schema.TypeList,
 				Description: "The disks",
 				Optional:    true,
-				ForceNew:    true,
 				DefaultFunc: func() (interface{}, error) {
 					return []interface{}{
 						map[string]interface{}{
@@ -337,14 +348,12 @@ func Container() *schema.Resource {
 							Type:        schema.TypeString,
 							Description: "The datastore id",
 							Optional:    true,
-							ForceNew:    true,
 							Default:     dvDiskDatastoreID,
 						},
 						mkDiskSize: {
 							Type:             schema.TypeInt,
 							Description:      "The rootfs size in gigabytes",
 							Optional:         true,
-							ForceNew:         true,
 							Default:          dvDiskSize,
 							ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
 						},
@@ -555,6 +564,25 @@ func Container() *schema.Resource {
 												strings.ReplaceAll(oldVal, "*", "") == ""
 										},
 									},
+									mkInitializationUserAccountPasswordWO: {
+										Type: schema.TypeString,
+										Description: "The SSH password, which is not stored in the state " +
+											"(requires Terraform 1.11+)",
+										Optional:  true,
+										Sensitive: true,
+										WriteOnly: true,
+										ConflictsWith: []string{
+											fmt.Sprintf("%s.0.%s.0.%s",
+												mkInitialization, mkInitializationUserAccount, mkInitializationUserAccountPassword),
+										},
+									},
+									mkInitializationUserAccountPasswordWOVersion: {
+										Type: schema.TypeInt,
+										Description: "The version of the write-only SSH password, " +
+											"change it to re-create the container with the new password",
+										Optional: true,
+										ForceNew: true,
+									},
 								},
 							},
 							MaxItems: 1,
@@ -800,11 +828,16 @@ func Container() *schema.Resource {
 				MaxItems: maxNetworkInterfaces,
 				MinItems: 0,
 			},
+			mkMigrate: {
+				Type:        schema.TypeBool,
+				Description: "Whether to migrate the container on node change instead of re-creating it",
+				Optional:    true,
+				Default:     dvMigrate,
+			},
 			mkNodeName: {
 				Type:        schema.TypeString,
 				Description: "The node name",
 				Required:    true,
-				ForceNew:    true,
 			},
 			mkOperatingSystem: {
 				Type:        schema.TypeList,
@@ -847,6 +880,43 @@ func Container() *schema.Resource {
 				ForceNew: false,
 				Default:  dvProtection,
 			},
+			mkRestore: {
+				Type:        schema.TypeList,
+				Description: "The restore configuration",
+				Optional:    true,
+				ForceNew:    true,
+				DefaultFunc: func() (interface{}, error) {
+					return []interface{}{}, nil
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						mkRestoreArchive: {
+							Type:             schema.TypeString,
+							Description:      "The volume ID of the backup archive to restore the container from",
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+						},
+						mkRestoreForce: {
+							Type:        schema.TypeBool,
+							Description: "Whether to overwrite an existing container with the same ID",
+							Optional:    true,
+							ForceNew:    true,
+							Default:     dvRestoreForce,
+						},
+						mkRestoreUnique: {
+							Type:        schema.TypeBool,
+							Description: "Whether to assign unique random ethernet addresses to the restored container",
+							Optional:    true,
+							ForceNew:    true,
+							Default:     dvRestoreUnique,
+						},
+					},
+				},
+				MaxItems:      1,
+				MinItems:      0,
+				ConflictsWith: []string{mkClone, mkOperatingSystem},
+			},
 			mkStarted: {
 				Type:        schema.TypeBool,
 				Description: "Whether to start the container",
@@ -972,6 +1042,16 @@ func Container() *schema.Resource {
 					return strconv.Itoa(newValue.(int)) != d.Id()
 				},
 			),
+			customdiff.ForceNewIf(
+				mkNodeName,
+				func(_ context.Context, d *schema.ResourceDiff, _ interface{}) bool {
+					if !d.HasChange(mkNodeName) {
+						return false
+					}
+
+					return !d.Get(mkMigrate).(bool)
+				},
+			),
 		),
 		Importer: &schema.ResourceImporter{
 			StateContext: func(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
@@ -999,6 +1079,10 @@ func containerCreate(ctx context.Context, d *schema.ResourceData, m interface{})
 		return containerCreateClone(ctx, d, m)
 	}
 
+	if restore := d.Get(mkRestore).([]interface{}); len(restore) > 0 {
+		return containerCreateRestore(ctx, d, m)
+	}
+
 	return containerCreateCustom(ctx, d, m)
 }
 
@@ -1033,7 +1117,6 @@ func containerCreateClone(ctx context.Context, d *schema.ResourceData, m interfa
 
 	nodeName := d.Get(mkNodeName).(string)
 	poolID := d.Get(mkPoolID).(string)
-	tags := d.Get(mkTags).([]interface{})
 	vmIDUntyped, hasVMID := d.GetOk(mkVMID)
 	vmID := vmIDUntyped.(int)
 
@@ -1096,9 +1179,31 @@ func containerCreateClone(ctx context.Context, d *schema.ResourceData, m interfa
 		return diag.FromErr(err)
 	}
 
-	// Now that the virtual machine has been cloned, we need to perform some modifications.
+	// Now that the container has been cloned, we need to perform some modifications.
+	if diags := containerCreateUpdateConfig(ctx, d, containerAPI); diags.HasError() {
+		return diags
+	}
+
+	return containerCreateStart(ctx, d, m)
+}
+
+// containerCreateUpdateConfig applies the resource configuration to a container that was created from an existing
+// one, either cloned or restored from a backup.
+func containerCreateUpdateConfig(
+	ctx context.Context,
+	d *schema.ResourceData,
+	containerAPI *containers.Client,
+) diag.Diagnostics {
+	description := d.Get(mkDescription).(string)
+	initialization := d.Get(mkInitialization).([]interface{})
+	tags := d.Get(mkTags).([]interface{})
+
 	updateBody := &containers.UpdateRequestBody{}
 
+	if description != "" {
+		updateBody.Description = &description
+	}
+
 	startOnBoot := types.CustomBool(d.Get(mkStartOnBoot).(bool))
 	updateBody.StartOnBoot = &startOnBoot
 
@@ -1244,6 +1349,10 @@ func containerCreateClone(ctx context.Context, d *schema.ResourceData, m interfa
 
 			initializationUserAccountPassword := initializationUserAccountBlock[mkInitializationUserAccountPassword].(string)
 
+			if passwordWO := containerGetPasswordWO(d); passwordWO != "" {
+				initializationUserAccountPassword = passwordWO
+			}
+
 			if initializationUserAccountPassword != dvInitializationUserAccountPassword {
 				updateBody.Password = &initializationUserAccountPassword
 			} else {
@@ -1297,6 +1406,8 @@ func containerCreateClone(ctx context.Context, d *schema.ResourceData, m interfa
 	networkInterface := d.Get(mkNetworkInterface).([]interface{})
 
 	if len(networkInterface) == 0 {
+		var err error
+
 		networkInterface, err = containerGetExistingNetworkInterface(ctx, containerAPI)
 		if err != nil {
 			return diag.FromErr(err)
@@ -1404,7 +1515,7 @@ func containerCreateClone(ctx context.Context, d *schema.ResourceData, m interfa
 		updateBody.Template = &template
 	}
 
-	err = containerAPI.UpdateContainer(ctx, updateBody)
+	err := containerAPI.UpdateContainer(ctx, updateBody)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -1415,7 +1526,7 @@ func containerCreateClone(ctx context.Context, d *schema.ResourceData, m interfa
 		return diag.FromErr(err)
 	}
 
-	return containerCreateStart(ctx, d, m)
+	return nil
 }
 
 func containerCreateCustom(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -1588,6 +1699,10 @@ func containerCreateCustom(ctx context.Context, d *schema.ResourceData, m interf
 			}
 
 			initializationUserAccountPassword = initializationUserAccountBlock[mkInitializationUserAccountPassword].(string)
+
+			if passwordWO := containerGetPasswordWO(d); passwordWO != "" {
+				initializationUserAccountPassword = passwordWO
+			}
 		}
 	}
 
@@ -1901,6 +2016,82 @@ func containerCreateCustom(ctx context.Context, d *schema.ResourceData, m interf
 	return containerCreateStart(ctx, d, m)
 }
 
+// containerCreateRestore creates a container by restoring it from an existing vzdump archive.
+func containerCreateRestore(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	createTimeoutSec := d.Get(mkTimeoutCreate).(int)
+
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(createTimeoutSec)*time.Second)
+	defer cancel()
+
+	config := m.(proxmoxtf.ProviderConfiguration)
+
+	client, err := config.GetClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	restore := d.Get(mkRestore).([]interface{})
+	restoreBlock := restore[0].(map[string]interface{})
+	restoreArchive := restoreBlock[mkRestoreArchive].(string)
+	restoreForce := types.CustomBool(restoreBlock[mkRestoreForce].(bool))
+	restoreUnique := types.CustomBool(restoreBlock[mkRestoreUnique].(bool))
+
+	nodeName := d.Get(mkNodeName).(string)
+	poolID := d.Get(mkPoolID).(string)
+	vmIDUntyped, hasVMID := d.GetOk(mkVMID)
+	vmID := vmIDUntyped.(int)
+
+	if !hasVMID {
+		vmIDNew, e := config.GetIDGenerator().NextID(ctx)
+		if e != nil {
+			return diag.FromErr(e)
+		}
+
+		vmID = vmIDNew
+
+		e = d.Set(mkVMID, vmID)
+		if e != nil {
+			return diag.FromErr(e)
+		}
+	}
+
+	restoreFlag := types.CustomBool(true)
+
+	createBody := containers.CreateRequestBody{
+		Force:                &restoreForce,
+		OSTemplateFileVolume: &restoreArchive,
+		Restore:              &restoreFlag,
+		Unique:               &restoreUnique,
+		VMID:                 &vmID,
+	}
+
+	if poolID != "" {
+		createBody.PoolID = &poolID
+	}
+
+	err = client.Node(nodeName).Container(0).CreateContainer(ctx, &createBody)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(vmID))
+
+	containerAPI := client.Node(nodeName).Container(vmID)
+
+	// Wait for the container's lock to be released.
+	err = containerAPI.WaitForContainerConfigUnlock(ctx, true)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The archive contains the configuration of the backed up container, which is replaced by the resource one.
+	if diags := containerCreateUpdateConfig(ctx, d, containerAPI); diags.HasError() {
+		return diags
+	}
+
+	return containerCreateStart(ctx, d, m)
+}
+
 func containerCreateStart(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	started := d.Get(mkStarted).(bool)
 	template := d.Get(mkTemplate).(bool)
@@ -1994,6 +2185,20 @@ func containerGetExistingNetworkInterface(
 	return utils.OrderedListFromMap(networkInterfacesMap), nil
 }
 
+// containerGetPasswordWO returns the write-only password of the user account from the configuration, if any.
+func containerGetPasswordWO(d *schema.ResourceData) string {
+	p := cty.GetAttrPath(mkInitialization).IndexInt(0).
+		GetAttr(mkInitializationUserAccount).IndexInt(0).
+		GetAttr(mkInitializationUserAccountPasswordWO)
+
+	v, diags := d.GetRawConfigAt(p)
+	if diags.HasError() || !v.IsKnown() || v.IsNull() || !v.Type().Equals(cty.String) {
+		return ""
+	}
+
+	return v.AsString()
+}
+
 func containerGetTagsString(d *schema.ResourceData) string {
 	var sanitizedTags []string
 
@@ -2129,6 +2334,11 @@ func containerRead(ctx context.Context, d *schema.ResourceData, m interface{}) d
 
 	clone := d.Get(mkClone).([]interface{})
 
+	// A restored container gets its configuration from the backup, so treat it like a clone.
+	if len(clone) == 0 {
+		clone = d.Get(mkRestore).([]interface{})
+	}
+
 	// Compare the primitive values to those stored in the state.
 	currentDescription := d.Get(mkDescription).(string)
 
@@ -2780,8 +2990,20 @@ func containerUpdate(ctx context.Context, d *schema.ResourceData, m interface{})
 		return diag.FromErr(e)
 	}
 
+	// If the node name has changed we need to migrate the container to the new node before we do anything else.
+	if d.HasChange(mkNodeName) {
+		if diags := containerMigrate(ctx, d, client, vmID); diags.HasError() {
+			return diags
+		}
+	}
+
 	containerAPI := client.Node(nodeName).Container(vmID)
 
+	movedVolumes, diags := containerUpdateDiskLocationAndSize(ctx, d, containerAPI)
+	if diags.HasError() {
+		return diags
+	}
+
 	// Prepare the new request object.
 	updateBody := containers.UpdateRequestBody{
 		Delete: []string{},
@@ -2793,6 +3015,10 @@ func containerUpdate(ctx context.Context, d *schema.ResourceData, m interface{})
 	// Retrieve the clone argument as the update logic varies for clones.
 	clone := d.Get(mkClone).([]interface{})
 
+	if len(clone) == 0 {
+		clone = d.Get(mkRestore).([]interface{})
+	}
+
 	// Prepare the new primitive values.
 	description := d.Get(mkDescription).(string)
 	updateBody.Description = &description
@@ -3042,6 +3268,10 @@ func containerUpdate(ctx context.Context, d *schema.ResourceData, m interface{})
 			size := mountPointMap[mkMountPointSize].(string)
 			volume := mountPointMap[mkMountPointVolume].(string)
 
+			if movedVolume, ok := movedVolumes[fmt.Sprintf("mp%d", i)]; ok {
+				volume = movedVolume
+			}
+
 			mountPointObject.ACL = &acl
 			mountPointObject.Backup = &backup
 			mountPointObject.MountPoint = path
@@ -3277,6 +3507,225 @@ func containerUpdate(ctx context.Context, d *schema.ResourceData, m interface{})
 	return containerRead(ctx, d, m)
 }
 
+// containerMigrate migrates the container from the previous to the new node. A running container is
+// migrated in restart mode, as PVE does not support live migration of containers.
+func containerMigrate(ctx context.Context, d *schema.ResourceData, client proxmox.Client, vmID int) diag.Diagnostics {
+	oldNodeNameValue, newNodeNameValue := d.GetChange(mkNodeName)
+	containerAPI := client.Node(oldNodeNameValue.(string)).Container(vmID)
+
+	status, err := containerAPI.GetContainerStatus(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	migrateBody := &containers.MigrateRequestBody{
+		TargetNode: newNodeNameValue.(string),
+	}
+
+	if status.Status == "running" {
+		restart := types.CustomBool(true)
+		shutdownTimeoutSec := max(1, d.Get(mkTimeoutDelete).(int)-5)
+
+		migrateBody.Restart = &restart
+		migrateBody.Timeout = &shutdownTimeoutSec
+	}
+
+	err = containerAPI.MigrateContainer(ctx, migrateBody)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// containerUpdateDiskLocationAndSize moves the root file system and the mount point volumes to their new
+// datastores, and grows them to their new sizes. Returns the new volume IDs of the moved mount points.
+func containerUpdateDiskLocationAndSize(
+	ctx context.Context,
+	d *schema.ResourceData,
+	containerAPI *containers.Client,
+) (map[string]string, diag.Diagnostics) {
+	var volumeMoveBodies []*containers.MoveVolumeRequestBody
+
+	var diskResizeBodies []*containers.ResizeDiskRequestBody
+
+	deleteOriginalVolume := types.CustomBool(true)
+
+	if d.HasChange(mkDisk) {
+		diskOld, diskNew := d.GetChange(mkDisk)
+
+		oldDatastoreID, oldSize := containerGetDiskBlock(diskOld.([]interface{}))
+		newDatastoreID, newSize := containerGetDiskBlock(diskNew.([]interface{}))
+
+		if oldDatastoreID != newDatastoreID {
+			volumeMoveBodies = append(volumeMoveBodies, &containers.MoveVolumeRequestBody{
+				DeleteOriginalVolume: &deleteOriginalVolume,
+				TargetStorage:        newDatastoreID,
+				Volume:               "rootfs",
+			})
+		}
+
+		if newSize < oldSize {
+			return nil, diag.Errorf("cannot shrink the root file system of the container, it is not supported")
+		}
+
+		if newSize > oldSize {
+			diskResizeBodies = append(diskResizeBodies, &containers.ResizeDiskRequestBody{
+				Disk: "rootfs",
+				Size: *types.DiskSizeFromGigabytes(int64(newSize)),
+			})
+		}
+	}
+
+	if d.HasChange(mkMountPoint) {
+		mountPointsOld, mountPointsNew := d.GetChange(mkMountPoint)
+		oldMountPoints := mountPointsOld.([]interface{})
+		newMountPoints := mountPointsNew.([]interface{})
+
+		for i := 0; i < min(len(oldMountPoints), len(newMountPoints)); i++ {
+			oldMountPoint := oldMountPoints[i].(map[string]interface{})
+			newMountPoint := newMountPoints[i].(map[string]interface{})
+			key := fmt.Sprintf("mp%d", i)
+
+			// only the existing storage-backed mount points have volumes in the "storage:volume" format
+			oldDatastoreID, _, isVolume := strings.Cut(oldMountPoint[mkMountPointVolume].(string), ":")
+			if !isVolume {
+				continue
+			}
+
+			newVolume := newMountPoint[mkMountPointVolume].(string)
+			if newVolume != oldDatastoreID && !strings.ContainsAny(newVolume, ":/") {
+				volumeMoveBodies = append(volumeMoveBodies, &containers.MoveVolumeRequestBody{
+					DeleteOriginalVolume: &deleteOriginalVolume,
+					TargetStorage:        newVolume,
+					Volume:               key,
+				})
+			}
+
+			oldSize := oldMountPoint[mkMountPointSize].(string)
+			newSize := newMountPoint[mkMountPointSize].(string)
+
+			if oldSize == "" || newSize == "" || oldSize == newSize {
+				continue
+			}
+
+			oldDiskSize, err := types.ParseDiskSize(oldSize)
+			if err != nil {
+				return nil, diag.Errorf("invalid disk size: %s", err.Error())
+			}
+
+			newDiskSize, err := types.ParseDiskSize(newSize)
+			if err != nil {
+				return nil, diag.Errorf("invalid disk size: %s", err.Error())
+			}
+
+			if newDiskSize < oldDiskSize {
+				return nil, diag.Errorf("cannot shrink mount point %s of the container, it is not supported", key)
+			}
+
+			if newDiskSize > oldDiskSize {
+				diskResizeBodies = append(diskResizeBodies, &containers.ResizeDiskRequestBody{
+					Disk: key,
+					Size: newDiskSize,
+				})
+			}
+		}
+	}
+
+	if len(volumeMoveBodies) > 0 {
+		if diags := containerMoveVolumes(ctx, d, containerAPI, volumeMoveBodies); diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	for _, reqBody := range diskResizeBodies {
+		err := containerAPI.ResizeContainerDisk(ctx, reqBody)
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+	}
+
+	movedVolumes := map[string]string{}
+
+	if len(volumeMoveBodies) == 0 {
+		return movedVolumes, nil
+	}
+
+	containerConfig, err := containerAPI.GetContainer(ctx)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	for _, reqBody := range volumeMoveBodies {
+		if mp, ok := containerConfig.MountPoints[reqBody.Volume]; ok && mp != nil {
+			movedVolumes[reqBody.Volume] = mp.Volume
+		}
+	}
+
+	return movedVolumes, nil
+}
+
+// containerMoveVolumes moves the container volumes. Volumes cannot be moved while the container is running,
+// so a running container is shut down and started again afterwards.
+func containerMoveVolumes(
+	ctx context.Context,
+	d *schema.ResourceData,
+	containerAPI *containers.Client,
+	volumeMoveBodies []*containers.MoveVolumeRequestBody,
+) diag.Diagnostics {
+	status, err := containerAPI.GetContainerStatus(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	running := status.Status == "running"
+
+	if running {
+		forceStop := types.CustomBool(true)
+		shutdownTimeoutSec := max(1, d.Get(mkTimeoutDelete).(int)-5)
+
+		err = containerAPI.ShutdownContainer(ctx, &containers.ShutdownRequestBody{
+			ForceStop: &forceStop,
+			Timeout:   &shutdownTimeoutSec,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		err = containerAPI.WaitForContainerStatus(ctx, "stopped")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	for _, reqBody := range volumeMoveBodies {
+		err = containerAPI.MoveContainerVolume(ctx, reqBody)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if running {
+		err = containerAPI.StartContainer(ctx)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// containerGetDiskBlock returns the datastore ID and the size of the root file system disk block.
+func containerGetDiskBlock(disk []interface{}) (string, int) {
+	if len(disk) == 0 || disk[0] == nil {
+		return dvDiskDatastoreID, dvDiskSize
+	}
+
+	diskBlock := disk[0].(map[string]interface{})
+
+	return diskBlock[mkDiskDatastoreID].(string), diskBlock[mkDiskSize].(int)
+}
+
 func containerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	deleteTimeoutSec := d.Get(mkTimeoutDelete).(int)
 
@@ -10,7 +10,14 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/fakepve"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/helpers/ptr"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/containers"
 	"github.com/bpg/terraform-provider-proxmox/proxmoxtf/test"
 )
 
@@ -41,6 +48,7 @@ func TestContainerSchema(t *testing.T) {
 		mkInitialization,
 		mkHookScriptFileID,
 		mkMemory,
+		mkMigrate,
 		mkDevicePassthrough,
 		mkMountPoint,
 		mkOperatingSystem,
@@ -62,6 +70,7 @@ func TestContainerSchema(t *testing.T) {
 		mkInitialization:    schema.TypeList,
 		mkHookScriptFileID:  schema.TypeString,
 		mkMemory:            schema.TypeList,
+		mkMigrate:           schema.TypeBool,
 		mkDevicePassthrough: schema.TypeList,
 		mkMountPoint:        schema.TypeList,
 		mkOperatingSystem:   schema.TypeList,
@@ -226,11 +235,15 @@ func TestContainerSchema(t *testing.T) {
 	test.AssertOptionalArguments(t, initializationUserAccountSchema, []string{
 		mkInitializationUserAccountKeys,
 		mkInitializationUserAccountPassword,
+		mkInitializationUserAccountPasswordWO,
+		mkInitializationUserAccountPasswordWOVersion,
 	})
 
 	test.AssertValueTypes(t, initializationUserAccountSchema, map[string]schema.ValueType{
-		mkInitializationUserAccountKeys:     schema.TypeList,
-		mkInitializationUserAccountPassword: schema.TypeString,
+		mkInitializationUserAccountKeys:              schema.TypeList,
+		mkInitializationUserAccountPassword:          schema.TypeString,
+		mkInitializationUserAccountPasswordWO:        schema.TypeString,
+		mkInitializationUserAccountPasswordWOVersion: schema.TypeInt,
 	})
 
 	memorySchema := test.AssertNestedSchemaExistence(t, s, mkMemory)
@@ -340,3 +353,220 @@ func TestContainerSchema(t *testing.T) {
 		mkOperatingSystemType:           schema.TypeString,
 	})
 }
+
+// TestContainerCreateRestore tests that the configuration of a container restored from a backup is replaced
+// by the resource one.
+func TestContainerCreateRestore(t *testing.T) {
+	t.Parallel()
+
+	s, config := test.NewFakeProviderConfiguration(t)
+	ctx := t.Context()
+
+	client, err := config.GetClient()
+	require.NoError(t, err)
+
+	nodeAPI := client.Node(s.NodeName())
+
+	require.NoError(t, nodeAPI.Container(200).CreateContainer(ctx, &containers.CreateRequestBody{
+		VMID:                 ptr.Ptr(200),
+		Hostname:             ptr.Ptr("backed-up"),
+		Description:          ptr.Ptr("backed up"),
+		DedicatedMemory:      ptr.Ptr(512),
+		CPUCores:             ptr.Ptr(1),
+		OSTemplateFileVolume: ptr.Ptr("local:vztmpl/debian.tar.zst"),
+		RootFS:               &containers.CustomRootFS{Volume: "local-lvm:4"},
+	}))
+
+	archive, err := nodeAPI.Vzdump(ctx, &nodes.VzdumpRequestBody{VMID: 200, Storage: "local"})
+	require.NoError(t, err)
+
+	d := schema.TestResourceDataRaw(t, Container().Schema, map[string]interface{}{
+		mkNodeName:    s.NodeName(),
+		mkVMID:        201,
+		mkDescription: "restored from a backup",
+		mkStarted:     false,
+		mkTags:        []interface{}{"restored"},
+		mkCPU:         []interface{}{map[string]interface{}{mkCPUCores: 2}},
+		mkMemory:      []interface{}{map[string]interface{}{mkMemoryDedicated: 1024}},
+		mkInitialization: []interface{}{map[string]interface{}{
+			mkInitializationHostname: "restored",
+		}},
+		mkRestore: []interface{}{map[string]interface{}{mkRestoreArchive: archive}},
+	})
+
+	diags := containerCreate(ctx, d, config)
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "201", d.Id())
+
+	ct, err := nodeAPI.Container(201).GetContainer(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "restored", *ct.Hostname)
+	assert.Equal(t, "restored from a backup", *ct.Description)
+	assert.Equal(t, "restored", *ct.Tags)
+	assert.Equal(t, 2, *ct.CPUCores)
+	assert.Equal(t, 1024, *ct.DedicatedMemory)
+	assert.Equal(t, "local-lvm:vm-201-disk-0", ct.RootFS.Volume)
+}
+
+// containerResourceData returns the resource data of a container updated from the old to the new configuration.
+func containerResourceData(t *testing.T, oldConfig, newConfig map[string]interface{}) *schema.ResourceData {
+	t.Helper()
+
+	r := Container()
+
+	old := schema.TestResourceDataRaw(t, r.Schema, oldConfig)
+	old.SetId("100")
+
+	state := old.State()
+
+	diff, err := r.Diff(t.Context(), state, terraform.NewResourceConfigRaw(newConfig), nil)
+	require.NoError(t, err)
+
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+	require.NoError(t, err)
+
+	return d
+}
+
+// TestContainerNodeNameChange tests that changing the node of a container migrates it only if `migrate` is set,
+// and re-creates it otherwise.
+func TestContainerNodeNameChange(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		migrate      bool
+		wantForceNew bool
+	}{
+		{"re-create", false, true},
+		{"migrate", true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := Container()
+
+			old := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+				mkNodeName: "pve",
+				mkVMID:     100,
+				mkMigrate:  tt.migrate,
+			})
+			old.SetId("100")
+
+			diff, err := r.Diff(t.Context(), old.State(), terraform.NewResourceConfigRaw(map[string]interface{}{
+				mkNodeName: "pve2",
+				mkVMID:     100,
+				mkMigrate:  tt.migrate,
+			}), nil)
+			require.NoError(t, err)
+			require.Contains(t, diff.Attributes, mkNodeName)
+			assert.Equal(t, tt.wantForceNew, diff.Attributes[mkNodeName].RequiresNew)
+		})
+	}
+}
+
+// TestContainerMigrate tests that a running container is migrated in restart mode.
+func TestContainerMigrate(t *testing.T) {
+	t.Parallel()
+
+	_, config := test.NewFakeProviderConfiguration(t, fakepve.WithNodes("pve", "pve2"))
+	ctx := t.Context()
+
+	client, err := config.GetClient()
+	require.NoError(t, err)
+
+	containerAPI := client.Node("pve").Container(100)
+
+	require.NoError(t, containerAPI.CreateContainer(ctx, &containers.CreateRequestBody{
+		VMID:                 ptr.Ptr(100),
+		OSTemplateFileVolume: ptr.Ptr("local:vztmpl/debian.tar.zst"),
+	}))
+	require.NoError(t, containerAPI.StartContainer(ctx))
+
+	d := containerResourceData(t,
+		map[string]interface{}{mkNodeName: "pve", mkVMID: 100, mkMigrate: true},
+		map[string]interface{}{mkNodeName: "pve2", mkVMID: 100, mkMigrate: true},
+	)
+
+	diags := containerMigrate(ctx, d, client, 100)
+	require.False(t, diags.HasError(), "%v", diags)
+
+	_, err = containerAPI.GetContainer(ctx)
+	require.Error(t, err)
+
+	status, err := client.Node("pve2").Container(100).GetContainerStatus(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "running", status.Status)
+}
+
+// TestContainerUpdateDiskLocationAndSize tests that the root file system is moved when its datastore changes,
+// resized when it grows, and that shrinking it is rejected.
+func TestContainerUpdateDiskLocationAndSize(t *testing.T) {
+	t.Parallel()
+
+	disk := func(datastoreID string, size int) []interface{} {
+		return []interface{}{map[string]interface{}{mkDiskDatastoreID: datastoreID, mkDiskSize: size}}
+	}
+
+	tests := []struct {
+		name       string
+		disk       []interface{}
+		wantVolume string
+		wantSize   int64
+		wantErr    string
+	}{
+		{"unchanged", disk("local-lvm", 4), "local-lvm:vm-100-disk-0", 4, ""},
+		{"move", disk("nfs", 4), "nfs:100/vm-100-disk-0.raw", 4, ""},
+		{"resize", disk("local-lvm", 8), "local-lvm:vm-100-disk-0", 8, ""},
+		{"move and resize", disk("nfs", 8), "nfs:100/vm-100-disk-0.raw", 8, ""},
+		{"shrink", disk("local-lvm", 2), "", 0, "cannot shrink the root file system"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, config := test.NewFakeProviderConfiguration(t, fakepve.WithDatastore("nfs", "dir", "images", "rootdir"))
+			ctx := t.Context()
+
+			client, err := config.GetClient()
+			require.NoError(t, err)
+
+			containerAPI := client.Node(fakepve.DefaultNodeName).Container(100)
+
+			require.NoError(t, containerAPI.CreateContainer(ctx, &containers.CreateRequestBody{
+				VMID:                 ptr.Ptr(100),
+				OSTemplateFileVolume: ptr.Ptr("local:vztmpl/debian.tar.zst"),
+				RootFS:               &containers.CustomRootFS{Volume: "local-lvm:4"},
+			}))
+			require.NoError(t, containerAPI.StartContainer(ctx))
+
+			d := containerResourceData(t,
+				map[string]interface{}{mkNodeName: fakepve.DefaultNodeName, mkVMID: 100, mkDisk: disk("local-lvm", 4)},
+				map[string]interface{}{mkNodeName: fakepve.DefaultNodeName, mkVMID: 100, mkDisk: tt.disk},
+			)
+
+			_, diags := containerUpdateDiskLocationAndSize(ctx, d, containerAPI)
+			if tt.wantErr != "" {
+				require.True(t, diags.HasError())
+				assert.Contains(t, diags[0].Summary, tt.wantErr)
+
+				return
+			}
+
+			require.False(t, diags.HasError(), "%v", diags)
+
+			ct, err := containerAPI.GetContainer(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantVolume, ct.RootFS.Volume)
+			assert.Equal(t, tt.wantSize, ct.RootFS.Size.InGigabytes())
+
+			// the container is started again after its volumes are moved
+			status, err := containerAPI.GetContainerStatus(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, "running", status.Status)
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -30,20 +31,22 @@ const (
 	dvResourceVirtualEnvironmentUserKeys      = ""
 	dvResourceVirtualEnvironmentUserLastName  = ""
 
-	mkResourceVirtualEnvironmentUserACL            = "acl"
-	mkResourceVirtualEnvironmentUserACLPath        = "path"
-	mkResourceVirtualEnvironmentUserACLPropagate   = "propagate"
-	mkResourceVirtualEnvironmentUserACLRoleID      = "role_id"
-	mkResourceVirtualEnvironmentUserComment        = "comment"
-	mkResourceVirtualEnvironmentUserEmail          = "email"
-	mkResourceVirtualEnvironmentUserEnabled        = "enabled"
-	mkResourceVirtualEnvironmentUserExpirationDate = "expiration_date"
-	mkResourceVirtualEnvironmentUserFirstName      = "first_name"
-	mkResourceVirtualEnvironmentUserGroups         = "groups"
-	mkResourceVirtualEnvironmentUserKeys           = "keys"
-	mkResourceVirtualEnvironmentUserLastName       = "last_name"
-	mkResourceVirtualEnvironmentUserPassword       = "password"
-	mkResourceVirtualEnvironmentUserUserID         = "user_id"
+	mkResourceVirtualEnvironmentUserACL               = "acl"
+	mkResourceVirtualEnvironmentUserACLPath           = "path"
+	mkResourceVirtualEnvironmentUserACLPropagate      = "propagate"
+	mkResourceVirtualEnvironmentUserACLRoleID         = "role_id"
+	mkResourceVirtualEnvironmentUserComment           = "comment"
+	mkResourceVirtualEnvironmentUserEmail             = "email"
+	mkResourceVirtualEnvironmentUserEnabled           = "enabled"
+	mkResourceVirtualEnvironmentUserExpirationDate    = "expiration_date"
+	mkResourceVirtualEnvironmentUserFirstName         = "first_name"
+	mkResourceVirtualEnvironmentUserGroups            = "groups"
+	mkResourceVirtualEnvironmentUserKeys              = "keys"
+	mkResourceVirtualEnvironmentUserLastName          = "last_name"
+	mkResourceVirtualEnvironmentUserPassword          = "password"
+	mkResourceVirtualEnvironmentUserPasswordWO        = "password_wo"
+	mkResourceVirtualEnvironmentUserPasswordWOVersion = "password_wo_version"
+	mkResourceVirtualEnvironmentUserUserID            = "user_id"
 )
 
 // User returns a resource that manages a user in the Proxmox VE access control list.
@@ -131,10 +134,25 @@ func User() *schema.Resource {
 				Default:     dvResourceVirtualEnvironmentUserLastName,
 			},
 			mkResourceVirtualEnvironmentUserPassword: {
-				Type:        schema.TypeString,
-				Description: "The user's password",
-				Optional:    true,
-				Sensitive:   true,
+				Type:          schema.TypeString,
+				Description:   "The user's password",
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{mkResourceVirtualEnvironmentUserPasswordWO},
+			},
+			mkResourceVirtualEnvironmentUserPasswordWO: {
+				Type:          schema.TypeString,
+				Description:   "The user's password, which is not stored in the state (requires Terraform 1.11+)",
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{mkResourceVirtualEnvironmentUserPassword},
+			},
+			mkResourceVirtualEnvironmentUserPasswordWOVersion: {
+				Type:         schema.TypeInt,
+				Description:  "The version of the write-only password, change it to update the password",
+				Optional:     true,
+				RequiredWith: []string{mkResourceVirtualEnvironmentUserPasswordWO},
 			},
 			mkResourceVirtualEnvironmentUserUserID: {
 				Type:        schema.TypeString,
@@ -194,6 +212,10 @@ func userCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag
 	password := d.Get(mkResourceVirtualEnvironmentUserPassword).(string)
 	userID := d.Get(mkResourceVirtualEnvironmentUserUserID).(string)
 
+	if passwordWO := userGetPasswordWO(d); passwordWO != "" {
+		password = passwordWO
+	}
+
 	body := &access.UserCreateRequestBody{
 		Comment:        &comment,
 		Email:          &email,
@@ -412,6 +434,15 @@ func userUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag
 		}
 	}
 
+	if d.HasChange(mkResourceVirtualEnvironmentUserPasswordWOVersion) {
+		if passwordWO := userGetPasswordWO(d); passwordWO != "" {
+			err = client.Access().ChangeUserPassword(ctx, userID, passwordWO)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
 	aclArgOld, aclArg := d.GetChange(mkResourceVirtualEnvironmentUserACL)
 	aclParsedOld := aclArgOld.(*schema.Set).List()
 
@@ -502,3 +533,13 @@ func userDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag
 
 	return nil
 }
+
+// userGetPasswordWO returns the write-only password from the configuration, if any.
+func userGetPasswordWO(d *schema.ResourceData) string {
+	v, diags := d.GetRawConfigAt(cty.GetAttrPath(mkResourceVirtualEnvironmentUserPasswordWO))
+	if diags.HasError() || !v.IsKnown() || v.IsNull() || !v.Type().Equals(cty.String) {
+		return ""
+	}
+
+	return v.AsString()
+}
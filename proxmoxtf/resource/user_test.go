@@ -45,20 +45,24 @@ func TestUserSchema(t *testing.T) {
 		mkResourceVirtualEnvironmentUserKeys,
 		mkResourceVirtualEnvironmentUserLastName,
 		mkResourceVirtualEnvironmentUserPassword,
+		mkResourceVirtualEnvironmentUserPasswordWO,
+		mkResourceVirtualEnvironmentUserPasswordWOVersion,
 	})
 
 	test.AssertValueTypes(t, s, map[string]schema.ValueType{
-		mkResourceVirtualEnvironmentUserACL:            schema.TypeSet,
-		mkResourceVirtualEnvironmentUserComment:        schema.TypeString,
-		mkResourceVirtualEnvironmentUserEmail:          schema.TypeString,
-		mkResourceVirtualEnvironmentUserEnabled:        schema.TypeBool,
-		mkResourceVirtualEnvironmentUserExpirationDate: schema.TypeString,
-		mkResourceVirtualEnvironmentUserFirstName:      schema.TypeString,
-		mkResourceVirtualEnvironmentUserGroups:         schema.TypeSet,
-		mkResourceVirtualEnvironmentUserKeys:           schema.TypeString,
-		mkResourceVirtualEnvironmentUserLastName:       schema.TypeString,
-		mkResourceVirtualEnvironmentUserPassword:       schema.TypeString,
-		mkResourceVirtualEnvironmentUserUserID:         schema.TypeString,
+		mkResourceVirtualEnvironmentUserACL:               schema.TypeSet,
+		mkResourceVirtualEnvironmentUserComment:           schema.TypeString,
+		mkResourceVirtualEnvironmentUserEmail:             schema.TypeString,
+		mkResourceVirtualEnvironmentUserEnabled:           schema.TypeBool,
+		mkResourceVirtualEnvironmentUserExpirationDate:    schema.TypeString,
+		mkResourceVirtualEnvironmentUserFirstName:         schema.TypeString,
+		mkResourceVirtualEnvironmentUserGroups:            schema.TypeSet,
+		mkResourceVirtualEnvironmentUserKeys:              schema.TypeString,
+		mkResourceVirtualEnvironmentUserLastName:          schema.TypeString,
+		mkResourceVirtualEnvironmentUserPassword:          schema.TypeString,
+		mkResourceVirtualEnvironmentUserPasswordWO:        schema.TypeString,
+		mkResourceVirtualEnvironmentUserPasswordWOVersion: schema.TypeInt,
+		mkResourceVirtualEnvironmentUserUserID:            schema.TypeString,
 	})
 
 	aclSchema := test.AssertNestedSchemaExistence(t, s, mkResourceVirtualEnvironmentUserACL)
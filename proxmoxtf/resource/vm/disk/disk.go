@@ -321,6 +321,170 @@ func createCustomDisk(
 		fileFormat = *disk.Format
 	}
 
+	useImportFrom := false
+
+	if isImportableVolume(*disk.FileID) || isISOVolume(*disk.FileID) {
+		ver, err := client.Version().ProxmoxVersion(ctx)
+		if err != nil {
+			tflog.Warn(ctx, "unable to determine the Proxmox VE version, importing the disk over SSH",
+				map[string]interface{}{
+					"error": err.Error(),
+				},
+			)
+		} else {
+			useImportFrom = ver.SupportImportFrom()
+		}
+	}
+
+	if useImportFrom && isISOVolume(*disk.FileID) && hasSSHAccess(client) && !client.API().IsRootTicket(ctx) {
+		// the API imports the ISO volumes only for `root@pam`, import them over SSH like before `import-from`
+		tflog.Debug(ctx, "importing the ISO volume over SSH, the API imports it only as root@pam",
+			map[string]interface{}{
+				"file_id": *disk.FileID,
+			},
+		)
+
+		useImportFrom = false
+	}
+
+	var err error
+
+	if useImportFrom {
+		importFrom := *disk.FileID
+
+		if isISOVolume(importFrom) {
+			importFrom, err = isoVolumePath(ctx, client, nodeName, importFrom)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = importCustomDisk(ctx, client, nodeName, vmID, iface, disk, fileFormat, importFrom)
+	} else {
+		err = importCustomDiskOverSSH(ctx, client, nodeName, vmID, iface, disk, fileFormat)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	err = client.Node(nodeName).VM(vmID).ResizeVMDisk(ctx, &vms.ResizeDiskRequestBody{
+		Disk: iface,
+		Size: *disk.Size,
+	})
+	if err != nil {
+		return fmt.Errorf("resizing disk: %w", err)
+	}
+
+	return nil
+}
+
+// isImportableVolume returns true if the `import-from` disk option accepts the volume, i.e. if it is a volume of
+// the `images` or `import` content types. ISO images are imported using their absolute path, see isoVolumePath,
+// and absolute paths are imported over SSH.
+func isImportableVolume(fileID string) bool {
+	_, volume, ok := strings.Cut(fileID, ":")
+	if !ok {
+		return false
+	}
+
+	for _, prefix := range []string{"iso/", "vztmpl/", "snippets/", "backup/"} {
+		if strings.HasPrefix(volume, prefix) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isISOVolume returns true if the file ID is a volume of the `iso` content type, e.g. a cloud image downloaded
+// with `content_type = "iso"`.
+func isISOVolume(fileID string) bool {
+	_, volume, ok := strings.Cut(fileID, ":")
+
+	return ok && strings.HasPrefix(volume, "iso/")
+}
+
+// hasSSHAccess returns true if the SSH access to the nodes is configured, which is not the case when
+// the provider is configured with an API token only.
+func hasSSHAccess(client proxmox.Client) bool {
+	return client.SSH() != nil && client.SSH().Username() != ""
+}
+
+// isoVolumePath returns the absolute path of an ISO volume on the node. The `import-from` disk option does not
+// accept the volumes of the `iso` content type, but it accepts their absolute path when authenticated as `root@pam`.
+func isoVolumePath(ctx context.Context, client proxmox.Client, nodeName string, fileID string) (string, error) {
+	if !client.API().IsRootTicket(ctx) {
+		return "", fmt.Errorf(
+			"unable to import the disk image %q: the API imports images of the `iso` content type only when "+
+				"authenticated as `root@pam` with a password, download the image with `content_type = \"import\"` "+
+				"instead (Proxmox VE 8.4+), or configure the SSH access to the nodes",
+			fileID,
+		)
+	}
+
+	datastoreID, _, _ := strings.Cut(fileID, ":")
+
+	file, err := client.Node(nodeName).Storage(datastoreID).GetDatastoreFile(ctx, fileID)
+	if err != nil {
+		return "", fmt.Errorf("getting the path of the disk image %q: %w", fileID, err)
+	}
+
+	if file.Path == nil || *file.Path == "" {
+		return "", fmt.Errorf("the path of the disk image %q is unknown", fileID)
+	}
+
+	return *file.Path, nil
+}
+
+// importCustomDisk imports the disk image from the given volume or absolute path using the `import-from` disk
+// option of the API.
+func importCustomDisk(
+	ctx context.Context,
+	client proxmox.Client,
+	nodeName string,
+	vmID int,
+	iface string,
+	disk vms.CustomStorageDevice,
+	fileFormat string,
+	importFrom string,
+) error {
+	device := disk
+	device.FileID = nil
+	device.FileVolume = fmt.Sprintf("%s:0", *disk.DatastoreID)
+	device.Format = &fileFormat
+	device.ImportFrom = &importFrom
+	device.Size = nil
+
+	body := &vms.UpdateRequestBody{}
+	body.AddCustomStorageDevice(iface, device)
+
+	vmClient := client.Node(nodeName).VM(vmID)
+
+	taskID, err := vmClient.UpdateVMAsync(ctx, body)
+	if err != nil {
+		return fmt.Errorf("importing custom disk: %w", err)
+	}
+
+	err = vmClient.Tasks().WaitForTask(ctx, *taskID)
+	if err != nil {
+		return fmt.Errorf("waiting for custom disk import: %w", err)
+	}
+
+	return nil
+}
+
+// importCustomDiskOverSSH imports the disk image by running `qm disk import` on the node, it is used for the
+// volumes the `import-from` option does not accept and for the PVE versions not supporting it.
+func importCustomDiskOverSSH(
+	ctx context.Context,
+	client proxmox.Client,
+	nodeName string,
+	vmID int,
+	iface string,
+	disk vms.CustomStorageDevice,
+	fileFormat string,
+) error {
 	//nolint:lll
 	commands := []string{
 		`set -e`,
@@ -350,14 +514,6 @@ func createCustomDisk(
 		"output": string(out),
 	})
 
-	err = client.Node(nodeName).VM(vmID).ResizeVMDisk(ctx, &vms.ResizeDiskRequestBody{
-		Disk: iface,
-		Size: *disk.Size,
-	})
-	if err != nil {
-		return fmt.Errorf("resizing disk: %w", err)
-	}
-
 	return nil
 }
 
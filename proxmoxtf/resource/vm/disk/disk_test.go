@@ -0,0 +1,128 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package disk
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/fakepve"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/helpers/ptr"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/storage"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/vms"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/ssh"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/types"
+)
+
+func TestIsImportableVolume(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		fileID string
+		want   bool
+	}{
+		{"import content", "local:import/jammy-server-cloudimg-amd64.qcow2", true},
+		{"vm disk on directory storage", "local:100/vm-100-disk-0.qcow2", true},
+		{"vm disk on zfs storage", "local-zfs:base-9000-disk-0", true},
+		{"iso content", "local:iso/jammy-server-cloudimg-amd64.img", false},
+		{"container template", "local:vztmpl/debian-12-standard_12.7-1_amd64.tar.zst", false},
+		{"absolute path", "/var/lib/vz/images/jammy.qcow2", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, isImportableVolume(tt.fileID))
+		})
+	}
+}
+
+func TestCreateCustomDiskFromISOVolume(t *testing.T) {
+	t.Parallel()
+
+	s := fakepve.NewServer()
+	t.Cleanup(s.Close)
+
+	newClient := func(username, password, apiToken string) proxmox.Client {
+		creds, err := api.NewCredentials(username, password, "", apiToken, "", "")
+		require.NoError(t, err)
+
+		conn, err := api.NewConnection(s.Endpoint(), true, "")
+		require.NoError(t, err)
+
+		apiClient, err := api.NewClient(creds, conn)
+		require.NoError(t, err)
+
+		return proxmox.NewClient(apiClient, nil, "")
+	}
+
+	ctx := t.Context()
+	rootClient := newClient(fakepve.DefaultUsername, fakepve.DefaultPassword, "")
+	tokenClient := newClient("", "", s.APIToken())
+	nodeName := s.NodeName()
+
+	require.NoError(t, rootClient.Node(nodeName).Storage("local").DownloadFileByURL(ctx,
+		&storage.DownloadURLPostRequestBody{
+			Content:  ptr.Ptr("iso"),
+			FileName: ptr.Ptr("jammy.img"),
+			URL:      ptr.Ptr("https://cloud-images.ubuntu.com/jammy/current/jammy-server-cloudimg-amd64.img"),
+		}))
+	require.NoError(t, rootClient.Node(nodeName).VM(100).CreateVM(ctx, &vms.CreateRequestBody{VMID: 100}))
+
+	disk := vms.CustomStorageDevice{
+		DatastoreID: ptr.Ptr("local-lvm"),
+		FileID:      ptr.Ptr("local:iso/jammy.img"),
+		Size:        types.DiskSizeFromGigabytes(8),
+	}
+
+	err := createCustomDisk(ctx, tokenClient, nodeName, 100, "scsi0", disk)
+	require.ErrorContains(t, err, `content_type = "import"`)
+
+	// with the SSH access, the image is imported over SSH like before the `import-from` option
+	sshClient := &fakeSSHClient{username: "terraform", err: errors.New("connection refused")}
+	tokenSSHClient := proxmox.NewClient(tokenClient.API(), sshClient, "")
+
+	err = createCustomDisk(ctx, tokenSSHClient, nodeName, 100, "scsi0", disk)
+	require.ErrorContains(t, err, "creating custom disk: connection refused")
+	require.Len(t, sshClient.commands, 1)
+	assert.Contains(t, sshClient.commands[0], `file_id="local:iso/jammy.img"`)
+
+	require.NoError(t, createCustomDisk(ctx, rootClient, nodeName, 100, "scsi0", disk))
+
+	vm, err := rootClient.Node(nodeName).VM(100).GetVM(ctx)
+	require.NoError(t, err)
+	require.Contains(t, vm.StorageDevices, "scsi0")
+	assert.Equal(t, "local-lvm:vm-100-disk-0", vm.StorageDevices["scsi0"].FileVolume)
+	assert.Equal(t, int64(8), vm.StorageDevices["scsi0"].Size.InGigabytes())
+}
+
+// fakeSSHClient records the commands executed on the nodes, and fails them with the given error.
+type fakeSSHClient struct {
+	ssh.Client
+
+	username string
+	err      error
+	commands [][]string
+}
+
+func (c *fakeSSHClient) Username() string {
+	return c.username
+}
+
+func (c *fakeSSHClient) ExecuteNodeCommands(_ context.Context, _ string, commands []string) ([]byte, error) {
+	c.commands = append(c.commands, commands)
+
+	return nil, c.err
+}
@@ -19,6 +19,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/uuid"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -104,6 +105,8 @@ const (
 	dvOperatingSystemType = "other"
 	dvPoolID              = ""
 	dvProtection          = false
+	dvRestoreForce        = false
+	dvRestoreUnique       = false
 	dvRNGMaxBytes         = 1024
 	dvRNGPeriod           = 1000
 	dvSerialDeviceDevice  = "socket"
@@ -239,6 +242,9 @@ const (
 	mkInitializationNetworkDataFileID   = "network_data_file_id"
 	mkInitializationMetaDataFileID      = "meta_data_file_id"
 
+	mkInitializationUserAccountPasswordWO        = "password_wo"
+	mkInitializationUserAccountPasswordWOVersion = "password_wo_version"
+
 	mkKeyboardLayout      = "keyboard_layout"
 	mkKVMArguments        = "kvm_arguments"
 	mkMachine             = "machine"
@@ -256,6 +262,10 @@ const (
 	mkOperatingSystemType  = "type"
 	mkPoolID               = "pool_id"
 	mkProtection           = "protection"
+	mkRestore              = "restore"
+	mkRestoreArchive       = "archive"
+	mkRestoreForce         = "force"
+	mkRestoreUnique        = "unique"
 	mkRNG                  = "rng"
 	mkRNGSource            = "source"
 	mkRNGMaxBytes          = "max_bytes"
@@ -929,6 +939,23 @@ func VM() *schema.Resource {
 											strings.ReplaceAll(oldVal, "*", "") == ""
 									},
 								},
+								mkInitializationUserAccountPasswordWO: {
+									Type:        schema.TypeString,
+									Description: "The SSH password, which is not stored in the state (requires Terraform 1.11+)",
+									Optional:    true,
+									Sensitive:   true,
+									WriteOnly:   true,
+									ConflictsWith: []string{
+										fmt.Sprintf("%s.0.%s.0.%s",
+											mkInitialization, mkInitializationUserAccount, mkInitializationUserAccountPassword),
+									},
+								},
+								mkInitializationUserAccountPasswordWOVersion: {
+									Type: schema.TypeInt,
+									Description: "The version of the write-only SSH password, " +
+										"change it to update the password",
+									Optional: true,
+								},
 								mkInitializationUserAccountUsername: {
 									Type:        schema.TypeString,
 									Description: "The SSH username",
@@ -1268,6 +1295,43 @@ func VM() *schema.Resource {
 			Optional:    true,
 			Default:     dvProtection,
 		},
+		mkRestore: {
+			Type:        schema.TypeList,
+			Description: "The restore configuration",
+			Optional:    true,
+			ForceNew:    true,
+			DefaultFunc: func() (interface{}, error) {
+				return []interface{}{}, nil
+			},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					mkRestoreArchive: {
+						Type:             schema.TypeString,
+						Description:      "The volume ID of the backup archive to restore the VM from",
+						Required:         true,
+						ForceNew:         true,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+					},
+					mkRestoreForce: {
+						Type:        schema.TypeBool,
+						Description: "Whether to overwrite an existing VM with the same ID",
+						Optional:    true,
+						ForceNew:    true,
+						Default:     dvRestoreForce,
+					},
+					mkRestoreUnique: {
+						Type:        schema.TypeBool,
+						Description: "Whether to assign unique random ethernet addresses to the restored VM",
+						Optional:    true,
+						ForceNew:    true,
+						Default:     dvRestoreUnique,
+					},
+				},
+			},
+			MaxItems:      1,
+			MinItems:      0,
+			ConflictsWith: []string{mkClone},
+		},
 		mkRNG: {
 			Type:        schema.TypeList,
 			Description: "The RNG configuration",
@@ -1720,6 +1784,10 @@ func vmCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.D
 		return vmCreateClone(ctx, d, m)
 	}
 
+	if restore := d.Get(mkRestore).([]interface{}); len(restore) > 0 {
+		return vmCreateRestore(ctx, d, m)
+	}
+
 	return vmCreateCustom(ctx, d, m)
 }
 
@@ -1856,7 +1924,6 @@ func vmCreateClone(ctx context.Context, d *schema.ResourceData, m interface{}) d
 
 	description := d.Get(mkDescription).(string)
 	name := d.Get(mkName).(string)
-	tags := d.Get(mkTags).([]interface{})
 	nodeName := d.Get(mkNodeName).(string)
 	poolID := d.Get(mkPoolID).(string)
 	vmIDUntyped, hasVMID := d.GetOk(mkVMID)
@@ -1986,6 +2053,26 @@ func vmCreateClone(ctx context.Context, d *schema.ResourceData, m interface{}) d
 	}
 
 	// Now that the virtual machine has been cloned, we need to perform some modifications.
+	if diags := vmCreateUpdateConfig(ctx, d, client, vmAPI, vmID); diags.HasError() || d.Id() == "" {
+		return diags
+	}
+
+	return vmCreateStart(ctx, d, m)
+}
+
+// vmCreateUpdateConfig applies the resource configuration to a VM that was created from an existing one,
+// either cloned or restored from a backup.
+func vmCreateUpdateConfig(
+	ctx context.Context,
+	d *schema.ResourceData,
+	client proxmox.Client,
+	vmAPI *vms.Client,
+	vmID int,
+) diag.Diagnostics {
+	description := d.Get(mkDescription).(string)
+	name := d.Get(mkName).(string)
+	tags := d.Get(mkTags).([]interface{})
+
 	audioDevices := vmGetAudioDeviceList(d)
 
 	acpi := types.CustomBool(d.Get(mkACPI).(bool))
@@ -2017,6 +2104,14 @@ func vmCreateClone(ctx context.Context, d *schema.ResourceData, m interface{}) d
 		AudioDevices: audioDevices,
 	}
 
+	if description != "" {
+		updateBody.Description = &description
+	}
+
+	if name != "" {
+		updateBody.Name = &name
+	}
+
 	ideDevices := vms.CustomStorageDevices{}
 
 	var del []string
@@ -2326,31 +2421,31 @@ func vmCreateClone(ctx context.Context, d *schema.ResourceData, m interface{}) d
 
 	updateBody.Delete = del
 
-	e = vmAPI.UpdateVM(ctx, updateBody)
-	if e != nil {
-		return diag.FromErr(e)
+	err = vmAPI.UpdateVM(ctx, updateBody)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	vmConfig, e = vmAPI.GetVM(ctx)
-	if e != nil {
-		if errors.Is(e, api.ErrResourceDoesNotExist) {
+	vmConfig, err = vmAPI.GetVM(ctx)
+	if err != nil {
+		if errors.Is(err, api.ErrResourceDoesNotExist) {
 			d.SetId("")
 			return nil
 		}
 
-		return diag.FromErr(e)
+		return diag.FromErr(err)
 	}
 
 	clonedDiskInfo := disk.GetInfo(vmConfig, d) // from the cloned VM
 
-	planDisks, e := disk.GetDiskDeviceObjects(d, VM(), nil) // from the resource config
-	if e != nil {
-		return diag.FromErr(e)
+	planDisks, err := disk.GetDiskDeviceObjects(d, VM(), nil) // from the resource config
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	e = disk.UpdateClone(ctx, planDisks, clonedDiskInfo, vmAPI)
-	if e != nil {
-		return diag.FromErr(e)
+	err = disk.UpdateClone(ctx, planDisks, clonedDiskInfo, vmAPI)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	efiDisk := d.Get(mkEFIDisk).([]interface{})
@@ -2370,9 +2465,9 @@ func vmCreateClone(ctx context.Context, d *schema.ResourceData, m interface{}) d
 
 			diskUpdateBody.EFIDisk = configuredDiskInfo
 
-			e = vmAPI.UpdateVM(ctx, diskUpdateBody)
-			if e != nil {
-				return diag.FromErr(e)
+			err = vmAPI.UpdateVM(ctx, diskUpdateBody)
+			if err != nil {
+				return diag.FromErr(err)
 			}
 
 			continue
@@ -2408,9 +2503,9 @@ func vmCreateClone(ctx context.Context, d *schema.ResourceData, m interface{}) d
 		}
 
 		if moveDisk {
-			e = vmAPI.MoveVMDisk(ctx, diskMoveBody)
-			if e != nil {
-				return diag.FromErr(e)
+			err = vmAPI.MoveVMDisk(ctx, diskMoveBody)
+			if err != nil {
+				return diag.FromErr(err)
 			}
 		}
 	}
@@ -2431,9 +2526,9 @@ func vmCreateClone(ctx context.Context, d *schema.ResourceData, m interface{}) d
 
 			diskUpdateBody.TPMState = configuredTPMStateInfo
 
-			e = vmAPI.UpdateVM(ctx, diskUpdateBody)
-			if e != nil {
-				return diag.FromErr(e)
+			err = vmAPI.UpdateVM(ctx, diskUpdateBody)
+			if err != nil {
+				return diag.FromErr(err)
 			}
 
 			continue
@@ -2459,14 +2554,14 @@ func vmCreateClone(ctx context.Context, d *schema.ResourceData, m interface{}) d
 		}
 
 		if moveDisk {
-			e = vmAPI.MoveVMDisk(ctx, diskMoveBody)
-			if e != nil {
-				return diag.FromErr(e)
+			err = vmAPI.MoveVMDisk(ctx, diskMoveBody)
+			if err != nil {
+				return diag.FromErr(err)
 			}
 		}
 	}
 
-	return vmCreateStart(ctx, d, m)
+	return nil
 }
 
 func setCPUArchitecture(
@@ -2488,6 +2583,79 @@ func setCPUArchitecture(
 	return nil
 }
 
+// vmCreateRestore creates a VM by restoring it from an existing vzdump archive.
+func vmCreateRestore(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	createTimeoutSec := d.Get(mkTimeoutCreate).(int)
+
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(createTimeoutSec)*time.Second)
+	defer cancel()
+
+	config := m.(proxmoxtf.ProviderConfiguration)
+
+	client, err := config.GetClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	restore := d.Get(mkRestore).([]interface{})
+	restoreBlock := restore[0].(map[string]interface{})
+	restoreArchive := restoreBlock[mkRestoreArchive].(string)
+	restoreForce := types.CustomBool(restoreBlock[mkRestoreForce].(bool))
+	restoreUnique := types.CustomBool(restoreBlock[mkRestoreUnique].(bool))
+
+	nodeName := d.Get(mkNodeName).(string)
+	poolID := d.Get(mkPoolID).(string)
+	vmIDUntyped, hasVMID := d.GetOk(mkVMID)
+	vmID := vmIDUntyped.(int)
+
+	if !hasVMID {
+		vmIDNew, e := config.GetIDGenerator().NextID(ctx)
+		if e != nil {
+			return diag.FromErr(e)
+		}
+
+		vmID = vmIDNew
+
+		e = d.Set(mkVMID, vmID)
+		if e != nil {
+			return diag.FromErr(e)
+		}
+	}
+
+	createBody := &vms.CreateRequestBody{
+		BackupFile: &restoreArchive,
+		Overwrite:  &restoreForce,
+		Unique:     &restoreUnique,
+		VMID:       vmID,
+	}
+
+	if poolID != "" {
+		createBody.PoolID = &poolID
+	}
+
+	err = client.Node(nodeName).VM(0).CreateVM(ctx, createBody)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(vmID))
+
+	vmAPI := client.Node(nodeName).VM(vmID)
+
+	// Wait for the virtual machine to be restored and its configuration lock to be released.
+	err = vmAPI.WaitForVMConfigUnlock(ctx, true)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The archive contains the configuration of the backed up VM, which is replaced by the resource one.
+	if diags := vmCreateUpdateConfig(ctx, d, client, vmAPI, vmID); diags.HasError() || d.Id() == "" {
+		return diags
+	}
+
+	return vmCreateStart(ctx, d, m)
+}
+
 func vmCreateCustom(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	createTimeoutSec := d.Get(mkTimeoutCreate).(int)
 
@@ -3009,6 +3177,20 @@ func vmGetAudioDeviceList(d *schema.ResourceData) vms.CustomAudioDevices {
 	return list
 }
 
+// vmGetCloudInitPasswordWO returns the write-only cloud-init password from the configuration, if any.
+func vmGetCloudInitPasswordWO(d *schema.ResourceData) string {
+	p := cty.GetAttrPath(mkInitialization).IndexInt(0).
+		GetAttr(mkInitializationUserAccount).IndexInt(0).
+		GetAttr(mkInitializationUserAccountPasswordWO)
+
+	v, diags := d.GetRawConfigAt(p)
+	if diags.HasError() || !v.IsKnown() || v.IsNull() || !v.Type().Equals(cty.String) {
+		return ""
+	}
+
+	return v.AsString()
+}
+
 func vmGetCloudInitConfig(d *schema.ResourceData) *vms.CustomCloudInitConfig {
 	initialization := d.Get(mkInitialization).([]interface{})
 
@@ -3102,6 +3284,10 @@ func vmGetCloudInitConfig(d *schema.ResourceData) *vms.CustomCloudInitConfig {
 			initializationConfig.Password = &password
 		}
 
+		if passwordWO := vmGetCloudInitPasswordWO(d); passwordWO != "" {
+			initializationConfig.Password = &passwordWO
+		}
+
 		username := initializationUserAccountBlock[mkInitializationUserAccountUsername].(string)
 		if username != "" {
 			initializationConfig.Username = &username
@@ -3684,6 +3870,11 @@ func vmReadCustom(
 	nodeName := d.Get(mkNodeName).(string)
 	clone := d.Get(mkClone).([]interface{})
 
+	// A restored VM gets its configuration from the backup, so treat it like a clone.
+	if len(clone) == 0 {
+		clone = d.Get(mkRestore).([]interface{})
+	}
+
 	// Compare the agent configuration to the one stored in the state.
 	currentAgent := d.Get(mkAgent).([]interface{})
 
@@ -4429,6 +4620,12 @@ func vmReadCustom(
 			initializationUserAccount[mkInitializationUserAccountUsername] = ""
 		}
 
+		// the write-only password is not returned by the API, so its version is kept from the state
+		initializationUserAccount[mkInitializationUserAccountPasswordWOVersion] = d.Get(
+			fmt.Sprintf("%s.0.%s.0.%s",
+				mkInitialization, mkInitializationUserAccount, mkInitializationUserAccountPasswordWOVersion),
+		)
+
 		initialization[mkInitializationUserAccount] = []interface{}{
 			initializationUserAccount,
 		}
@@ -4880,6 +5077,12 @@ func vmReadPrimitiveValues(
 	var err error
 
 	clone := d.Get(mkClone).([]interface{})
+
+	// A restored VM gets its configuration from the backup, so treat it like a clone.
+	if len(clone) == 0 {
+		clone = d.Get(mkRestore).([]interface{})
+	}
+
 	currentACPI := d.Get(mkACPI).(bool)
 
 	if len(clone) == 0 || !currentACPI {
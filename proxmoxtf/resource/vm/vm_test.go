@@ -10,8 +10,13 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/bpg/terraform-provider-proxmox/proxmox/helpers/ptr"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/nodes/vms"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/types"
 	"github.com/bpg/terraform-provider-proxmox/proxmoxtf/resource/vm/disk"
 	"github.com/bpg/terraform-provider-proxmox/proxmoxtf/resource/vm/network"
 	"github.com/bpg/terraform-provider-proxmox/proxmoxtf/test"
@@ -318,13 +323,17 @@ func TestVMSchema(t *testing.T) {
 	test.AssertOptionalArguments(t, initializationUserAccountSchema, []string{
 		mkInitializationUserAccountKeys,
 		mkInitializationUserAccountPassword,
+		mkInitializationUserAccountPasswordWO,
+		mkInitializationUserAccountPasswordWOVersion,
 		mkInitializationUserAccountUsername,
 	})
 
 	test.AssertValueTypes(t, initializationUserAccountSchema, map[string]schema.ValueType{
-		mkInitializationUserAccountKeys:     schema.TypeList,
-		mkInitializationUserAccountPassword: schema.TypeString,
-		mkInitializationUserAccountUsername: schema.TypeString,
+		mkInitializationUserAccountKeys:              schema.TypeList,
+		mkInitializationUserAccountPassword:          schema.TypeString,
+		mkInitializationUserAccountPasswordWO:        schema.TypeString,
+		mkInitializationUserAccountPasswordWOVersion: schema.TypeInt,
+		mkInitializationUserAccountUsername:          schema.TypeString,
 	})
 
 	memorySchema := test.AssertNestedSchemaExistence(t, s, mkMemory)
@@ -446,3 +455,55 @@ func Test_parseImportIDWIthNodeName(t *testing.T) {
 		})
 	}
 }
+
+// TestVMCreateRestore tests that the configuration of a VM restored from a backup is replaced
+// by the resource one.
+func TestVMCreateRestore(t *testing.T) {
+	t.Parallel()
+
+	s, config := test.NewFakeProviderConfiguration(t)
+	ctx := t.Context()
+
+	client, err := config.GetClient()
+	require.NoError(t, err)
+
+	nodeAPI := client.Node(s.NodeName())
+
+	body := &vms.CreateRequestBody{
+		VMID:            100,
+		Name:            ptr.Ptr("backed-up"),
+		Description:     ptr.Ptr("backed up"),
+		DedicatedMemory: ptr.Ptr(1024),
+		CPUCores:        ptr.Ptr(int64(1)),
+	}
+	body.AddCustomStorageDevice("scsi0", vms.CustomStorageDevice{FileVolume: "local-lvm:8"})
+	require.NoError(t, nodeAPI.VM(100).CreateVM(ctx, body))
+
+	archive, err := nodeAPI.Vzdump(ctx, &nodes.VzdumpRequestBody{VMID: 100, Storage: "local"})
+	require.NoError(t, err)
+
+	d := schema.TestResourceDataRaw(t, VM().Schema, map[string]interface{}{
+		mkNodeName:    s.NodeName(),
+		mkVMID:        101,
+		mkName:        "restored",
+		mkDescription: "restored from a backup",
+		mkStarted:     false,
+		mkTags:        []interface{}{"restored"},
+		mkCPU:         []interface{}{map[string]interface{}{mkCPUCores: 4}},
+		mkMemory:      []interface{}{map[string]interface{}{mkMemoryDedicated: 4096}},
+		mkRestore:     []interface{}{map[string]interface{}{mkRestoreArchive: archive}},
+	})
+
+	diags := vmCreate(ctx, d, config)
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, "101", d.Id())
+
+	vm, err := nodeAPI.VM(101).GetVM(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "restored", *vm.Name)
+	assert.Equal(t, "restored from a backup", *vm.Description)
+	assert.Equal(t, "restored", *vm.Tags)
+	assert.Equal(t, int64(4), *vm.CPUCores)
+	assert.Equal(t, types.CustomInt64(4096), *vm.DedicatedMemory)
+	assert.Equal(t, "local-lvm:vm-101-disk-0", vm.StorageDevices["scsi0"].FileVolume)
+}
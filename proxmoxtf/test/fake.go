@@ -0,0 +1,56 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox/api"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/cluster"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/fakepve"
+	"github.com/bpg/terraform-provider-proxmox/proxmox/ssh"
+	"github.com/bpg/terraform-provider-proxmox/proxmoxtf"
+)
+
+// NewFakeProviderConfiguration starts a fake Proxmox VE API server with the given options, and returns it with
+// a provider configuration using it. The SSH client of the configuration cannot connect to the nodes.
+func NewFakeProviderConfiguration(
+	t *testing.T,
+	opts ...fakepve.Option,
+) (*fakepve.Server, proxmoxtf.ProviderConfiguration) {
+	t.Helper()
+
+	s := fakepve.NewServer(opts...)
+	t.Cleanup(s.Close)
+
+	creds, err := api.NewCredentials("", "", "", s.APIToken(), "", "")
+	require.NoError(t, err)
+
+	conn, err := api.NewConnection(s.Endpoint(), true, "")
+	require.NoError(t, err)
+
+	apiClient, err := api.NewClient(creds, conn)
+	require.NoError(t, err)
+
+	sshClient, err := ssh.NewClient("root", "", false, "", "", "", "", "", noNodeResolver{})
+	require.NoError(t, err)
+
+	config, err := proxmoxtf.NewProviderConfiguration(apiClient, sshClient, t.TempDir(), cluster.IDGeneratorConfig{})
+	require.NoError(t, err)
+
+	return s, config
+}
+
+type noNodeResolver struct{}
+
+func (noNodeResolver) Resolve(_ context.Context, nodeName string) (ssh.ProxmoxNode, error) {
+	return ssh.ProxmoxNode{}, errors.New("the fake environment does not resolve the node " + nodeName)
+}
@@ -9,6 +9,7 @@ package utils
 import (
 	"os"
 	"strconv"
+	"strings"
 )
 
 // GetAnyStringEnv returns the first non-empty string value from the environment variables.
@@ -48,3 +49,17 @@ func GetAnyIntEnv(ks ...string) int {
 
 	return 0
 }
+
+// GetAnyListEnv returns the comma-separated values of the first non-empty environment variable,
+// without the empty ones.
+func GetAnyListEnv(ks ...string) []string {
+	var vals []string
+
+	for _, v := range strings.Split(GetAnyStringEnv(ks...), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			vals = append(vals, v)
+		}
+	}
+
+	return vals
+}
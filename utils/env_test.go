@@ -0,0 +1,24 @@
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//nolint:paralleltest
+func TestGetAnyListEnv(t *testing.T) {
+	t.Setenv("TEST_LIST_ENV", " https://pve1:8006/, ,https://pve2:8006/ ")
+
+	assert.Equal(t,
+		[]string{"https://pve1:8006/", "https://pve2:8006/"},
+		GetAnyListEnv("TEST_LIST_ENV_UNSET", "TEST_LIST_ENV"),
+	)
+	assert.Empty(t, GetAnyListEnv("TEST_LIST_ENV_UNSET"))
+}